@@ -0,0 +1,55 @@
+package cosmos
+
+import (
+	"math/big"
+
+	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// BaseFeeSplitDecorator applies the base fee split policy of the fee market to
+// the base fee component of the fee of Cosmos transactions. It must be placed
+// after the DeductFeeDecorator, once the fee is held by the fee collector.
+// CONTRACT: Tx must implement FeeTx to use BaseFeeSplitDecorator
+type BaseFeeSplitDecorator struct {
+	feemarketKeeper anteinterfaces.FeeMarketKeeper
+	evmKeeper       anteinterfaces.EVMKeeper
+}
+
+// NewBaseFeeSplitDecorator creates a new BaseFeeSplitDecorator instance used only for
+// Cosmos transactions.
+func NewBaseFeeSplitDecorator(fk anteinterfaces.FeeMarketKeeper, ek anteinterfaces.EVMKeeper) BaseFeeSplitDecorator {
+	return BaseFeeSplitDecorator{feemarketKeeper: fk, evmKeeper: ek}
+}
+
+// AnteHandle splits the base fee paid for the gas limit of the transaction,
+// capped by the fee paid in the EVM denom.
+func (bfsd BaseFeeSplitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType, "invalid transaction type %T, expected sdk.FeeTx", tx)
+	}
+
+	cosmosBaseFee := bfsd.feemarketKeeper.GetCosmosBaseFee(ctx)
+	if cosmosBaseFee.IsNil() || !cosmosBaseFee.IsPositive() {
+		return next(ctx, tx, simulate)
+	}
+	baseFee := evmtypes.ConvertAmountTo18DecimalsLegacy(cosmosBaseFee).TruncateInt().BigInt()
+
+	baseFeeAmount := new(big.Int).Mul(baseFee, new(big.Int).SetUint64(feeTx.GetGas()))
+	paid := evmtypes.ConvertAmountTo18DecimalsBigInt(feeTx.GetFee().AmountOf(evmtypes.GetEVMCoinDenom()).BigInt())
+	if paid.Cmp(baseFeeAmount) < 0 {
+		baseFeeAmount = paid
+	}
+
+	if err := bfsd.evmKeeper.SplitBaseFee(ctx, baseFeeAmount); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,149 @@
+package cosmos_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/ante/cosmos"
+	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
+	"github.com/cosmos/evm/encoding"
+	"github.com/cosmos/evm/testutil/constants"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// mockFeeMarketKeeper returns a fixed cosmos base fee.
+type mockFeeMarketKeeper struct {
+	anteinterfaces.FeeMarketKeeper
+	cosmosBaseFee math.LegacyDec
+}
+
+func (m mockFeeMarketKeeper) GetCosmosBaseFee(sdk.Context) math.LegacyDec {
+	return m.cosmosBaseFee
+}
+
+// mockEVMKeeper records the base fee amount it is asked to split.
+type mockEVMKeeper struct {
+	anteinterfaces.EVMKeeper
+	splitAmount *big.Int
+}
+
+func (m *mockEVMKeeper) SplitBaseFee(_ sdk.Context, baseFeeAmount *big.Int) error {
+	m.splitAmount = baseFeeAmount
+	return nil
+}
+
+func TestBaseFeeSplitDecorator(t *testing.T) {
+	eighteenDecimalsCoinInfo := constants.ExampleChainCoinInfo[constants.ExampleChainID]
+	sixDecimalsCoinInfo := constants.ExampleChainCoinInfo[constants.SixDecimalsChainID]
+	otherDenom := "uother"
+
+	testCases := []struct {
+		name          string
+		evmCoinInfo   evmtypes.EvmCoinInfo
+		cosmosBaseFee math.LegacyDec
+		gasLimit      uint64
+		fee           sdk.Coins
+		expSplit      *big.Int
+	}{
+		{
+			name:          "no split - zero cosmos base fee",
+			evmCoinInfo:   eighteenDecimalsCoinInfo,
+			cosmosBaseFee: math.LegacyZeroDec(),
+			gasLimit:      100_000,
+			fee:           sdk.NewCoins(sdk.NewInt64Coin(eighteenDecimalsCoinInfo.Denom, 2_000_000)),
+			expSplit:      nil,
+		},
+		{
+			name:          "split - gas limit times cosmos base fee",
+			evmCoinInfo:   eighteenDecimalsCoinInfo,
+			cosmosBaseFee: math.LegacyNewDec(10),
+			gasLimit:      100_000,
+			fee:           sdk.NewCoins(sdk.NewInt64Coin(eighteenDecimalsCoinInfo.Denom, 2_000_000)),
+			expSplit:      big.NewInt(1_000_000),
+		},
+		{
+			name:          "split - capped at the fee paid in the evm denom",
+			evmCoinInfo:   eighteenDecimalsCoinInfo,
+			cosmosBaseFee: math.LegacyNewDec(10),
+			gasLimit:      100_000,
+			fee:           sdk.NewCoins(sdk.NewInt64Coin(eighteenDecimalsCoinInfo.Denom, 500_000)),
+			expSplit:      big.NewInt(500_000),
+		},
+		{
+			name:          "split - fee paid in a non evm denom",
+			evmCoinInfo:   eighteenDecimalsCoinInfo,
+			cosmosBaseFee: math.LegacyNewDec(10),
+			gasLimit:      100_000,
+			fee:           sdk.NewCoins(sdk.NewInt64Coin(otherDenom, 2_000_000)),
+			expSplit:      big.NewInt(0),
+		},
+		{
+			name:          "split - only the fee paid in the evm denom is counted",
+			evmCoinInfo:   eighteenDecimalsCoinInfo,
+			cosmosBaseFee: math.LegacyNewDec(10),
+			gasLimit:      100_000,
+			fee: sdk.NewCoins(
+				sdk.NewInt64Coin(eighteenDecimalsCoinInfo.Denom, 300_000),
+				sdk.NewInt64Coin(otherDenom, 2_000_000),
+			),
+			expSplit: big.NewInt(300_000),
+		},
+		{
+			name:          "split - 6 decimals evm denom converted to 18 decimals",
+			evmCoinInfo:   sixDecimalsCoinInfo,
+			cosmosBaseFee: math.LegacyNewDec(1),
+			gasLimit:      100_000,
+			fee:           sdk.NewCoins(sdk.NewInt64Coin(sixDecimalsCoinInfo.Denom, 200_000)),
+			expSplit:      big.NewInt(1e17),
+		},
+		{
+			name:          "split - 6 decimals evm denom capped at the fee paid",
+			evmCoinInfo:   sixDecimalsCoinInfo,
+			cosmosBaseFee: math.LegacyNewDec(1),
+			gasLimit:      100_000,
+			fee:           sdk.NewCoins(sdk.NewInt64Coin(sixDecimalsCoinInfo.Denom, 50_000)),
+			expSplit:      big.NewInt(5e16),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			configurator := evmtypes.NewEVMConfigurator()
+			configurator.ResetTestConfig()
+			require.NoError(t, configurator.WithEVMCoinInfo(tc.evmCoinInfo).Configure())
+
+			txBuilder := encoding.MakeConfig(constants.ExampleChainID.EVMChainID).TxConfig.NewTxBuilder()
+			txBuilder.SetGasLimit(tc.gasLimit)
+			txBuilder.SetFeeAmount(tc.fee)
+
+			evmKeeper := &mockEVMKeeper{}
+			decorator := cosmos.NewBaseFeeSplitDecorator(
+				mockFeeMarketKeeper{cosmosBaseFee: tc.cosmosBaseFee},
+				evmKeeper,
+			)
+
+			nextCalled := false
+			next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				nextCalled = true
+				return ctx, nil
+			}
+
+			_, err := decorator.AnteHandle(sdk.Context{}, txBuilder.GetTx(), false, next)
+			require.NoError(t, err)
+			require.True(t, nextCalled)
+
+			if tc.expSplit == nil {
+				require.Nil(t, evmKeeper.splitAmount)
+				return
+			}
+			require.NotNil(t, evmKeeper.splitAmount)
+			require.Equal(t, 0, tc.expSplit.Cmp(evmKeeper.splitAmount), "expected %s, got %s", tc.expSplit, evmKeeper.splitAmount)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
 	"github.com/cosmos/evm/types"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -74,9 +75,20 @@ func CheckGasWanted(ctx sdk.Context, feeMarketKeeper anteinterfaces.FeeMarketKee
 	}
 
 	// Add total gasWanted to cumulative in block transientStore in FeeMarket module
-	if _, err := feeMarketKeeper.AddTransientGasWanted(ctx, gasWanted); err != nil {
+	if _, err := feeMarketKeeper.AddTransientGasWanted(ctx, gasWanted, gasLane(tx)); err != nil {
 		return errorsmod.Wrapf(err, "failed to add gas wanted to transient store")
 	}
 
 	return nil
 }
+
+// gasLane returns the fee market gas lane of the tx: the EVM lane for the txs
+// wrapping Ethereum transactions, and the Cosmos lane otherwise.
+func gasLane(tx sdk.Tx) feemarkettypes.GasLane {
+	for _, msg := range tx.GetMsgs() {
+		if _, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+			return feemarkettypes.GasLaneEVM
+		}
+	}
+	return feemarkettypes.GasLaneCosmos
+}
@@ -57,7 +57,9 @@ func FeeChecker(
 		return checkTxFeeWithValidatorMinGasPrices(ctx, feeTx)
 	}
 
-	baseFee := k.GetBaseFee(ctx)
+	// the Cosmos txs are priced by the Cosmos base fee, which only differs from
+	// the base fee if the gas lanes are separate
+	baseFee := k.GetCosmosBaseFee(ctx)
 	// if baseFee is nil because it is disabled
 	// or not found, consider it as 0
 	// so the DynamicFeeTx logic can be applied
@@ -28,18 +28,26 @@ import (
 var _ anteinterfaces.FeeMarketKeeper = MockFeemarketKeeper{}
 
 type MockFeemarketKeeper struct {
-	BaseFee math.LegacyDec
+	BaseFee       math.LegacyDec
+	CosmosBaseFee math.LegacyDec
 }
 
 func (m MockFeemarketKeeper) GetBaseFee(_ sdk.Context) math.LegacyDec {
 	return m.BaseFee
 }
 
+func (m MockFeemarketKeeper) GetCosmosBaseFee(_ sdk.Context) math.LegacyDec {
+	if m.CosmosBaseFee.IsNil() {
+		return m.BaseFee
+	}
+	return m.CosmosBaseFee
+}
+
 func (m MockFeemarketKeeper) GetBaseFeeEnabled(_ sdk.Context) bool {
 	return true
 }
 
-func (m MockFeemarketKeeper) AddTransientGasWanted(_ sdk.Context, _ uint64) (uint64, error) {
+func (m MockFeemarketKeeper) AddTransientGasWanted(_ sdk.Context, _ uint64, _ feemarkettypes.GasLane) (uint64, error) {
 	return 0, nil
 }
 
@@ -163,6 +171,24 @@ func TestSDKTxFeeChecker(t *testing.T) {
 			0,
 			true,
 		},
+		{
+			"fail, dynamic fee below the cosmos base fee",
+			deliverTxCtx,
+			MockFeemarketKeeper{
+				BaseFee:       math.LegacyNewDec(10),
+				CosmosBaseFee: math.LegacyNewDec(20),
+			},
+			func() sdk.FeeTx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(testconstants.ExampleAttoDenom, math.NewInt(10))))
+				return txBuilder.GetTx()
+			},
+			true,
+			"",
+			0,
+			false,
+		},
 		{
 			"success, dynamic fee priority",
 			deliverTxCtx,
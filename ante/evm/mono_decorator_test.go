@@ -66,9 +66,10 @@ func (k *ExtendedEVMKeeper) ResetTransientGasUsed(_ sdk.Context) {}
 func (k *ExtendedEVMKeeper) GetParams(_ sdk.Context) evmsdktypes.Params {
 	return evmsdktypes.DefaultParams()
 }
-func (k *ExtendedEVMKeeper) GetBaseFee(_ sdk.Context) *big.Int           { return big.NewInt(0) }
-func (k *ExtendedEVMKeeper) GetMinGasPrice(_ sdk.Context) math.LegacyDec { return math.LegacyZeroDec() }
-func (k *ExtendedEVMKeeper) GetTxIndexTransient(_ sdk.Context) uint64    { return 0 }
+func (k *ExtendedEVMKeeper) GetBaseFee(_ sdk.Context) *big.Int            { return big.NewInt(0) }
+func (k *ExtendedEVMKeeper) GetMinGasPrice(_ sdk.Context) math.LegacyDec  { return math.LegacyZeroDec() }
+func (k *ExtendedEVMKeeper) GetTxIndexTransient(_ sdk.Context) uint64     { return 0 }
+func (k *ExtendedEVMKeeper) SplitBaseFee(_ sdk.Context, _ *big.Int) error { return nil }
 
 // only methods called by EVMMonoDecorator
 type MockFeeMarketKeeper struct{}
@@ -77,11 +78,14 @@ func (m MockFeeMarketKeeper) GetParams(_ sdk.Context) feemarkettypes.Params {
 	return feemarkettypes.DefaultParams()
 }
 
-func (m MockFeeMarketKeeper) AddTransientGasWanted(_ sdk.Context, _ uint64) (uint64, error) {
+func (m MockFeeMarketKeeper) AddTransientGasWanted(_ sdk.Context, _ uint64, _ feemarkettypes.GasLane) (uint64, error) {
 	return 0, nil
 }
 func (m MockFeeMarketKeeper) GetBaseFeeEnabled(_ sdk.Context) bool    { return true }
 func (m MockFeeMarketKeeper) GetBaseFee(_ sdk.Context) math.LegacyDec { return math.LegacyZeroDec() }
+func (m MockFeeMarketKeeper) GetCosmosBaseFee(_ sdk.Context) math.LegacyDec {
+	return math.LegacyZeroDec()
+}
 
 // matches the actual signatures
 type MockAccountKeeper struct {
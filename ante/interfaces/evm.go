@@ -36,14 +36,18 @@ type EVMKeeper interface {
 	// GetMinGasPrice returns the MinGasPrice param from the fee market module
 	// adapted according to the evm denom decimals
 	GetMinGasPrice(ctx sdk.Context) math.LegacyDec
+	// SplitBaseFee applies the base fee split policy to the given base fee
+	// amount, denominated in 18 decimals, held by the fee collector
+	SplitBaseFee(ctx sdk.Context, baseFeeAmount *big.Int) error
 }
 
 // FeeMarketKeeper exposes the required feemarket keeper interface required for ante handlers
 type FeeMarketKeeper interface {
 	GetParams(ctx sdk.Context) (params feemarkettypes.Params)
-	AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64, error)
+	AddTransientGasWanted(ctx sdk.Context, gasWanted uint64, lane feemarkettypes.GasLane) (uint64, error)
 	GetBaseFeeEnabled(ctx sdk.Context) bool
 	GetBaseFee(ctx sdk.Context) math.LegacyDec
+	GetCosmosBaseFee(ctx sdk.Context) math.LegacyDec
 }
 
 type ProtoTxProvider interface {
@@ -0,0 +1,15 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "./IERC20Metadata.sol";
+import "./IERC20Permit.sol";
+
+/**
+ * @author Evmos Team
+ * @title ERC20 Precompile Interface
+ * @dev Interface of the ERC20 precompile, which exposes the ERC20 standard with its metadata
+ * and the EIP-2612 permit extension for the native coins registered as token pairs.
+ * The domain separator of the permit signatures uses the token name, version "1",
+ * the EVM chain ID and the address of the precompile.
+ */
+interface IERC20MetadataPermit is IERC20Metadata, IERC20Permit {}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev The IMulticall contract's address.
+address constant MULTICALL_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000080a;
+
+/// @dev The IMulticall contract's instance.
+IMulticall constant MULTICALL_CONTRACT = IMulticall(MULTICALL_PRECOMPILE_ADDRESS);
+
+/// @dev Call defines a call to a contract or precompile.
+struct Call {
+    /// @dev The address of the contract to call
+    address target;
+    /// @dev The ABI encoded call data
+    bytes callData;
+}
+
+/// @dev Call3 defines a call to a contract or precompile that is allowed to fail.
+struct Call3 {
+    /// @dev The address of the contract to call
+    address target;
+    /// @dev Whether the batch continues if the call fails
+    bool allowFailure;
+    /// @dev The ABI encoded call data
+    bytes callData;
+}
+
+/// @dev Result defines the outcome of a call.
+struct Result {
+    /// @dev Whether the call succeeded
+    bool success;
+    /// @dev The data returned by the call, or its revert data if it failed
+    bytes returnData;
+}
+
+/// @author Evmos Team
+/// @title Multicall Precompiled Contract
+/// @dev The interface through which solidity contracts and EOAs can batch calls to other
+/// precompiles and contracts in a single transaction, following the Multicall3 semantics.
+/// Unlike Multicall3, the calls are executed on behalf of the caller of the precompile, so
+/// that msg.sender is preserved for each call (e.g. to delegate and vote in one transaction).
+/// @custom:address 0x000000000000000000000000000000000000080a
+interface IMulticall {
+    /// @dev Aggregate executes the calls in order and reverts if any of them fails.
+    /// @param calls The calls to execute
+    /// @return blockNumber The current block number
+    /// @return returnData The data returned by each call
+    function aggregate(
+        Call[] calldata calls
+    ) external returns (uint256 blockNumber, bytes[] memory returnData);
+
+    /// @dev TryAggregate executes the calls in order. The changes of a failed call are reverted,
+    /// and the whole batch reverts on failure if requireSuccess is set.
+    /// @param requireSuccess Whether the batch reverts if any call fails
+    /// @param calls The calls to execute
+    /// @return returnData The result of each call
+    function tryAggregate(
+        bool requireSuccess,
+        Call[] calldata calls
+    ) external returns (Result[] memory returnData);
+
+    /// @dev Aggregate3 executes the calls in order. The changes of a failed call are reverted,
+    /// and the whole batch reverts if the failed call does not allow failure.
+    /// @param calls The calls to execute
+    /// @return returnData The result of each call
+    function aggregate3(
+        Call3[] calldata calls
+    ) external returns (Result[] memory returnData);
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 
 	"github.com/spf13/cast"
 
@@ -23,6 +24,7 @@ import (
 	evmosencoding "github.com/cosmos/evm/encoding"
 	"github.com/cosmos/evm/evmd/ante"
 	evmmempool "github.com/cosmos/evm/mempool"
+	"github.com/cosmos/evm/mempool/txpool/legacypool"
 	srvflags "github.com/cosmos/evm/server/flags"
 	cosmosevmtypes "github.com/cosmos/evm/types"
 	"github.com/cosmos/evm/x/erc20"
@@ -151,9 +153,10 @@ const appName = "evmd"
 var defaultNodeHome string
 
 var (
-	_ runtime.AppI                = (*EVMD)(nil)
-	_ cosmosevmserver.Application = (*EVMD)(nil)
-	_ ibctesting.TestingApp       = (*EVMD)(nil)
+	_ runtime.AppI                           = (*EVMD)(nil)
+	_ cosmosevmserver.Application            = (*EVMD)(nil)
+	_ cosmosevmserver.AppWithHeaderHashStore = (*EVMD)(nil)
+	_ ibctesting.TestingApp                  = (*EVMD)(nil)
 )
 
 // EVMD extends an ABCI application, but with most of its parameters exported.
@@ -392,7 +395,11 @@ func NewExampleApp(
 		authAddr,
 	)
 
-	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[feegrant.StoreKey]), app.AccountKeeper)
+	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[feegrant.StoreKey]),
+		app.AccountKeeper,
+	).SetBankKeeper(app.BankKeeper) // the bank keeper is used to reject allowances to blocked addresses
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -405,7 +412,7 @@ func NewExampleApp(
 		appCodec,
 		app.MsgServiceRouter(),
 		app.AccountKeeper,
-	)
+	).SetBankKeeper(app.BankKeeper) // the bank keeper is used to reject grants to blocked addresses
 
 	// get skipUpgradeHeights from the app options
 	skipUpgradeHeights := map[int64]bool{}
@@ -493,6 +500,7 @@ func NewExampleApp(
 		&app.Erc20Keeper,
 		tracer,
 	)
+	app.EVMKeeper.SetCommunityPoolKeeper(app.DistrKeeper)
 
 	app.Erc20Keeper = erc20keeper.NewKeeper(
 		keys[erc20types.StoreKey],
@@ -581,6 +589,8 @@ func NewExampleApp(
 			app.EVMKeeper,
 			app.GovKeeper,
 			app.SlashingKeeper,
+			app.AuthzKeeper,
+			app.FeeGrantKeeper,
 			app.AppCodec(),
 		),
 	)
@@ -765,10 +775,24 @@ func NewExampleApp(
 	// set the EVM priority nonce mempool
 	// If you wish to use the noop mempool, remove this codeblock
 	if evmtypes.GetChainConfig() != nil {
+		// journal the local transactions of the EVM mempool to survive node restarts
+		legacyPoolConfig := legacypool.DefaultConfig
+		legacyPoolConfig.Journal = ""
+		if journal := cast.ToString(appOpts.Get(srvflags.EVMMempoolJournal)); journal != "" {
+			if !filepath.IsAbs(journal) {
+				journal = filepath.Join(homePath, "data", journal)
+			}
+			legacyPoolConfig.Journal = journal
+		}
+		if rejournal := cast.ToDuration(appOpts.Get(srvflags.EVMMempoolRejournal)); rejournal > 0 {
+			legacyPoolConfig.Rejournal = rejournal
+		}
+
 		// TODO: Get the actual block gas limit from consensus parameters
 		mempoolConfig := &evmmempool.EVMMempoolConfig{
-			AnteHandler:   app.GetAnteHandler(),
-			BlockGasLimit: 100_000_000,
+			AnteHandler:      app.GetAnteHandler(),
+			BlockGasLimit:    100_000_000,
+			LegacyPoolConfig: &legacyPoolConfig,
 		}
 
 		evmMempool := evmmempool.NewExperimentalEVMMempool(app.CreateQueryContext, logger, app.EVMKeeper, app.FeeMarketKeeper, app.txConfig, app.clientCtx, mempoolConfig)
@@ -1146,6 +1170,12 @@ func (app *EVMD) SetClientCtx(clientCtx client.Context) {
 	app.clientCtx = clientCtx
 }
 
+// SetHeaderHashStore sets the archival store of the block header hashes,
+// serving the EVM block hashes beyond the history serve window in queries.
+func (app *EVMD) SetHeaderHashStore(store evmtypes.HeaderHashStore) {
+	app.EVMKeeper.SetHeaderHashStore(store)
+}
+
 // Close unsubscribes from the CometBFT event bus (if set) and closes the underlying BaseApp.
 func (app *EVMD) Close() error {
 	var err error
@@ -18,6 +18,8 @@ import (
 	cosmosevmkeyring "github.com/cosmos/evm/crypto/keyring"
 	"github.com/cosmos/evm/evmd"
 	evmdconfig "github.com/cosmos/evm/evmd/cmd/evmd/config"
+	cosmosevmrpc "github.com/cosmos/evm/rpc"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/trace"
 	cosmosevmserver "github.com/cosmos/evm/server"
 	srvflags "github.com/cosmos/evm/server/flags"
 
@@ -48,6 +50,14 @@ import (
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 )
 
+func init() {
+	// the trace namespace is opt-in, and is served when enabled in the
+	// JSON-RPC api config
+	if err := cosmosevmrpc.RegisterAPINamespace(trace.Namespace, trace.NewAPIs); err != nil {
+		panic(err)
+	}
+}
+
 // NewRootCmd creates a new root command for evmd. It is called once in the
 // main function.
 func NewRootCmd() *cobra.Command {
@@ -0,0 +1,19 @@
+package multicall
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/evmd/tests/integration"
+	"github.com/cosmos/evm/tests/integration/precompiles/multicall"
+)
+
+func TestMulticallPrecompileTestSuite(t *testing.T) {
+	s := multicall.NewPrecompileTestSuite(integration.CreateEvmd)
+	suite.Run(t, s)
+}
+
+func TestMulticallPrecompileIntegrationTestSuite(t *testing.T) {
+	multicall.TestPrecompileIntegrationTestSuite(t, integration.CreateEvmd)
+}
@@ -23,14 +23,19 @@ import (
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash       = 1
+	KeyPrefixTxIndex      = 2
+	KeyPrefixHeaderHash   = 3
+	KeyPrefixHeaderHeight = 4
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
 )
 
-var _ cosmosevmtypes.EVMTxIndexer = &KVIndexer{}
+var (
+	_ cosmosevmtypes.EVMTxIndexer      = &KVIndexer{}
+	_ cosmosevmtypes.HeaderHashIndexer = &KVIndexer{}
+)
 
 // KVIndexer implements a eth tx indexer on a KV db.
 type KVIndexer struct {
@@ -49,72 +54,19 @@ func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVInd
 // - Parses eth Tx infos from cosmos-sdk events for every TxResult
 // - Iterates over all the messages of the Tx
 // - Builds and stores a indexer.TxResult based on parsed events for every message
+// - Archives the block header hash
 func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
-	height := block.Height
-
 	batch := kv.db.NewBatch()
 	defer batch.Close()
 
-	// record index of valid eth tx during the iteration
-	var ethTxIndex int32
-	for txIndex, tx := range block.Txs {
-		result := txResults[txIndex]
-		if !rpctypes.TxSucessOrExpectedFailure(result) {
-			continue
+	if hash := block.Hash(); len(hash) > 0 {
+		if err := saveHeaderHash(batch, block.Height, common.BytesToHash(hash)); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", block.Height)
 		}
-
-		tx, err := kv.clientCtx.TxConfig.TxDecoder()(tx)
-		if err != nil {
-			kv.logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
-			continue
-		}
-
-		if !isEthTx(tx) {
-			continue
-		}
-
-		txs, err := rpctypes.ParseTxResult(result, tx)
-		if err != nil {
-			kv.logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
-			continue
-		}
-
-		var cumulativeGasUsed uint64
-		for msgIndex, msg := range tx.GetMsgs() {
-			ethMsg := msg.(*evmtypes.MsgEthereumTx)
-			txHash := ethMsg.Hash()
-
-			txResult := cosmosevmtypes.TxResult{
-				Height:     height,
-				TxIndex:    uint32(txIndex),  //#nosec G115 -- int overflow is not a concern here
-				MsgIndex:   uint32(msgIndex), //#nosec G115 -- int overflow is not a concern here
-				EthTxIndex: ethTxIndex,
-			}
-			if result.Code != abci.CodeTypeOK {
-				// exceeds block gas limit scenario, set gas used to gas limit because that's what's charged by ante handler.
-				// some old versions don't emit any events, so workaround here directly.
-				txResult.GasUsed = ethMsg.GetGas()
-				txResult.Failed = true
-			} else {
-				parsedTx := txs.GetTxByMsgIndex(msgIndex)
-				if parsedTx == nil {
-					kv.logger.Error("msg index not found in events", "msgIndex", msgIndex)
-					continue
-				}
-				if parsedTx.EthTxIndex >= 0 && parsedTx.EthTxIndex != ethTxIndex {
-					kv.logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
-				}
-				txResult.GasUsed = parsedTx.GasUsed
-				txResult.Failed = parsedTx.Failed
-			}
-
-			cumulativeGasUsed += txResult.GasUsed
-			txResult.CumulativeGasUsed = cumulativeGasUsed
-			ethTxIndex++
-
-			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
-				return errorsmod.Wrapf(err, "IndexBlock %d", height)
-			}
+	}
+	for _, ethTx := range parseEthTxs(kv.clientCtx, kv.logger, block, txResults) {
+		if err := saveTxResult(kv.clientCtx.Codec, batch, ethTx.hash, &ethTx.result); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", block.Height)
 		}
 	}
 	if err := batch.Write(); err != nil {
@@ -161,6 +113,43 @@ func (kv *KVIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*cosm
 	return kv.GetByTxHash(common.BytesToHash(bz))
 }
 
+// IndexHeaderHash archives the header hash of the block at the given height
+func (kv *KVIndexer) IndexHeaderHash(height int64, hash common.Hash) error {
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	if err := saveHeaderHash(batch, height, hash); err != nil {
+		return errorsmod.Wrapf(err, "IndexHeaderHash %d", height)
+	}
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "IndexHeaderHash %d, write batch", height)
+	}
+	return nil
+}
+
+// GetHeaderHash returns the archived header hash of the block at the given
+// height, returns the empty hash if not found
+func (kv *KVIndexer) GetHeaderHash(height int64) (common.Hash, error) {
+	bz, err := kv.db.Get(HeaderHashKey(height))
+	if err != nil {
+		return common.Hash{}, errorsmod.Wrapf(err, "GetHeaderHash %d", height)
+	}
+	return common.BytesToHash(bz), nil
+}
+
+// GetHeightByHeaderHash returns the height of the block with the given
+// archived header hash, returns -1 if not found
+func (kv *KVIndexer) GetHeightByHeaderHash(hash common.Hash) (int64, error) {
+	bz, err := kv.db.Get(HeaderHeightKey(hash))
+	if err != nil {
+		return 0, errorsmod.Wrapf(err, "GetHeightByHeaderHash %s", hash.Hex())
+	}
+	if len(bz) == 0 {
+		return -1, nil
+	}
+	return int64(sdk.BigEndianToUint64(bz)), nil //#nosec G115 -- int overflow is not a concern here
+}
+
 // TxHashKey returns the key for db entry: `tx hash -> tx result struct`
 func TxHashKey(hash common.Hash) []byte {
 	return append([]byte{KeyPrefixTxHash}, hash.Bytes()...)
@@ -173,6 +162,16 @@ func TxIndexKey(blockNumber int64, txIndex int32) []byte {
 	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
 }
 
+// HeaderHashKey returns the key for db entry: `block number -> header hash`
+func HeaderHashKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixHeaderHash}, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115 // block number won't exceed uint64
+}
+
+// HeaderHeightKey returns the key for db entry: `header hash -> block number`
+func HeaderHeightKey(hash common.Hash) []byte {
+	return append([]byte{KeyPrefixHeaderHeight}, hash.Bytes()...)
+}
+
 // LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
 func LoadLastBlock(db dbm.DB) (int64, error) {
 	it, err := db.ReverseIterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
@@ -212,6 +211,81 @@ func isEthTx(tx sdk.Tx) bool {
 	return true
 }
 
+// ethTxResult is an eth tx result parsed from a block, along with the tx hash.
+type ethTxResult struct {
+	hash   common.Hash
+	result cosmosevmtypes.TxResult
+}
+
+// parseEthTxs parses the results of all the eth txs in a block, in the order
+// of the eth tx index. Txs that cannot be decoded or parsed are skipped.
+func parseEthTxs(clientCtx client.Context, logger log.Logger, block *cmttypes.Block, txResults []*abci.ExecTxResult) []ethTxResult {
+	height := block.Height
+
+	var ethTxs []ethTxResult
+	// record index of valid eth tx during the iteration
+	var ethTxIndex int32
+	for txIndex, tx := range block.Txs {
+		result := txResults[txIndex]
+		if !rpctypes.TxSucessOrExpectedFailure(result) {
+			continue
+		}
+
+		tx, err := clientCtx.TxConfig.TxDecoder()(tx)
+		if err != nil {
+			logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+
+		if !isEthTx(tx) {
+			continue
+		}
+
+		txs, err := rpctypes.ParseTxResult(result, tx)
+		if err != nil {
+			logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+
+		var cumulativeGasUsed uint64
+		for msgIndex, msg := range tx.GetMsgs() {
+			ethMsg := msg.(*evmtypes.MsgEthereumTx)
+			txHash := ethMsg.Hash()
+
+			txResult := cosmosevmtypes.TxResult{
+				Height:     height,
+				TxIndex:    uint32(txIndex),  //#nosec G115 -- int overflow is not a concern here
+				MsgIndex:   uint32(msgIndex), //#nosec G115 -- int overflow is not a concern here
+				EthTxIndex: ethTxIndex,
+			}
+			if result.Code != abci.CodeTypeOK {
+				// exceeds block gas limit scenario, set gas used to gas limit because that's what's charged by ante handler.
+				// some old versions don't emit any events, so workaround here directly.
+				txResult.GasUsed = ethMsg.GetGas()
+				txResult.Failed = true
+			} else {
+				parsedTx := txs.GetTxByMsgIndex(msgIndex)
+				if parsedTx == nil {
+					logger.Error("msg index not found in events", "msgIndex", msgIndex)
+					continue
+				}
+				if parsedTx.EthTxIndex >= 0 && parsedTx.EthTxIndex != ethTxIndex {
+					logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
+				}
+				txResult.GasUsed = parsedTx.GasUsed
+				txResult.Failed = parsedTx.Failed
+			}
+
+			cumulativeGasUsed += txResult.GasUsed
+			txResult.CumulativeGasUsed = cumulativeGasUsed
+			ethTxIndex++
+
+			ethTxs = append(ethTxs, ethTxResult{hash: txHash, result: txResult})
+		}
+	}
+	return ethTxs
+}
+
 // saveTxResult index the txResult into the kv db batch
 func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResult *cosmosevmtypes.TxResult) error {
 	bz := codec.MustMarshal(txResult)
@@ -224,6 +298,17 @@ func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResu
 	return nil
 }
 
+// saveHeaderHash index the header hash of a block into the kv db batch
+func saveHeaderHash(batch dbm.Batch, height int64, hash common.Hash) error {
+	if err := batch.Set(HeaderHashKey(height), hash.Bytes()); err != nil {
+		return errorsmod.Wrap(err, "set header-hash key")
+	}
+	if err := batch.Set(HeaderHeightKey(hash), sdk.Uint64ToBigEndian(uint64(height))); err != nil { //nolint:gosec // G115 // block number won't exceed uint64
+		return errorsmod.Wrap(err, "set header-height key")
+	}
+	return nil
+}
+
 func parseBlockNumberFromKey(key []byte) (int64, error) {
 	if len(key) != TxIndexKeyLength {
 		return 0, fmt.Errorf("wrong tx index key length, expect: %d, got: %d", TxIndexKeyLength, len(key))
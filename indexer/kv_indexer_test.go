@@ -0,0 +1,52 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+func TestKVIndexerHeaderHash(t *testing.T) {
+	idxer := NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), client.Context{})
+
+	hash, err := idxer.GetHeaderHash(1)
+	require.NoError(t, err)
+	require.Equal(t, common.Hash{}, hash)
+	height, err := idxer.GetHeightByHeaderHash(common.HexToHash("0x1"))
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), height)
+
+	require.NoError(t, idxer.IndexHeaderHash(1, common.HexToHash("0x1")))
+	hash, err = idxer.GetHeaderHash(1)
+	require.NoError(t, err)
+	require.Equal(t, common.HexToHash("0x1"), hash)
+	height, err = idxer.GetHeightByHeaderHash(common.HexToHash("0x1"))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), height)
+
+	// the header hashes are archived along the indexed blocks
+	block := cmttypes.MakeBlock(2, nil, &cmttypes.Commit{}, nil)
+	block.ValidatorsHash = common.HexToHash("0x2").Bytes()
+	require.NotEmpty(t, block.Hash())
+	require.NoError(t, idxer.IndexBlock(block, nil))
+	hash, err = idxer.GetHeaderHash(2)
+	require.NoError(t, err)
+	require.Equal(t, common.BytesToHash(block.Hash()), hash)
+	height, err = idxer.GetHeightByHeaderHash(hash)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), height)
+
+	// the archived hashes don't count as indexed blocks
+	last, err := idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), last)
+}
@@ -0,0 +1,63 @@
+/*
+  This file defines the database schema of the SQL EVM tx indexer. It is
+  compatible with PostgreSQL and applied when the indexer is created, so all
+  the statements must be idempotent.
+*/
+
+-- The evm_blocks table records the indexed blocks, including the blocks
+-- without eth txs, so that the indexed block range is known.
+CREATE TABLE IF NOT EXISTS evm_blocks (
+  height BIGINT NOT NULL PRIMARY KEY
+);
+
+-- The evm_header_hashes table archives the block header hashes. It is kept
+-- apart from evm_blocks, as the header hashes may be backfilled for blocks
+-- which txs are not indexed.
+CREATE TABLE IF NOT EXISTS evm_header_hashes (
+  height BIGINT NOT NULL PRIMARY KEY,
+  hash   BYTEA  NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_evm_header_hashes_hash ON evm_header_hashes (hash);
+
+-- The evm_txs table records the results of the eth txs.
+CREATE TABLE IF NOT EXISTS evm_txs (
+  tx_hash             BYTEA   NOT NULL PRIMARY KEY,
+  height              BIGINT  NOT NULL,
+  tx_index            BIGINT  NOT NULL,
+  msg_index           BIGINT  NOT NULL,
+  eth_tx_index        INTEGER NOT NULL,
+  failed              BOOLEAN NOT NULL,
+  gas_used            BIGINT  NOT NULL,
+  cumulative_gas_used BIGINT  NOT NULL,
+
+  UNIQUE (height, eth_tx_index)
+);
+
+-- The evm_logs table records the logs emitted in the blocks. The seq column
+-- is the position of the log within its block, and the topic columns are
+-- NULL when the log has less topics.
+CREATE TABLE IF NOT EXISTS evm_logs (
+  height     BIGINT NOT NULL,
+  seq        BIGINT NOT NULL,
+  address    BYTEA  NOT NULL,
+  topic0     BYTEA,
+  topic1     BYTEA,
+  topic2     BYTEA,
+  topic3     BYTEA,
+  data       BYTEA  NOT NULL,
+  block_hash BYTEA  NOT NULL,
+  tx_hash    BYTEA  NOT NULL,
+  tx_index   BIGINT NOT NULL,
+  log_index  BIGINT NOT NULL,
+
+  PRIMARY KEY (height, seq)
+);
+
+-- Indices of the log filter criteria, so that log queries over large block
+-- ranges are answered without scanning the blocks.
+CREATE INDEX IF NOT EXISTS idx_evm_logs_address ON evm_logs (address, height);
+CREATE INDEX IF NOT EXISTS idx_evm_logs_topic0 ON evm_logs (topic0, height);
+CREATE INDEX IF NOT EXISTS idx_evm_logs_topic1 ON evm_logs (topic1, height);
+CREATE INDEX IF NOT EXISTS idx_evm_logs_topic2 ON evm_logs (topic2, height);
+CREATE INDEX IF NOT EXISTS idx_evm_logs_topic3 ON evm_logs (topic3, height);
@@ -0,0 +1,382 @@
+package indexer
+
+import (
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/lib/pq"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+const (
+	// SQLDriverName is the name of the database/sql driver used by the SQL indexer.
+	SQLDriverName = "postgres"
+
+	tableBlocks = "evm_blocks"
+	tableTxs    = "evm_txs"
+	tableLogs   = "evm_logs"
+
+	// maxLogTopics is the maximum number of topics of a log.
+	maxLogTopics = 4
+)
+
+// schema is the database schema of the SQL indexer.
+//
+//go:embed schema.sql
+var schema string
+
+var (
+	_ cosmosevmtypes.EVMLogIndexer     = &SQLIndexer{}
+	_ cosmosevmtypes.HeaderHashIndexer = &SQLIndexer{}
+)
+
+// SQLIndexer implements a eth tx indexer on a PostgreSQL database. On top of
+// the eth tx results, it indexes the logs by address and topics, so that the
+// log queries are answered from the database indices.
+type SQLIndexer struct {
+	db        *sql.DB
+	logger    log.Logger
+	clientCtx client.Context
+}
+
+// NewSQLIndexer creates the SQLIndexer and the database schema if it doesn't
+// exist yet.
+func NewSQLIndexer(db *sql.DB, logger log.Logger, clientCtx client.Context) (*SQLIndexer, error) {
+	if _, err := db.Exec(schema); err != nil {
+		return nil, errorsmod.Wrap(err, "create indexer schema")
+	}
+	return &SQLIndexer{db, logger, clientCtx}, nil
+}
+
+// IndexBlock indexes the block, its header hash, the results of its eth txs
+// and the logs emitted in the block within a single database transaction.
+// Indexing a block again replaces its previous entries.
+func (s *SQLIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
+	height := block.Height
+	ethTxs := parseEthTxs(s.clientCtx, s.logger, block, txResults)
+
+	var logs []*ethtypes.Log
+	for txIndex, result := range txResults {
+		txLogs, err := evmtypes.DecodeTxLogsFromEvents(result.Data, result.Events, uint64(height)) //#nosec G115 -- int overflow is not a concern here
+		if err != nil {
+			s.logger.Error("Fail to decode logs", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+		logs = append(logs, txLogs...)
+	}
+
+	err := runInTransaction(s.db, func(dbtx *sql.Tx) error {
+		for _, table := range []string{tableLogs, tableTxs, tableBlocks} {
+			if _, err := dbtx.Exec(fmt.Sprintf("DELETE FROM %s WHERE height = $1", table), height); err != nil {
+				return errorsmod.Wrapf(err, "delete %s", table)
+			}
+		}
+
+		if _, err := dbtx.Exec("INSERT INTO evm_blocks (height) VALUES ($1)", height); err != nil {
+			return errorsmod.Wrap(err, "insert block")
+		}
+
+		if hash := block.Hash(); len(hash) > 0 {
+			if err := upsertHeaderHash(dbtx, height, common.BytesToHash(hash)); err != nil {
+				return err
+			}
+		}
+
+		for _, ethTx := range ethTxs {
+			res := ethTx.result
+			if _, err := dbtx.Exec(
+				`INSERT INTO evm_txs (tx_hash, height, tx_index, msg_index, eth_tx_index, failed, gas_used, cumulative_gas_used)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+				ethTx.hash.Bytes(), res.Height, res.TxIndex, res.MsgIndex, res.EthTxIndex, res.Failed,
+				int64(res.GasUsed),           //#nosec G115 -- int overflow is not a concern here
+				int64(res.CumulativeGasUsed), //#nosec G115 -- int overflow is not a concern here
+			); err != nil {
+				return errorsmod.Wrapf(err, "insert tx %s", ethTx.hash.Hex())
+			}
+		}
+
+		for seq, ethLog := range logs {
+			var topics [maxLogTopics][]byte
+			for i, topic := range ethLog.Topics {
+				if i == maxLogTopics {
+					break
+				}
+				topics[i] = topic.Bytes()
+			}
+
+			data := ethLog.Data
+			if data == nil {
+				data = []byte{}
+			}
+
+			if _, err := dbtx.Exec(
+				`INSERT INTO evm_logs (height, seq, address, topic0, topic1, topic2, topic3, data, block_hash, tx_hash, tx_index, log_index)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+				height, seq, ethLog.Address.Bytes(), topics[0], topics[1], topics[2], topics[3], data,
+				ethLog.BlockHash.Bytes(), ethLog.TxHash.Bytes(),
+				int64(ethLog.TxIndex), //#nosec G115 -- int overflow is not a concern here
+				int64(ethLog.Index),   //#nosec G115 -- int overflow is not a concern here
+			); err != nil {
+				return errorsmod.Wrapf(err, "insert log %d", seq)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+	return nil
+}
+
+// LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
+func (s *SQLIndexer) LastIndexedBlock() (int64, error) {
+	return s.indexedBlock("MAX")
+}
+
+// FirstIndexedBlock returns the first indexed block number, returns -1 if db is empty
+func (s *SQLIndexer) FirstIndexedBlock() (int64, error) {
+	return s.indexedBlock("MIN")
+}
+
+// GetByTxHash finds eth tx by eth tx hash
+func (s *SQLIndexer) GetByTxHash(hash common.Hash) (*cosmosevmtypes.TxResult, error) {
+	row := s.db.QueryRow(
+		`SELECT height, tx_index, msg_index, eth_tx_index, failed, gas_used, cumulative_gas_used
+		FROM evm_txs WHERE tx_hash = $1`,
+		hash.Bytes(),
+	)
+
+	txResult, err := scanTxResult(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("tx not found, hash: %s", hash.Hex())
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByTxHash %s", hash.Hex())
+	}
+	return txResult, nil
+}
+
+// GetByBlockAndIndex finds eth tx by block number and eth tx index
+func (s *SQLIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*cosmosevmtypes.TxResult, error) {
+	row := s.db.QueryRow(
+		`SELECT height, tx_index, msg_index, eth_tx_index, failed, gas_used, cumulative_gas_used
+		FROM evm_txs WHERE height = $1 AND eth_tx_index = $2`,
+		blockNumber, txIndex,
+	)
+
+	txResult, err := scanTxResult(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("tx not found, block: %d, eth-index: %d", blockNumber, txIndex)
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByBlockAndIndex %d %d", blockNumber, txIndex)
+	}
+	return txResult, nil
+}
+
+// IndexHeaderHash archives the header hash of the block at the given height
+func (s *SQLIndexer) IndexHeaderHash(height int64, hash common.Hash) error {
+	err := runInTransaction(s.db, func(dbtx *sql.Tx) error {
+		return upsertHeaderHash(dbtx, height, hash)
+	})
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexHeaderHash %d", height)
+	}
+	return nil
+}
+
+// GetHeaderHash returns the archived header hash of the block at the given
+// height, returns the empty hash if not found
+func (s *SQLIndexer) GetHeaderHash(height int64) (common.Hash, error) {
+	var hash []byte
+	err := s.db.QueryRow("SELECT hash FROM evm_header_hashes WHERE height = $1", height).Scan(&hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return common.Hash{}, nil
+	}
+	if err != nil {
+		return common.Hash{}, errorsmod.Wrapf(err, "GetHeaderHash %d", height)
+	}
+	return common.BytesToHash(hash), nil
+}
+
+// GetHeightByHeaderHash returns the height of the block with the given
+// archived header hash, returns -1 if not found
+func (s *SQLIndexer) GetHeightByHeaderHash(hash common.Hash) (int64, error) {
+	var height int64
+	err := s.db.QueryRow("SELECT height FROM evm_header_hashes WHERE hash = $1", hash.Bytes()).Scan(&height)
+	if errors.Is(err, sql.ErrNoRows) {
+		return -1, nil
+	}
+	if err != nil {
+		return 0, errorsmod.Wrapf(err, "GetHeightByHeaderHash %s", hash.Hex())
+	}
+	return height, nil
+}
+
+// GetLogs returns at most limit logs emitted within the [from, to] block range
+// that match the addresses and topics, ordered as in the blocks.
+func (s *SQLIndexer) GetLogs(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, error) {
+	// no log has more topics than the maximum, so none can match the criteria
+	if len(topics) > maxLogTopics {
+		return []*ethtypes.Log{}, nil
+	}
+
+	query, args := logsQuery(from, to, addresses, topics, limit)
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetLogs %d %d", from, to)
+	}
+	defer rows.Close()
+
+	logs := []*ethtypes.Log{}
+	for rows.Next() {
+		var (
+			height, txIndex, logIndex int64
+			address, data             []byte
+			blockHash, txHash         []byte
+			topicsBz                  [maxLogTopics][]byte
+		)
+		if err := rows.Scan(
+			&height, &address, &topicsBz[0], &topicsBz[1], &topicsBz[2], &topicsBz[3],
+			&data, &blockHash, &txHash, &txIndex, &logIndex,
+		); err != nil {
+			return nil, errorsmod.Wrapf(err, "GetLogs %d %d", from, to)
+		}
+
+		ethLog := &ethtypes.Log{
+			Address:     common.BytesToAddress(address),
+			Topics:      []common.Hash{},
+			Data:        data,
+			BlockNumber: uint64(height), //#nosec G115 -- int overflow is not a concern here
+			TxHash:      common.BytesToHash(txHash),
+			TxIndex:     uint(txIndex), //#nosec G115 -- int overflow is not a concern here
+			BlockHash:   common.BytesToHash(blockHash),
+			Index:       uint(logIndex), //#nosec G115 -- int overflow is not a concern here
+		}
+		for _, topic := range topicsBz {
+			if topic == nil {
+				break
+			}
+			ethLog.Topics = append(ethLog.Topics, common.BytesToHash(topic))
+		}
+		logs = append(logs, ethLog)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errorsmod.Wrapf(err, "GetLogs %d %d", from, to)
+	}
+	return logs, nil
+}
+
+// indexedBlock returns the result of the given aggregate function over the
+// indexed block numbers, returns -1 if db is empty
+func (s *SQLIndexer) indexedBlock(aggregate string) (int64, error) {
+	var height sql.NullInt64
+	if err := s.db.QueryRow(fmt.Sprintf("SELECT %s(height) FROM %s", aggregate, tableBlocks)).Scan(&height); err != nil {
+		return 0, errorsmod.Wrap(err, "load indexed block")
+	}
+	if !height.Valid {
+		return -1, nil
+	}
+	return height.Int64, nil
+}
+
+// logsQuery builds the query of the logs emitted within the [from, to] block
+// range that match the addresses and topics, along with its arguments. As in
+// the log filters, an empty list of addresses or topics matches anything, and
+// the logs must have at least as many topics as the criteria.
+func logsQuery(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) (string, []interface{}) {
+	conditions := []string{"height >= $1", "height <= $2"}
+	args := []interface{}{from, to}
+
+	if len(addresses) > 0 {
+		addressesBz := make(pq.ByteaArray, len(addresses))
+		for i, address := range addresses {
+			addressesBz[i] = address.Bytes()
+		}
+		args = append(args, addressesBz)
+		conditions = append(conditions, fmt.Sprintf("address = ANY($%d)", len(args)))
+	}
+
+	for i, sub := range topics {
+		column := fmt.Sprintf("topic%d", i)
+		if len(sub) == 0 {
+			conditions = append(conditions, column+" IS NOT NULL")
+			continue
+		}
+
+		topicsBz := make(pq.ByteaArray, len(sub))
+		for j, topic := range sub {
+			topicsBz[j] = topic.Bytes()
+		}
+		args = append(args, topicsBz)
+		conditions = append(conditions, fmt.Sprintf("%s = ANY($%d)", column, len(args)))
+	}
+
+	args = append(args, limit)
+	query := fmt.Sprintf(
+		`SELECT height, address, topic0, topic1, topic2, topic3, data, block_hash, tx_hash, tx_index, log_index
+		FROM %s WHERE %s ORDER BY height, seq LIMIT $%d`,
+		tableLogs, strings.Join(conditions, " AND "), len(args),
+	)
+	return query, args
+}
+
+// upsertHeaderHash archives the header hash of a block, replacing the
+// previous entry of the height.
+func upsertHeaderHash(dbtx *sql.Tx, height int64, hash common.Hash) error {
+	if _, err := dbtx.Exec(
+		`INSERT INTO evm_header_hashes (height, hash) VALUES ($1, $2)
+		ON CONFLICT (height) DO UPDATE SET hash = EXCLUDED.hash`,
+		height, hash.Bytes(),
+	); err != nil {
+		return errorsmod.Wrap(err, "upsert header hash")
+	}
+	return nil
+}
+
+// scanTxResult scans a row of the evm_txs table into a tx result.
+func scanTxResult(row *sql.Row) (*cosmosevmtypes.TxResult, error) {
+	var (
+		txResult                   cosmosevmtypes.TxResult
+		gasUsed, cumulativeGasUsed int64
+	)
+	if err := row.Scan(
+		&txResult.Height, &txResult.TxIndex, &txResult.MsgIndex, &txResult.EthTxIndex,
+		&txResult.Failed, &gasUsed, &cumulativeGasUsed,
+	); err != nil {
+		return nil, err
+	}
+	txResult.GasUsed = uint64(gasUsed)                     //#nosec G115 -- int overflow is not a concern here
+	txResult.CumulativeGasUsed = uint64(cumulativeGasUsed) //#nosec G115 -- int overflow is not a concern here
+	return &txResult, nil
+}
+
+// runInTransaction runs the query in a database transaction, which is
+// committed if the query succeeds and rolled back otherwise.
+func runInTransaction(db *sql.DB, query func(*sql.Tx) error) error {
+	dbtx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := query(dbtx); err != nil {
+		_ = dbtx.Rollback() // report the initial error, not the rollback
+		return err
+	}
+	return dbtx.Commit()
+}
@@ -0,0 +1,60 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogsQuery(t *testing.T) {
+	address := common.HexToAddress("0x1")
+	topicA := common.HexToHash("0xa")
+	topicB := common.HexToHash("0xb")
+
+	testCases := []struct {
+		name          string
+		addresses     []common.Address
+		topics        [][]common.Hash
+		expConditions string
+		expArgs       []interface{}
+	}{
+		{
+			"block range only",
+			nil,
+			nil,
+			"height >= $1 AND height <= $2",
+			[]interface{}{int64(1), int64(10), 100},
+		},
+		{
+			"addresses",
+			[]common.Address{address},
+			nil,
+			"height >= $1 AND height <= $2 AND address = ANY($3)",
+			[]interface{}{int64(1), int64(10), pq.ByteaArray{address.Bytes()}, 100},
+		},
+		{
+			"topics with wildcard",
+			nil,
+			[][]common.Hash{{}, {topicA, topicB}},
+			"height >= $1 AND height <= $2 AND topic0 IS NOT NULL AND topic1 = ANY($3)",
+			[]interface{}{int64(1), int64(10), pq.ByteaArray{topicA.Bytes(), topicB.Bytes()}, 100},
+		},
+		{
+			"addresses and topics",
+			[]common.Address{address},
+			[][]common.Hash{{topicA}},
+			"height >= $1 AND height <= $2 AND address = ANY($3) AND topic0 = ANY($4)",
+			[]interface{}{int64(1), int64(10), pq.ByteaArray{address.Bytes()}, pq.ByteaArray{topicA.Bytes()}, 100},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			query, args := logsQuery(1, 10, tc.addresses, tc.topics, 100)
+			require.Contains(t, query, "WHERE "+tc.expConditions+" ORDER BY height, seq LIMIT $")
+			require.Equal(t, tc.expArgs, args)
+		})
+	}
+}
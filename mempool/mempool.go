@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/holiman/uint256"
 
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -72,6 +74,9 @@ type EVMMempoolConfig struct {
 	AnteHandler   sdk.AnteHandler
 	BroadCastTxFn func(txs []*ethtypes.Transaction) error
 	BlockGasLimit uint64 // Block gas limit from consensus parameters
+	// LegacyPoolConfig is the configuration of the default legacy pool. If nil,
+	// the default configuration is used with the transaction journal disabled.
+	LegacyPoolConfig *legacypool.Config
 }
 
 // NewExperimentalEVMMempool creates a new unified mempool for EVM and Cosmos transactions.
@@ -109,7 +114,12 @@ func NewExperimentalEVMMempool(getCtxCallback func(height int64, prove bool) (sd
 	// Default txPool
 	txPool = config.TxPool
 	if txPool == nil {
-		legacyPool := legacypool.New(legacypool.DefaultConfig, blockchain)
+		legacyConfig := legacypool.DefaultConfig
+		legacyConfig.Journal = ""
+		if config.LegacyPoolConfig != nil {
+			legacyConfig = *config.LegacyPoolConfig
+		}
+		legacyPool := legacypool.New(legacyConfig, blockchain)
 
 		// Set up broadcast function using clientCtx
 		if config.BroadCastTxFn != nil {
@@ -196,6 +206,13 @@ func (m *ExperimentalEVMMempool) GetTxPool() *txpool.TxPool {
 	return m.txPool
 }
 
+// SubscribeQueuedTransactions registers a subscription for new EVM transactions
+// that are not executable yet, e.g. because of a nonce gap. Executable ones are
+// announced by the SubscribeTransactions method of the txpool.
+func (m *ExperimentalEVMMempool) SubscribeQueuedTransactions(ch chan<- core.NewTxsEvent) event.Subscription {
+	return m.legacyTxPool.SubscribeQueuedTransactions(ch)
+}
+
 // Insert adds a transaction to the appropriate mempool (EVM or Cosmos).
 // EVM transactions are routed to the EVM transaction pool, while all other
 // transactions are inserted into the Cosmos sdkmempool. The method assumes
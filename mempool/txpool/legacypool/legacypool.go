@@ -197,6 +197,10 @@ func (config *Config) sanitize() Config {
 		log.Warn("Sanitizing invalid txpool global queue", "provided", conf.GlobalQueue, "updated", DefaultConfig.GlobalQueue)
 		conf.GlobalQueue = DefaultConfig.GlobalQueue
 	}
+	if conf.Rejournal < time.Second {
+		log.Warn("Sanitizing invalid txpool journal time", "provided", conf.Rejournal, "updated", time.Second)
+		conf.Rejournal = time.Second
+	}
 	if conf.Lifetime < 1 {
 		log.Warn("Sanitizing invalid txpool lifetime", "provided", conf.Lifetime, "updated", DefaultConfig.Lifetime)
 		conf.Lifetime = DefaultConfig.Lifetime
@@ -231,6 +235,7 @@ type LegacyPool struct {
 	chain       BlockChain
 	gasTip      atomic.Pointer[uint256.Int]
 	txFeed      event.Feed
+	queueFeed   event.Feed
 	signer      types.Signer
 	mu          sync.RWMutex
 
@@ -245,6 +250,9 @@ type LegacyPool struct {
 	all     *lookup                      // All transactions to allow lookups
 	priced  *pricedList                  // All transactions sorted by price
 
+	journal       *journal // Journal of local transaction to back up to disk
+	replayJournal bool     // Whether the journal is waiting for the head state to be replayed
+
 	reqResetCh      chan *txpoolResetRequest
 	reqPromoteCh    chan *accountSet
 	queueTxEventCh  chan *types.Transaction
@@ -255,6 +263,8 @@ type LegacyPool struct {
 
 	changesSinceReorg int // A counter for how many drops we've performed in-between reorg.
 
+	queuedTxs []*types.Transaction // New non-executable transactions to announce in the next reorg
+
 	BroadcastTxFn func(txs []*types.Transaction) error
 }
 
@@ -287,6 +297,9 @@ func New(config Config, chain BlockChain) *LegacyPool {
 	}
 	pool.priced = newPricedList(pool.all)
 
+	if !config.NoLocals && config.Journal != "" {
+		pool.journal = newTxJournal(config.Journal)
+	}
 	return pool
 }
 
@@ -325,14 +338,40 @@ func (pool *LegacyPool) Init(gasTip uint64, head *types.Header, reserver txpool.
 	pool.currentState = statedb
 	pool.pendingNonces = newNoncer(statedb)
 
+	// Start the reorg loop early, so it can handle requests generated during
+	// journal loading.
 	pool.wg.Add(1)
 	go pool.scheduleReorgLoop()
 
+	// If journaling is enabled, load the local transactions from disk. The head
+	// state is not available until the application has loaded its latest
+	// version, in which case the journal is replayed on the first pool reset.
+	if pool.journal != nil {
+		if statedb != nil {
+			pool.loadJournal()
+		} else {
+			pool.replayJournal = true
+		}
+	}
 	pool.wg.Add(1)
 	go pool.loop()
 	return nil
 }
 
+// loadJournal replays the transactions of the journal into the pool and
+// regenerates the journal from the resulting pool contents.
+func (pool *LegacyPool) loadJournal() {
+	if err := pool.journal.load(pool.addRemotes); err != nil {
+		log.Warn("Failed to load transaction journal", "err", err)
+	}
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	if err := pool.journal.rotate(pool.local()); err != nil {
+		log.Warn("Failed to rotate transaction journal", "err", err)
+	}
+}
+
 // loop is the transaction pool's main event loop, waiting for and reacting to
 // outside blockchain events as well as for various reporting and transaction
 // eviction events.
@@ -343,11 +382,13 @@ func (pool *LegacyPool) loop() {
 		prevPending, prevQueued, prevStales int
 
 		// Start the stats reporting and transaction eviction tickers
-		report = time.NewTicker(statsReportInterval)
-		evict  = time.NewTicker(evictionInterval)
+		report  = time.NewTicker(statsReportInterval)
+		evict   = time.NewTicker(evictionInterval)
+		journal = time.NewTicker(pool.config.Rejournal)
 	)
 	defer report.Stop()
 	defer evict.Stop()
+	defer journal.Stop()
 
 	// Notify tests that the init phase is done
 	close(pool.initDoneCh)
@@ -383,6 +424,18 @@ func (pool *LegacyPool) loop() {
 				}
 			}
 			pool.mu.Unlock()
+
+		// Handle local transaction journal rotation
+		case <-journal.C:
+			if pool.journal != nil {
+				pool.mu.Lock()
+				if !pool.replayJournal {
+					if err := pool.journal.rotate(pool.local()); err != nil {
+						log.Warn("Failed to rotate local tx journal", "err", err)
+					}
+				}
+				pool.mu.Unlock()
+			}
 		}
 	}
 }
@@ -393,6 +446,9 @@ func (pool *LegacyPool) Close() error {
 	close(pool.reorgShutdownCh)
 	pool.wg.Wait()
 
+	if pool.journal != nil {
+		pool.journal.close()
+	}
 	log.Info("Transaction pool stopped")
 	return nil
 }
@@ -414,6 +470,13 @@ func (pool *LegacyPool) SubscribeTransactions(ch chan<- core.NewTxsEvent, reorgs
 	return pool.txFeed.Subscribe(ch)
 }
 
+// SubscribeQueuedTransactions registers a subscription for newly added transactions
+// that are not executable yet, e.g. because of a nonce gap. Transactions which are
+// promoted in the same reorg run are only announced by SubscribeTransactions.
+func (pool *LegacyPool) SubscribeQueuedTransactions(ch chan<- core.NewTxsEvent) event.Subscription {
+	return pool.queueFeed.Subscribe(ch)
+}
+
 // SetGasTip updates the minimum gas tip required by the transaction pool for a
 // new transaction, and drops all transactions below this threshold.
 func (pool *LegacyPool) SetGasTip(tip *big.Int) {
@@ -792,6 +855,7 @@ func (pool *LegacyPool) add(tx *types.Transaction) (replaced bool, err error) {
 		}
 		pool.all.Add(tx)
 		pool.priced.Put(tx)
+		pool.journalTx(tx)
 		pool.queueTxEvent(tx)
 		log.Trace("Pooled new executable transaction", "hash", hash, "from", from, "to", tx.To())
 
@@ -804,6 +868,8 @@ func (pool *LegacyPool) add(tx *types.Transaction) (replaced bool, err error) {
 	if err != nil {
 		return false, err
 	}
+	pool.journalTx(tx)
+	pool.queuedTxs = append(pool.queuedTxs, tx)
 
 	log.Trace("Pooled new future transaction", "hash", hash, "from", from, "to", tx.To())
 	return replaced, nil
@@ -833,6 +899,35 @@ func (pool *LegacyPool) isGapped(from common.Address, tx *types.Transaction) boo
 	return false
 }
 
+// journalTx adds the specified transaction to the local disk journal if it is
+// enabled and has already been replayed.
+//
+// Note, this method assumes the pool lock is held!
+func (pool *LegacyPool) journalTx(tx *types.Transaction) {
+	if pool.journal == nil || pool.replayJournal {
+		return
+	}
+	if err := pool.journal.insert(tx); err != nil {
+		log.Warn("Failed to journal local transaction", "err", err)
+	}
+}
+
+// local retrieves all currently known transactions, grouped by origin account.
+// All transactions reach the pool through the node's own CheckTx, so each of
+// them is considered local and backed up to the journal.
+//
+// Note, this method assumes the pool lock is held!
+func (pool *LegacyPool) local() map[common.Address]types.Transactions {
+	txs := make(map[common.Address]types.Transactions)
+	for addr, list := range pool.pending {
+		txs[addr] = append(txs[addr], list.Flatten()...)
+	}
+	for addr, list := range pool.queue {
+		txs[addr] = append(txs[addr], list.Flatten()...)
+	}
+	return txs
+}
+
 // enqueueTx inserts a new transaction into the non-executable transaction queue.
 //
 // Note, this method assumes the pool lock is held!
@@ -1310,8 +1405,28 @@ func (pool *LegacyPool) runReorg(done chan struct{}, reset *txpoolResetRequest,
 
 	dropBetweenReorgHistogram.Update(int64(pool.changesSinceReorg))
 	pool.changesSinceReorg = 0 // Reset change counter
+
+	// Gather the new transactions which are still waiting in the queue
+	var queued []*types.Transaction
+	for _, tx := range pool.queuedTxs {
+		addr, _ := types.Sender(pool.signer, tx)
+		if list := pool.queue[addr]; list != nil && list.txs.Get(tx.Nonce()) == tx {
+			queued = append(queued, tx)
+		}
+	}
+	pool.queuedTxs = nil
+
+	// Replay the journal once the head state became available
+	replayJournal := pool.replayJournal && pool.currentState != nil
+	if replayJournal {
+		pool.replayJournal = false
+	}
 	pool.mu.Unlock()
 
+	if replayJournal {
+		pool.loadJournal()
+	}
+
 	// Notify subsystems for newly added transactions
 	for _, tx := range promoted {
 		addr, _ := types.Sender(pool.signer, tx)
@@ -1338,6 +1453,9 @@ func (pool *LegacyPool) runReorg(done chan struct{}, reset *txpoolResetRequest,
 		}
 		pool.txFeed.Send(core.NewTxsEvent{Txs: txs})
 	}
+	if len(queued) > 0 {
+		pool.queueFeed.Send(core.NewTxsEvent{Txs: queued})
+	}
 }
 
 // resetInternalState initializes the internal state to the current head and reinjects transactions
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"math/big"
 	"math/rand"
+	"path/filepath"
 	"slices"
 	"sync"
 	"sync/atomic"
@@ -922,6 +923,53 @@ func TestGapFilling(t *testing.T) {
 	}
 }
 
+// Tests that new non-executable transactions are announced on the queued
+// transaction feed, and that queued ones promoted in the same run are not.
+func TestQueuedTransactionEvents(t *testing.T) {
+	t.Parallel()
+
+	// Create a test account and fund it
+	pool, key := setupPool()
+	defer pool.Close()
+
+	account := crypto.PubkeyToAddress(key.PublicKey)
+	testAddBalance(pool, account, big.NewInt(1000000))
+
+	events := make(chan core.NewTxsEvent, testTxPoolConfig.AccountQueue+5)
+	sub := pool.txFeed.Subscribe(events)
+	defer sub.Unsubscribe()
+
+	queuedEvents := make(chan core.NewTxsEvent, testTxPoolConfig.AccountQueue+5)
+	queuedSub := pool.SubscribeQueuedTransactions(queuedEvents)
+	defer queuedSub.Unsubscribe()
+
+	// Create a pending and two queued transactions with a nonce-gap in between
+	pool.addRemotesSync([]*types.Transaction{
+		transaction(0, 100000, key),
+		transaction(2, 100000, key),
+		transaction(3, 100000, key),
+	})
+	if err := validateEvents(events, 1); err != nil {
+		t.Fatalf("pending event firing failed: %v", err)
+	}
+	if err := validateEvents(queuedEvents, 2); err != nil {
+		t.Fatalf("queued event firing failed: %v", err)
+	}
+	// Fill the nonce gap, the promoted transactions are only announced as pending
+	if err := pool.addRemoteSync(transaction(1, 100000, key)); err != nil {
+		t.Fatalf("failed to add gapped transaction: %v", err)
+	}
+	if err := validateEvents(events, 3); err != nil {
+		t.Fatalf("gap-filling event firing failed: %v", err)
+	}
+	if err := validateEvents(queuedEvents, 0); err != nil {
+		t.Fatalf("queued event firing failed: %v", err)
+	}
+	if err := validatePoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
+
 // Tests that if the transaction count belonging to a single account goes above
 // some threshold, the higher transactions are dropped to prevent DOS attacks.
 func TestQueueAccountLimiting(t *testing.T) {
@@ -2591,6 +2639,132 @@ func TestSetCodeTransactionsReorg(t *testing.T) {
 	}
 }
 
+// Tests that transactions can be saved to disk and reloaded from the journal
+// after a node restart, dropping the ones that became stale in the meantime.
+func TestJournaling(t *testing.T) {
+	t.Parallel()
+
+	// Create a temporary file for the journal
+	journal := filepath.Join(t.TempDir(), "transactions.rlp")
+
+	config := testTxPoolConfig
+	config.Journal = journal
+	config.Rejournal = time.Second
+
+	// Create the original pool to inject transactions into the journal
+	statedb, _ := state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+	blockchain := newTestBlockChain(params.TestChainConfig, 1000000, statedb, new(event.Feed))
+
+	pool := New(config, blockchain)
+	pool.Init(config.PriceLimit, blockchain.CurrentBlock(), newReserver())
+
+	// Create two test accounts to ensure both pending and queued transactions are journaled
+	local, _ := crypto.GenerateKey()
+	gapped, _ := crypto.GenerateKey()
+
+	testAddBalance(pool, crypto.PubkeyToAddress(local.PublicKey), big.NewInt(1000000000))
+	testAddBalance(pool, crypto.PubkeyToAddress(gapped.PublicKey), big.NewInt(1000000000))
+
+	// Add two pending transactions and a nonce-gapped queued one
+	if errs := pool.addRemotesSync([]*types.Transaction{
+		pricedTransaction(0, 100000, big.NewInt(1), local),
+		pricedTransaction(1, 100000, big.NewInt(1), local),
+		pricedTransaction(1, 100000, big.NewInt(1), gapped),
+	}); errs[0] != nil || errs[1] != nil || errs[2] != nil {
+		t.Fatalf("failed to add transactions: %v", errs)
+	}
+	pending, queued := pool.Stats()
+	if pending != 2 {
+		t.Fatalf("pending transactions mismatched: have %d, want %d", pending, 2)
+	}
+	if queued != 1 {
+		t.Fatalf("queued transactions mismatched: have %d, want %d", queued, 1)
+	}
+	if err := validatePoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+	// Terminate the old pool, bump the local nonce and create a new pool to
+	// ensure the stale transaction is dropped from the journal
+	pool.Close()
+	statedb.SetNonce(crypto.PubkeyToAddress(local.PublicKey), 1, tracing.NonceChangeUnspecified)
+	blockchain = newTestBlockChain(params.TestChainConfig, 1000000, statedb, new(event.Feed))
+
+	pool = New(config, blockchain)
+	pool.Init(config.PriceLimit, blockchain.CurrentBlock(), newReserver())
+	<-pool.requestPromoteExecutables(newAccountSet(pool.signer, crypto.PubkeyToAddress(local.PublicKey), crypto.PubkeyToAddress(gapped.PublicKey)))
+
+	pending, queued = pool.Stats()
+	if pending != 1 {
+		t.Fatalf("pending transactions mismatched: have %d, want %d", pending, 1)
+	}
+	if queued != 1 {
+		t.Fatalf("queued transactions mismatched: have %d, want %d", queued, 1)
+	}
+	if err := validatePoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+	pool.Close()
+
+	// The journal is rotated after the load, so it should only contain the
+	// transactions that are still valid
+	var txs []*types.Transaction
+	j := newTxJournal(journal)
+	if err := j.load(func(loaded []*types.Transaction) []error {
+		txs = append(txs, loaded...)
+		return make([]error, len(loaded))
+	}); err != nil {
+		t.Fatalf("failed to load journal: %v", err)
+	}
+	if len(txs) != 2 {
+		t.Fatalf("journaled transactions mismatched: have %d, want %d", len(txs), 2)
+	}
+}
+
+// Tests that the journal is replayed on the first reset if the head state is
+// not yet available when the pool is initialized.
+func TestJournalingDeferredReplay(t *testing.T) {
+	t.Parallel()
+
+	journal := filepath.Join(t.TempDir(), "transactions.rlp")
+
+	config := testTxPoolConfig
+	config.Journal = journal
+
+	// Write a nonce-gapped transaction into the journal
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+
+	j := newTxJournal(journal)
+	if err := j.rotate(map[common.Address]types.Transactions{
+		addr: {pricedTransaction(1, 100000, big.NewInt(1), key)},
+	}); err != nil {
+		t.Fatalf("failed to write journal: %v", err)
+	}
+	j.close()
+
+	// Create a pool without head state available
+	blockchain := newTestBlockChain(params.TestChainConfig, 1000000, nil, new(event.Feed))
+
+	pool := New(config, blockchain)
+	pool.Init(config.PriceLimit, blockchain.CurrentBlock(), newReserver())
+	defer pool.Close()
+
+	if _, queued := pool.Stats(); queued != 0 {
+		t.Fatalf("queued transactions mismatched: have %d, want %d", queued, 0)
+	}
+
+	// Make the head state available and reset the pool
+	statedb, _ := state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+	statedb.SetBalance(addr, uint256.NewInt(1000000000), tracing.BalanceChangeUnspecified)
+	blockchain.statedb = statedb
+	<-pool.requestReset(nil, nil)
+	<-pool.requestPromoteExecutables(newAccountSet(pool.signer, addr))
+
+	if _, queued := pool.Stats(); queued != 1 {
+		t.Fatalf("queued transactions mismatched: have %d, want %d", queued, 1)
+	}
+}
+
 // Benchmarks the speed of validating the contents of the pending queue of the
 // transaction pool.
 func BenchmarkPendingDemotion100(b *testing.B)   { benchmarkPendingDemotion(b, 100) }
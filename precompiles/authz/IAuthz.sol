@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IAuthz contract's address.
+address constant AUTHZ_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000808;
+
+/// @dev The IAuthz contract's instance.
+IAuthz constant AUTHZ_CONTRACT = IAuthz(AUTHZ_PRECOMPILE_ADDRESS);
+
+/// @dev StakeAuthorizationType defines the staking message types that can be
+/// authorized with a StakeAuthorization. It mirrors the Cosmos SDK enum.
+enum StakeAuthorizationType {
+    /// @dev Unspecified is not a valid authorization type
+    Unspecified,
+    /// @dev Delegate authorizes MsgDelegate
+    Delegate,
+    /// @dev Undelegate authorizes MsgUndelegate
+    Undelegate,
+    /// @dev Redelegate authorizes MsgBeginRedelegate
+    Redelegate,
+    /// @dev CancelUnbondingDelegation authorizes MsgCancelUnbondingDelegation
+    CancelUnbondingDelegation
+}
+
+/// @dev GrantData defines an authorization given by a granter to a grantee.
+struct GrantData {
+    /// @dev The address of the account that gave the authorization
+    address granter;
+    /// @dev The address of the account that received the authorization
+    address grantee;
+    /// @dev The type URL of the authorization (e.g. /cosmos.authz.v1beta1.GenericAuthorization)
+    string authorizationType;
+    /// @dev The type URL of the message the authorization applies to
+    string msgTypeUrl;
+    /// @dev The JSON encoded authorization
+    string authorization;
+    /// @dev The unix timestamp in seconds at which the grant expires, 0 if it never expires
+    int64 expiration;
+}
+
+/// @author Evmos Team
+/// @title Authz Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with the Cosmos SDK authz module.
+/// We follow this same interface including four-byte function selectors, in the precompile that
+/// wraps the pallet.
+/// @custom:address 0x0000000000000000000000000000000000000808
+interface IAuthz {
+    /// @dev Emitted when an authorization is granted.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the authorized message
+    /// @param expiration The unix timestamp in seconds at which the grant expires, 0 if it never expires
+    event Grant(address indexed granter, address indexed grantee, string msgTypeUrl, int64 expiration);
+
+    /// @dev Emitted when an authorization is revoked.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the revoked message
+    event Revoke(address indexed granter, address indexed grantee, string msgTypeUrl);
+
+    /// @dev Emitted when a grantee executes messages on behalf of granters.
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrls The type URLs of the executed messages
+    event Exec(address indexed grantee, string[] msgTypeUrls);
+
+    /// @dev Grant gives a GenericAuthorization to the grantee, which authorizes the grantee
+    /// to execute any message of the given type on behalf of the granter.
+    /// @param granter The address of the granter, must be the msg.sender
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the authorized message (e.g. /cosmos.gov.v1.MsgVote)
+    /// @param expiration The unix timestamp in seconds at which the grant expires, 0 if it never expires
+    /// @return success Whether or not the grant was successful
+    function grant(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev GrantSend gives a SendAuthorization to the grantee, which authorizes the grantee
+    /// to send up to the spend limit from the granter's balance.
+    /// @param granter The address of the granter, must be the msg.sender
+    /// @param grantee The address of the grantee
+    /// @param spendLimit The maximum amount of coins the grantee can send
+    /// @param allowList The recipients the grantee can send to, any recipient if empty
+    /// @param expiration The unix timestamp in seconds at which the grant expires, 0 if it never expires
+    /// @return success Whether or not the grant was successful
+    function grantSend(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        address[] calldata allowList,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev GrantStake gives a StakeAuthorization to the grantee, which authorizes the grantee
+    /// to delegate, undelegate, redelegate or cancel unbonding delegations on behalf of the granter.
+    /// @param granter The address of the granter, must be the msg.sender
+    /// @param grantee The address of the grantee
+    /// @param authorizationType The staking message type that is authorized
+    /// @param allowList The validator operator addresses the grantee can use, mutually exclusive with denyList
+    /// @param denyList The validator operator addresses the grantee cannot use, mutually exclusive with allowList
+    /// @param maxTokens The maximum amount of bond denom tokens the grantee can use, unlimited if 0
+    /// @param expiration The unix timestamp in seconds at which the grant expires, 0 if it never expires
+    /// @return success Whether or not the grant was successful
+    function grantStake(
+        address granter,
+        address grantee,
+        StakeAuthorizationType authorizationType,
+        string[] calldata allowList,
+        string[] calldata denyList,
+        uint256 maxTokens,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev Revoke removes the authorization given by the granter to the grantee for the message type.
+    /// @param granter The address of the granter, must be the msg.sender
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the message to revoke the authorization for
+    /// @return success Whether or not the revoke was successful
+    function revoke(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl
+    ) external returns (bool success);
+
+    /// @dev Exec executes Cosmos messages on behalf of their signers, using the
+    /// authorizations given to the grantee. Every message must be signed by a
+    /// granter other than the grantee.
+    /// @param grantee The address of the grantee, must be the msg.sender
+    /// @param msgs The JSON encoded Cosmos messages to execute, including their "@type"
+    /// @return results The results of the executed messages
+    function exec(
+        address grantee,
+        bytes[] calldata msgs
+    ) external returns (bytes[] memory results);
+
+    /// @dev GetGrants returns the grants given by the granter to the grantee.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the message to filter by, all grants if empty
+    /// @param pagination Pagination configuration for the query
+    /// @return grants The list of grants
+    /// @return pageResponse Pagination information for the response
+    function getGrants(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl,
+        PageRequest calldata pagination
+    ) external view returns (GrantData[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev GetGranterGrants returns the grants given by the granter.
+    /// @param granter The address of the granter
+    /// @param pagination Pagination configuration for the query
+    /// @return grants The list of grants
+    /// @return pageResponse Pagination information for the response
+    function getGranterGrants(
+        address granter,
+        PageRequest calldata pagination
+    ) external view returns (GrantData[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev GetGranteeGrants returns the grants given to the grantee.
+    /// @param grantee The address of the grantee
+    /// @param pagination Pagination configuration for the query
+    /// @return grants The list of grants
+    /// @return pageResponse Pagination information for the response
+    function getGranteeGrants(
+        address grantee,
+        PageRequest calldata pagination
+    ) external view returns (GrantData[] memory grants, PageResponse memory pageResponse);
+}
@@ -0,0 +1,30 @@
+package authz
+
+const (
+	// ErrInvalidGranter is raised when the granter address is not valid.
+	ErrInvalidGranter = "invalid granter address: %v"
+	// ErrInvalidGrantee is raised when the grantee address is not valid.
+	ErrInvalidGrantee = "invalid grantee address: %v"
+	// ErrInvalidMsgTypeURL is raised when the message type URL is not valid.
+	ErrInvalidMsgTypeURL = "invalid message type URL: %v"
+	// ErrInvalidExpiration is raised when the expiration timestamp is not valid.
+	ErrInvalidExpiration = "invalid expiration: %v"
+	// ErrInvalidSpendLimit is raised when the spend limit of a send authorization is not valid.
+	ErrInvalidSpendLimit = "invalid spend limit: %v"
+	// ErrInvalidAllowList is raised when the allow list of an authorization is not valid.
+	ErrInvalidAllowList = "invalid allow list: %v"
+	// ErrInvalidDenyList is raised when the deny list of a stake authorization is not valid.
+	ErrInvalidDenyList = "invalid deny list: %v"
+	// ErrInvalidStakeAuthorizationType is raised when the stake authorization type is not valid.
+	ErrInvalidStakeAuthorizationType = "invalid stake authorization type: %v"
+	// ErrInvalidMaxTokens is raised when the max tokens of a stake authorization are not valid.
+	ErrInvalidMaxTokens = "invalid max tokens: %v"
+	// ErrInvalidMsgs is raised when the messages to execute are not valid.
+	ErrInvalidMsgs = "invalid messages: %v"
+	// ErrDisabledMsgType is raised when a message type cannot be granted through the precompile.
+	ErrDisabledMsgType = "message type %s cannot be granted through the authz precompile"
+	// ErrExecNotAllowedMsgType is raised when a message type cannot be executed through the precompile.
+	ErrExecNotAllowedMsgType = "message type %s cannot be executed through the authz precompile"
+	// ErrSelfExec is raised when a message to execute is signed by the grantee itself.
+	ErrSelfExec = "message %d is signed by the grantee; only messages of other accounts can be executed"
+)
@@ -0,0 +1,90 @@
+package authz
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// GetGrantsMethod defines the ABI method name for the authz Grants query.
+	GetGrantsMethod = "getGrants"
+	// GetGranterGrantsMethod defines the ABI method name for the authz GranterGrants query.
+	GetGranterGrantsMethod = "getGranterGrants"
+	// GetGranteeGrantsMethod defines the ABI method name for the authz GranteeGrants query.
+	GetGranteeGrantsMethod = "getGranteeGrants"
+)
+
+// GetGrants implements the query logic for getting the grants given by a granter
+// to a grantee, optionally filtered by message type.
+func (p *Precompile) GetGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseGrantsArgs(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.authzQuerier.Grants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(GrantsOutput).FromGrantsResponse(req, res, p.codec, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(out.Grants, out.PageResponse)
+}
+
+// GetGranterGrants implements the query logic for getting the grants given by a granter.
+func (p *Precompile) GetGranterGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseGranterGrantsArgs(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.authzQuerier.GranterGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(GrantsOutput).FromGrantAuthorizations(res.Grants, res.Pagination, p.codec, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(out.Grants, out.PageResponse)
+}
+
+// GetGranteeGrants implements the query logic for getting the grants given to a grantee.
+func (p *Precompile) GetGranteeGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseGranteeGrantsArgs(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.authzQuerier.GranteeGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(GrantsOutput).FromGrantAuthorizations(res.Grants, res.Pagination, p.codec, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(out.Grants, out.PageResponse)
+}
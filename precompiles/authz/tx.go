@@ -0,0 +1,167 @@
+package authz
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+const (
+	// GrantMethod defines the ABI method name for the authz Grant transaction
+	// with a GenericAuthorization.
+	GrantMethod = "grant"
+	// GrantSendMethod defines the ABI method name for the authz Grant transaction
+	// with a SendAuthorization.
+	GrantSendMethod = "grantSend"
+	// GrantStakeMethod defines the ABI method name for the authz Grant transaction
+	// with a StakeAuthorization.
+	GrantStakeMethod = "grantStake"
+	// RevokeMethod defines the ABI method name for the authz Revoke transaction.
+	RevokeMethod = "revoke"
+	// ExecMethod defines the ABI method name for the authz Exec transaction.
+	ExecMethod = "exec"
+)
+
+// Grant gives a GenericAuthorization for a message type from the granter to the grantee.
+func (p *Precompile) Grant(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, event, err := NewMsgGrant(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grant(ctx, contract, stateDB, method, msg, event)
+}
+
+// GrantSend gives a SendAuthorization from the granter to the grantee.
+func (p *Precompile) GrantSend(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, event, err := NewMsgGrantSend(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grant(ctx, contract, stateDB, method, msg, event)
+}
+
+// GrantStake gives a StakeAuthorization from the granter to the grantee.
+func (p *Precompile) GrantStake(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	bondDenom, err := p.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, event, err := NewMsgGrantStake(args, bondDenom, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grant(ctx, contract, stateDB, method, msg, event)
+}
+
+// Revoke removes the authorization for a message type given by the granter to the grantee.
+func (p *Precompile) Revoke(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, event, err := NewMsgRevoke(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != event.Granter {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), event.Granter.String())
+	}
+
+	if _, err = p.authzMsgServer.Revoke(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRevokeEvent(ctx, stateDB, event); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Exec executes messages on behalf of their signers, using the authorizations
+// given to the grantee.
+func (p *Precompile) Exec(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, event, err := NewMsgExec(args, p.codec, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != event.Grantee {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), event.Grantee.String())
+	}
+
+	res, err := p.authzMsgServer.Exec(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitExecEvent(ctx, stateDB, event); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Results)
+}
+
+// grant checks that the granter is the caller and stores the grant.
+func (p *Precompile) grant(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	msg *authz.MsgGrant,
+	event *EventGrant,
+) ([]byte, error) {
+	msgSender := contract.Caller()
+	if msgSender != event.Granter {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), event.Granter.String())
+	}
+
+	if _, err := p.authzMsgServer.Grant(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitGrantEvent(ctx, stateDB, event); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
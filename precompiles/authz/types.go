@@ -0,0 +1,623 @@
+package authz
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+
+	"cosmossdk.io/core/address"
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// DisabledMsgTypes defines the message types that cannot be granted through the
+// authz precompile. Messages that call back into the EVM would run on top of the
+// uncommitted state of the calling transaction, and nested executions would
+// bypass the checks of the precompile.
+var DisabledMsgTypes = []string{
+	sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
+	sdk.MsgTypeURL(&erc20types.MsgConvertERC20{}),
+	sdk.MsgTypeURL(&erc20types.MsgConvertCoin{}),
+	sdk.MsgTypeURL(&transfertypes.MsgTransfer{}),
+	sdk.MsgTypeURL(&sdkvesting.MsgCreateVestingAccount{}),
+	sdk.MsgTypeURL(&authz.MsgExec{}),
+}
+
+// ExecAllowedMsgTypes defines the message types that can be executed through the
+// authz precompile. Only messages whose handlers never call back into the EVM are
+// allowed, as those would run on top of the uncommitted state of the calling
+// transaction.
+var ExecAllowedMsgTypes = []string{
+	sdk.MsgTypeURL(&banktypes.MsgSend{}),
+	sdk.MsgTypeURL(&banktypes.MsgMultiSend{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgCancelUnbondingDelegation{}),
+	sdk.MsgTypeURL(&distributiontypes.MsgSetWithdrawAddress{}),
+	sdk.MsgTypeURL(&distributiontypes.MsgWithdrawDelegatorReward{}),
+	sdk.MsgTypeURL(&distributiontypes.MsgWithdrawValidatorCommission{}),
+	sdk.MsgTypeURL(&govv1.MsgVote{}),
+	sdk.MsgTypeURL(&govv1.MsgVoteWeighted{}),
+	sdk.MsgTypeURL(&govv1.MsgDeposit{}),
+}
+
+// EventGrant defines the event data for the Grant, GrantSend and GrantStake transactions.
+type EventGrant struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeUrl string //nolint:revive
+	Expiration int64
+}
+
+// EventRevoke defines the event data for the Revoke transaction.
+type EventRevoke struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeUrl string //nolint:revive
+}
+
+// EventExec defines the event data for the Exec transaction.
+type EventExec struct {
+	Grantee     common.Address
+	MsgTypeUrls []string //nolint:revive
+}
+
+// GrantData represents an authorization given by a granter to a grantee.
+type GrantData struct {
+	Granter           common.Address `abi:"granter"`
+	Grantee           common.Address `abi:"grantee"`
+	AuthorizationType string         `abi:"authorizationType"`
+	MsgTypeUrl        string         `abi:"msgTypeUrl"` //nolint:revive
+	Authorization     string         `abi:"authorization"`
+	Expiration        int64          `abi:"expiration"`
+}
+
+// GrantsInput defines the input for the Grants query.
+type GrantsInput struct {
+	Granter    common.Address    `abi:"granter"`
+	Grantee    common.Address    `abi:"grantee"`
+	MsgTypeUrl string            `abi:"msgTypeUrl"` //nolint:revive
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// GranterGrantsInput defines the input for the GranterGrants query.
+type GranterGrantsInput struct {
+	Granter    common.Address    `abi:"granter"`
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// GranteeGrantsInput defines the input for the GranteeGrants query.
+type GranteeGrantsInput struct {
+	Grantee    common.Address    `abi:"grantee"`
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// GrantsOutput defines the output for the Grants, GranterGrants and GranteeGrants queries.
+type GrantsOutput struct {
+	Grants       []GrantData        `abi:"grants"`
+	PageResponse query.PageResponse `abi:"pageResponse"`
+}
+
+// NewMsgGrant creates a new MsgGrant with a GenericAuthorization.
+// args: [granter, grantee, msgTypeUrl, expiration]
+func NewMsgGrant(args []interface{}, addrCdc address.Codec) (*authz.MsgGrant, *EventGrant, error) {
+	if len(args) != 4 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	granter, grantee, err := parseGranterAndGrantee(args[0], args[1])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	msgTypeURL, ok := args[2].(string)
+	if !ok || msgTypeURL == "" {
+		return nil, nil, fmt.Errorf(ErrInvalidMsgTypeURL, args[2])
+	}
+
+	expiration, ok := args[3].(int64)
+	if !ok {
+		return nil, nil, fmt.Errorf(ErrInvalidExpiration, args[3])
+	}
+
+	return newMsgGrant(granter, grantee, authz.NewGenericAuthorization(msgTypeURL), expiration, addrCdc)
+}
+
+// NewMsgGrantSend creates a new MsgGrant with a SendAuthorization.
+// args: [granter, grantee, spendLimit, allowList, expiration]
+func NewMsgGrantSend(args []interface{}, addrCdc address.Codec) (*authz.MsgGrant, *EventGrant, error) {
+	if len(args) != 5 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+
+	granter, grantee, err := parseGranterAndGrantee(args[0], args[1])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	coins, err := cmn.ToCoins(args[2])
+	if err != nil {
+		return nil, nil, fmt.Errorf(ErrInvalidSpendLimit, err)
+	}
+	spendLimit, err := cmn.NewSdkCoinsFromCoins(coins)
+	if err != nil {
+		return nil, nil, fmt.Errorf(ErrInvalidSpendLimit, err)
+	}
+
+	allowList, ok := args[3].([]common.Address)
+	if !ok {
+		return nil, nil, fmt.Errorf(ErrInvalidAllowList, args[3])
+	}
+	allowed := make([]string, len(allowList))
+	for i, addr := range allowList {
+		if allowed[i], err = addrCdc.BytesToString(addr.Bytes()); err != nil {
+			return nil, nil, fmt.Errorf(ErrInvalidAllowList, err)
+		}
+	}
+
+	expiration, ok := args[4].(int64)
+	if !ok {
+		return nil, nil, fmt.Errorf(ErrInvalidExpiration, args[4])
+	}
+
+	authorization := &banktypes.SendAuthorization{
+		SpendLimit: spendLimit,
+		AllowList:  allowed,
+	}
+
+	return newMsgGrant(granter, grantee, authorization, expiration, addrCdc)
+}
+
+// NewMsgGrantStake creates a new MsgGrant with a StakeAuthorization. The max tokens
+// are denominated in the given bond denom.
+// args: [granter, grantee, authorizationType, allowList, denyList, maxTokens, expiration]
+func NewMsgGrantStake(args []interface{}, bondDenom string, addrCdc address.Codec) (*authz.MsgGrant, *EventGrant, error) {
+	if len(args) != 7 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 7, len(args))
+	}
+
+	granter, grantee, err := parseGranterAndGrantee(args[0], args[1])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	authzType, ok := args[2].(uint8)
+	if _, found := stakingtypes.AuthorizationType_name[int32(authzType)]; !ok || !found ||
+		stakingtypes.AuthorizationType(authzType) == stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_UNSPECIFIED {
+		return nil, nil, fmt.Errorf(ErrInvalidStakeAuthorizationType, args[2])
+	}
+
+	allowList, ok := args[3].([]string)
+	if !ok {
+		return nil, nil, fmt.Errorf(ErrInvalidAllowList, args[3])
+	}
+	allowed, err := parseValidators(allowList)
+	if err != nil {
+		return nil, nil, fmt.Errorf(ErrInvalidAllowList, err)
+	}
+
+	denyList, ok := args[4].([]string)
+	if !ok {
+		return nil, nil, fmt.Errorf(ErrInvalidDenyList, args[4])
+	}
+	denied, err := parseValidators(denyList)
+	if err != nil {
+		return nil, nil, fmt.Errorf(ErrInvalidDenyList, err)
+	}
+
+	maxTokens, ok := args[5].(*big.Int)
+	if !ok || maxTokens == nil {
+		return nil, nil, fmt.Errorf(ErrInvalidMaxTokens, args[5])
+	}
+
+	expiration, ok := args[6].(int64)
+	if !ok {
+		return nil, nil, fmt.Errorf(ErrInvalidExpiration, args[6])
+	}
+
+	// a zero amount defines an authorization without a limit of tokens
+	var amount *sdk.Coin
+	if maxTokens.Sign() > 0 {
+		coin := sdk.NewCoin(bondDenom, math.NewIntFromBigInt(maxTokens))
+		amount = &coin
+	}
+
+	authorization, err := stakingtypes.NewStakeAuthorization(allowed, denied, stakingtypes.AuthorizationType(authzType), amount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return newMsgGrant(granter, grantee, authorization, expiration, addrCdc)
+}
+
+// NewMsgRevoke creates a new MsgRevoke.
+// args: [granter, grantee, msgTypeUrl]
+func NewMsgRevoke(args []interface{}, addrCdc address.Codec) (*authz.MsgRevoke, *EventRevoke, error) {
+	if len(args) != 3 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	granter, grantee, err := parseGranterAndGrantee(args[0], args[1])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	msgTypeURL, ok := args[2].(string)
+	if !ok || msgTypeURL == "" {
+		return nil, nil, fmt.Errorf(ErrInvalidMsgTypeURL, args[2])
+	}
+
+	granterAddr, err := addrCdc.BytesToString(granter.Bytes())
+	if err != nil {
+		return nil, nil, fmt.Errorf(ErrInvalidGranter, err)
+	}
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return nil, nil, fmt.Errorf(ErrInvalidGrantee, err)
+	}
+
+	msg := &authz.MsgRevoke{
+		Granter:    granterAddr,
+		Grantee:    granteeAddr,
+		MsgTypeUrl: msgTypeURL,
+	}
+
+	return msg, &EventRevoke{Granter: granter, Grantee: grantee, MsgTypeUrl: msgTypeURL}, nil
+}
+
+// NewMsgExec creates a new MsgExec from the JSON encoded messages. Every message
+// must be signed by an account other than the grantee.
+// args: [grantee, msgs]
+func NewMsgExec(args []interface{}, cdc codec.Codec, addrCdc address.Codec) (*authz.MsgExec, *EventExec, error) {
+	if len(args) != 2 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, nil, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	msgsBz, ok := args[1].([][]byte)
+	if !ok || len(msgsBz) == 0 {
+		return nil, nil, fmt.Errorf(ErrInvalidMsgs, "messages cannot be empty")
+	}
+
+	anys := make([]*codectypes.Any, len(msgsBz))
+	msgTypeURLs := make([]string, len(msgsBz))
+	for i, bz := range msgsBz {
+		var msg sdk.Msg
+		if err := cdc.UnmarshalInterfaceJSON(bz, &msg); err != nil {
+			return nil, nil, sdkerrors.Wrapf(err, "message %d", i)
+		}
+
+		msgTypeURL := sdk.MsgTypeURL(msg)
+		if !slices.Contains(ExecAllowedMsgTypes, msgTypeURL) {
+			return nil, nil, fmt.Errorf(ErrExecNotAllowedMsgType, msgTypeURL)
+		}
+
+		signers, _, err := cdc.GetMsgV1Signers(msg)
+		if err != nil {
+			return nil, nil, sdkerrors.Wrapf(err, "message %d", i)
+		}
+		for _, signer := range signers {
+			if bytes.Equal(signer, grantee.Bytes()) {
+				return nil, nil, fmt.Errorf(ErrSelfExec, i)
+			}
+		}
+
+		anyMsg, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, nil, err
+		}
+		anys[i] = anyMsg
+		msgTypeURLs[i] = msgTypeURL
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return nil, nil, fmt.Errorf(ErrInvalidGrantee, err)
+	}
+
+	msg := &authz.MsgExec{
+		Grantee: granteeAddr,
+		Msgs:    anys,
+	}
+
+	return msg, &EventExec{Grantee: grantee, MsgTypeUrls: msgTypeURLs}, nil
+}
+
+// ParseGrantsArgs parses the arguments for the Grants query.
+func ParseGrantsArgs(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authz.QueryGrantsRequest, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input GrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GrantsInput: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, input.Granter)
+	}
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	granterAddr, err := addrCdc.BytesToString(input.Granter.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidGranter, err)
+	}
+	granteeAddr, err := addrCdc.BytesToString(input.Grantee.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidGrantee, err)
+	}
+
+	return &authz.QueryGrantsRequest{
+		Granter:    granterAddr,
+		Grantee:    granteeAddr,
+		MsgTypeUrl: input.MsgTypeUrl,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// ParseGranterGrantsArgs parses the arguments for the GranterGrants query.
+func ParseGranterGrantsArgs(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authz.QueryGranterGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranterGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranterGrantsInput: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, input.Granter)
+	}
+
+	granterAddr, err := addrCdc.BytesToString(input.Granter.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidGranter, err)
+	}
+
+	return &authz.QueryGranterGrantsRequest{
+		Granter:    granterAddr,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// ParseGranteeGrantsArgs parses the arguments for the GranteeGrants query.
+func ParseGranteeGrantsArgs(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authz.QueryGranteeGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranteeGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranteeGrantsInput: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(input.Grantee.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidGrantee, err)
+	}
+
+	return &authz.QueryGranteeGrantsRequest{
+		Grantee:    granteeAddr,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// FromGrantsResponse populates the output from the response of the Grants query.
+// The response does not contain the granter and grantee, so they are taken from
+// the request.
+func (o *GrantsOutput) FromGrantsResponse(
+	req *authz.QueryGrantsRequest,
+	res *authz.QueryGrantsResponse,
+	cdc codec.Codec,
+	addrCdc address.Codec,
+) (*GrantsOutput, error) {
+	granter, err := addrCdc.StringToBytes(req.Granter)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidGranter, err)
+	}
+	grantee, err := addrCdc.StringToBytes(req.Grantee)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidGrantee, err)
+	}
+
+	o.Grants = make([]GrantData, len(res.Grants))
+	for i, grant := range res.Grants {
+		data, err := newGrantData(common.BytesToAddress(granter), common.BytesToAddress(grantee), grant.Authorization, grant.Expiration, cdc)
+		if err != nil {
+			return nil, err
+		}
+		o.Grants[i] = data
+	}
+	o.setPageResponse(res.Pagination)
+	return o, nil
+}
+
+// FromGrantAuthorizations populates the output from the grant authorizations
+// returned by the GranterGrants and GranteeGrants queries.
+func (o *GrantsOutput) FromGrantAuthorizations(
+	grants []*authz.GrantAuthorization,
+	pageRes *query.PageResponse,
+	cdc codec.Codec,
+	addrCdc address.Codec,
+) (*GrantsOutput, error) {
+	o.Grants = make([]GrantData, len(grants))
+	for i, grant := range grants {
+		granter, err := addrCdc.StringToBytes(grant.Granter)
+		if err != nil {
+			return nil, fmt.Errorf(ErrInvalidGranter, err)
+		}
+		grantee, err := addrCdc.StringToBytes(grant.Grantee)
+		if err != nil {
+			return nil, fmt.Errorf(ErrInvalidGrantee, err)
+		}
+
+		data, err := newGrantData(common.BytesToAddress(granter), common.BytesToAddress(grantee), grant.Authorization, grant.Expiration, cdc)
+		if err != nil {
+			return nil, err
+		}
+		o.Grants[i] = data
+	}
+	o.setPageResponse(pageRes)
+	return o, nil
+}
+
+func (o *GrantsOutput) setPageResponse(pageRes *query.PageResponse) {
+	if pageRes != nil {
+		o.PageResponse = query.PageResponse{
+			NextKey: pageRes.NextKey,
+			Total:   pageRes.Total,
+		}
+	}
+}
+
+// newGrantData returns the ABI representation of a grant, with the authorization
+// encoded as JSON.
+func newGrantData(
+	granter, grantee common.Address,
+	authorizationAny *codectypes.Any,
+	expiration *time.Time,
+	cdc codec.Codec,
+) (GrantData, error) {
+	if authorizationAny == nil {
+		return GrantData{}, fmt.Errorf("empty authorization")
+	}
+
+	var authorization authz.Authorization
+	if err := cdc.UnpackAny(authorizationAny, &authorization); err != nil {
+		return GrantData{}, err
+	}
+
+	authorizationJSON, err := cdc.MarshalInterfaceJSON(authorization)
+	if err != nil {
+		return GrantData{}, err
+	}
+
+	data := GrantData{
+		Granter:           granter,
+		Grantee:           grantee,
+		AuthorizationType: authorizationAny.TypeUrl,
+		MsgTypeUrl:        authorization.MsgTypeURL(),
+		Authorization:     string(authorizationJSON),
+	}
+	if expiration != nil {
+		data.Expiration = expiration.Unix()
+	}
+	return data, nil
+}
+
+// newMsgGrant creates a MsgGrant for the given authorization. An expiration of
+// zero defines a grant that never expires.
+func newMsgGrant(
+	granter, grantee common.Address,
+	authorization authz.Authorization,
+	expiration int64,
+	addrCdc address.Codec,
+) (*authz.MsgGrant, *EventGrant, error) {
+	msgTypeURL := authorization.MsgTypeURL()
+	if slices.Contains(DisabledMsgTypes, msgTypeURL) {
+		return nil, nil, fmt.Errorf(ErrDisabledMsgType, msgTypeURL)
+	}
+
+	if expiration < 0 {
+		return nil, nil, fmt.Errorf(ErrInvalidExpiration, expiration)
+	}
+	var expirationTime *time.Time
+	if expiration > 0 {
+		t := time.Unix(expiration, 0).UTC()
+		expirationTime = &t
+	}
+
+	granterAddr, err := addrCdc.BytesToString(granter.Bytes())
+	if err != nil {
+		return nil, nil, fmt.Errorf(ErrInvalidGranter, err)
+	}
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return nil, nil, fmt.Errorf(ErrInvalidGrantee, err)
+	}
+
+	authorizationAny, err := codectypes.NewAnyWithValue(authorization)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	msg := &authz.MsgGrant{
+		Granter: granterAddr,
+		Grantee: granteeAddr,
+		Grant: authz.Grant{
+			Authorization: authorizationAny,
+			Expiration:    expirationTime,
+		},
+	}
+
+	event := &EventGrant{
+		Granter:    granter,
+		Grantee:    grantee,
+		MsgTypeUrl: msgTypeURL,
+		Expiration: expiration,
+	}
+
+	return msg, event, nil
+}
+
+// parseGranterAndGrantee parses the granter and grantee addresses from the arguments.
+func parseGranterAndGrantee(granterArg, granteeArg interface{}) (granter, grantee common.Address, err error) {
+	granter, ok := granterArg.(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGranter, granterArg)
+	}
+
+	grantee, ok = granteeArg.(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGrantee, granteeArg)
+	}
+
+	return granter, grantee, nil
+}
+
+// parseValidators parses a list of bech32 validator operator addresses.
+func parseValidators(validators []string) ([]sdk.ValAddress, error) {
+	valAddrs := make([]sdk.ValAddress, len(validators))
+	for i, validator := range validators {
+		valAddr, err := sdk.ValAddressFromBech32(validator)
+		if err != nil {
+			return nil, fmt.Errorf(cmn.ErrInvalidValidator, validator)
+		}
+		valAddrs[i] = valAddr
+	}
+	return valAddrs, nil
+}
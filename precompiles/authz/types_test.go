@@ -0,0 +1,334 @@
+package authz
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	granterAddr = common.HexToAddress("0x1234567890123456789012345678901234567890")
+	granteeAddr = common.HexToAddress("0x0987654321098765432109876543210987654321")
+)
+
+func TestNewMsgGrant(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	msgTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	expiration := time.Now().Add(time.Hour).Unix()
+
+	expectedGranter, err := addrCodec.BytesToString(granterAddr.Bytes())
+	require.NoError(t, err)
+	expectedGrantee, err := addrCodec.BytesToString(granteeAddr.Bytes())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		args          []interface{}
+		wantErr       bool
+		errMsg        string
+		wantExpiresAt *time.Time
+	}{
+		{
+			name:          "valid",
+			args:          []interface{}{granterAddr, granteeAddr, msgTypeURL, expiration},
+			wantExpiresAt: func() *time.Time { t := time.Unix(expiration, 0).UTC(); return &t }(),
+		},
+		{
+			name: "valid - no expiration",
+			args: []interface{}{granterAddr, granteeAddr, msgTypeURL, int64(0)},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			name:    "invalid granter type",
+			args:    []interface{}{"granter", granteeAddr, msgTypeURL, expiration},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidGranter, "granter"),
+		},
+		{
+			name:    "empty grantee",
+			args:    []interface{}{granterAddr, common.Address{}, msgTypeURL, expiration},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidGrantee, common.Address{}),
+		},
+		{
+			name:    "empty msg type url",
+			args:    []interface{}{granterAddr, granteeAddr, "", expiration},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidMsgTypeURL, ""),
+		},
+		{
+			name:    "negative expiration",
+			args:    []interface{}{granterAddr, granteeAddr, msgTypeURL, int64(-1)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidExpiration, -1),
+		},
+		{
+			name:    "disabled msg type",
+			args:    []interface{}{granterAddr, granteeAddr, sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}), expiration},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrDisabledMsgType, sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})),
+		},
+		{
+			name:    "disabled IBC transfer msg type",
+			args:    []interface{}{granterAddr, granteeAddr, sdk.MsgTypeURL(&transfertypes.MsgTransfer{}), expiration},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrDisabledMsgType, sdk.MsgTypeURL(&transfertypes.MsgTransfer{})),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, event, err := NewMsgGrant(tt.args, addrCodec)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				require.Nil(t, msg)
+				require.Nil(t, event)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, expectedGranter, msg.Granter)
+			require.Equal(t, expectedGrantee, msg.Grantee)
+			require.Equal(t, tt.wantExpiresAt, msg.Grant.Expiration)
+
+			authorization, err := msg.GetAuthorization()
+			require.NoError(t, err)
+			require.Equal(t, authz.NewGenericAuthorization(msgTypeURL), authorization)
+
+			require.Equal(t, granterAddr, event.Granter)
+			require.Equal(t, granteeAddr, event.Grantee)
+			require.Equal(t, msgTypeURL, event.MsgTypeUrl)
+		})
+	}
+}
+
+func TestNewMsgGrantSend(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	spendLimit := []cmn.Coin{{Denom: "stake", Amount: big.NewInt(1000)}}
+	allowed := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	expectedAllowed, err := addrCodec.BytesToString(allowed.Bytes())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		args          []interface{}
+		wantErr       bool
+		errMsg        string
+		wantAllowList []string
+	}{
+		{
+			name:          "valid",
+			args:          []interface{}{granterAddr, granteeAddr, spendLimit, []common.Address{allowed}, int64(0)},
+			wantAllowList: []string{expectedAllowed},
+		},
+		{
+			name:          "valid - empty allow list",
+			args:          []interface{}{granterAddr, granteeAddr, spendLimit, []common.Address{}, int64(0)},
+			wantAllowList: []string{},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 5, 0),
+		},
+		{
+			name:    "invalid spend limit",
+			args:    []interface{}{granterAddr, granteeAddr, "1000stake", []common.Address{}, int64(0)},
+			wantErr: true,
+			errMsg:  "invalid spend limit",
+		},
+		{
+			name:    "invalid allow list",
+			args:    []interface{}{granterAddr, granteeAddr, spendLimit, []string{"cosmos1"}, int64(0)},
+			wantErr: true,
+			errMsg:  "invalid allow list",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, event, err := NewMsgGrantSend(tt.args, addrCodec)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				require.Nil(t, msg)
+				return
+			}
+
+			require.NoError(t, err)
+			authorization, err := msg.GetAuthorization()
+			require.NoError(t, err)
+
+			sendAuthorization, ok := authorization.(*banktypes.SendAuthorization)
+			require.True(t, ok)
+			require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), sendAuthorization.SpendLimit)
+			require.Equal(t, tt.wantAllowList, sendAuthorization.AllowList)
+			require.Equal(t, sdk.MsgTypeURL(&banktypes.MsgSend{}), event.MsgTypeUrl)
+		})
+	}
+}
+
+func TestNewMsgGrantStake(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	validator := sdk.ValAddress(granterAddr.Bytes()).String()
+	delegate := uint8(stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE)
+
+	tests := []struct {
+		name          string
+		args          []interface{}
+		wantErr       bool
+		errMsg        string
+		wantMaxTokens *sdk.Coin
+	}{
+		{
+			name:          "valid - allow list with max tokens",
+			args:          []interface{}{granterAddr, granteeAddr, delegate, []string{validator}, []string{}, big.NewInt(100), int64(0)},
+			wantMaxTokens: func() *sdk.Coin { c := sdk.NewInt64Coin("stake", 100); return &c }(),
+		},
+		{
+			name: "valid - deny list without max tokens",
+			args: []interface{}{granterAddr, granteeAddr, delegate, []string{}, []string{validator}, big.NewInt(0), int64(0)},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 7, 0),
+		},
+		{
+			name:    "unspecified authorization type",
+			args:    []interface{}{granterAddr, granteeAddr, uint8(0), []string{validator}, []string{}, big.NewInt(0), int64(0)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidStakeAuthorizationType, 0),
+		},
+		{
+			name:    "unknown authorization type",
+			args:    []interface{}{granterAddr, granteeAddr, uint8(5), []string{validator}, []string{}, big.NewInt(0), int64(0)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidStakeAuthorizationType, 5),
+		},
+		{
+			name:    "invalid validator in allow list",
+			args:    []interface{}{granterAddr, granteeAddr, delegate, []string{"invalid"}, []string{}, big.NewInt(0), int64(0)},
+			wantErr: true,
+			errMsg:  "invalid allow list",
+		},
+		{
+			name:    "empty allow and deny lists",
+			args:    []interface{}{granterAddr, granteeAddr, delegate, []string{}, []string{}, big.NewInt(0), int64(0)},
+			wantErr: true,
+			errMsg:  "cannot be empty",
+		},
+		{
+			name:    "invalid max tokens",
+			args:    []interface{}{granterAddr, granteeAddr, delegate, []string{validator}, []string{}, nil, int64(0)},
+			wantErr: true,
+			errMsg:  "invalid max tokens",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, event, err := NewMsgGrantStake(tt.args, "stake", addrCodec)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				require.Nil(t, msg)
+				return
+			}
+
+			require.NoError(t, err)
+			authorization, err := msg.GetAuthorization()
+			require.NoError(t, err)
+
+			stakeAuthorization, ok := authorization.(*stakingtypes.StakeAuthorization)
+			require.True(t, ok)
+			require.Equal(t, tt.wantMaxTokens, stakeAuthorization.MaxTokens)
+			require.Equal(t, sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}), event.MsgTypeUrl)
+		})
+	}
+}
+
+func TestNewMsgRevoke(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	msgTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	expectedGranter, err := addrCodec.BytesToString(granterAddr.Bytes())
+	require.NoError(t, err)
+	expectedGrantee, err := addrCodec.BytesToString(granteeAddr.Bytes())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid",
+			args: []interface{}{granterAddr, granteeAddr, msgTypeURL},
+		},
+		{
+			name:    "too many arguments",
+			args:    []interface{}{granterAddr, granteeAddr, msgTypeURL, "extra"},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 4),
+		},
+		{
+			name:    "empty granter",
+			args:    []interface{}{common.Address{}, granteeAddr, msgTypeURL},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidGranter, common.Address{}),
+		},
+		{
+			name:    "invalid msg type url type",
+			args:    []interface{}{granterAddr, granteeAddr, 1},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidMsgTypeURL, 1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, event, err := NewMsgRevoke(tt.args, addrCodec)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				require.Nil(t, msg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, expectedGranter, msg.Granter)
+			require.Equal(t, expectedGrantee, msg.Grantee)
+			require.Equal(t, msgTypeURL, msg.MsgTypeUrl)
+			require.Equal(t, &EventRevoke{Granter: granterAddr, Grantee: granteeAddr, MsgTypeUrl: msgTypeURL}, event)
+		})
+	}
+}
@@ -39,9 +39,27 @@ func (p Precompile) Approve(
 	owner := contract.Caller()
 
 	// TODO: owner should be the owner of the contract
+	if err := p.approve(ctx, owner, spender, amount); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, owner, spender, amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// approve sets the given amount as the allowance of the spender over the
+// owner's tokens. It is shared by the approve and permit methods.
+func (p Precompile) approve(
+	ctx sdk.Context,
+	owner, spender common.Address,
+	amount *big.Int,
+) error {
 	allowance, err := p.erc20Keeper.GetAllowance(ctx, p.Address(), owner, spender)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, fmt.Sprintf(ErrNoAllowanceForToken, p.tokenPair.Denom))
+		return sdkerrors.Wrap(err, fmt.Sprintf(ErrNoAllowanceForToken, p.tokenPair.Denom))
 	}
 
 	switch {
@@ -59,15 +77,7 @@ func (p Precompile) Approve(
 		err = p.setAllowance(ctx, owner, spender, amount)
 	}
 
-	if err != nil {
-		return nil, err
-	}
-
-	if err := p.EmitApprovalEvent(ctx, stateDB, owner, spender, amount); err != nil {
-		return nil, err
-	}
-
-	return method.Outputs.Pack(true)
+	return err
 }
 
 func (p Precompile) setAllowance(
@@ -37,6 +37,11 @@ const (
 	GasTotalSupply  = 2_480
 	GasBalanceOf    = 2_870
 	GasAllowance    = 3_225
+
+	// NOTE: The permit gas covers the cost of the ecrecover precompile on top of an approval.
+	GasPermit          = 11_100
+	GasNonces          = 2_400
+	GasDomainSeparator = 3_900
 )
 
 // Embed abi json file to the executable binary. Needed when importing as dependency.
@@ -126,6 +131,13 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasBalanceOf
 	case AllowanceMethod:
 		return GasAllowance
+	// EIP-2612 transactions and queries
+	case PermitMethod:
+		return GasPermit
+	case NoncesMethod:
+		return GasNonces
+	case DomainSeparatorMethod:
+		return GasDomainSeparator
 	default:
 		return 0
 	}
@@ -186,7 +198,8 @@ func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
 	case TransferMethod,
 		TransferFromMethod,
-		ApproveMethod:
+		ApproveMethod,
+		PermitMethod:
 		return true
 	default:
 		return false
@@ -222,6 +235,13 @@ func (p *Precompile) HandleMethod(
 		bz, err = p.BalanceOf(ctx, contract, stateDB, method, args)
 	case AllowanceMethod:
 		bz, err = p.Allowance(ctx, contract, stateDB, method, args)
+	// EIP-2612 transactions and queries
+	case PermitMethod:
+		bz, err = p.Permit(ctx, contract, stateDB, method, args)
+	case NoncesMethod:
+		bz, err = p.Nonces(ctx, contract, stateDB, method, args)
+	case DomainSeparatorMethod:
+		bz, err = p.DomainSeparator(ctx, contract, stateDB, method, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
@@ -0,0 +1,186 @@
+package erc20
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// PermitMethod defines the ABI method name for the EIP-2612 permit
+	// transaction.
+	PermitMethod = "permit"
+	// NoncesMethod defines the ABI method name for the EIP-2612 nonces
+	// query.
+	NoncesMethod = "nonces"
+	// DomainSeparatorMethod defines the ABI method name for the EIP-2612
+	// DOMAIN_SEPARATOR query.
+	DomainSeparatorMethod = "DOMAIN_SEPARATOR"
+
+	// PermitVersion defines the version of the EIP-712 signing domain used
+	// for the permit signatures.
+	PermitVersion = "1"
+)
+
+var (
+	// DomainTypeHash is the EIP-712 type hash of the signing domain.
+	DomainTypeHash = crypto.Keccak256Hash([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"))
+	// PermitTypeHash is the EIP-712 type hash of the permit message.
+	PermitTypeHash = crypto.Keccak256Hash([]byte("Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)"))
+)
+
+// Permit sets the given value as the allowance of the spender over the owner's
+// tokens, given the owner's signed approval as defined in EIP-2612. Anyone can
+// submit the signature, which can only be used once as it is bound to the
+// current nonce of the owner. It emits the Approval event on success.
+func (p Precompile) Permit(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, spender, value, deadline, v, r, s, err := ParsePermitArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if deadline.Cmp(big.NewInt(ctx.BlockTime().Unix())) < 0 {
+		return nil, ErrPermitExpiredDeadline
+	}
+
+	domainSeparator, err := p.domainSeparator(ctx)
+	if err != nil {
+		return nil, ConvertErrToERC20Error(err)
+	}
+
+	nonce := p.erc20Keeper.GetPermitNonce(ctx, p.Address(), owner)
+	digest := ComputePermitDigest(domainSeparator, owner, spender, value, new(big.Int).SetUint64(nonce), deadline)
+
+	signer, err := recoverSigner(digest, v, r, s)
+	if err != nil || signer != owner {
+		return nil, ErrPermitInvalidSignature
+	}
+
+	p.erc20Keeper.IncrementPermitNonce(ctx, p.Address(), owner)
+
+	if err := p.approve(ctx, owner, spender, value); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, owner, spender, value); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// Nonces returns the current nonce of the owner, which must be included in the
+// next permit signature.
+func (p Precompile) Nonces(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, err := ParseNoncesArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := p.erc20Keeper.GetPermitNonce(ctx, p.Address(), owner)
+
+	return method.Outputs.Pack(new(big.Int).SetUint64(nonce))
+}
+
+// DomainSeparator returns the EIP-712 domain separator used to sign permits.
+func (p Precompile) DomainSeparator(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	domainSeparator, err := p.domainSeparator(ctx)
+	if err != nil {
+		return nil, ConvertErrToERC20Error(err)
+	}
+
+	return method.Outputs.Pack(domainSeparator)
+}
+
+// domainSeparator returns the EIP-712 domain separator of the token, which is
+// bound to the token name, the EVM chain ID and the precompile address.
+func (p Precompile) domainSeparator(ctx sdk.Context) (common.Hash, error) {
+	name, err := p.name(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return ComputeDomainSeparator(name, evmtypes.GetEthChainConfig().ChainID, p.Address()), nil
+}
+
+// ComputeDomainSeparator computes the EIP-712 domain separator of a token with the
+// given name, deployed on the given chain at the given address.
+func ComputeDomainSeparator(name string, chainID *big.Int, verifyingContract common.Address) common.Hash {
+	return crypto.Keccak256Hash(
+		DomainTypeHash.Bytes(),
+		crypto.Keccak256([]byte(name)),
+		crypto.Keccak256([]byte(PermitVersion)),
+		common.BigToHash(chainID).Bytes(),
+		common.BytesToHash(verifyingContract.Bytes()).Bytes(),
+	)
+}
+
+// ComputePermitDigest computes the EIP-712 digest that the owner signs to approve
+// the spender with a permit.
+func ComputePermitDigest(
+	domainSeparator common.Hash,
+	owner, spender common.Address,
+	value, nonce, deadline *big.Int,
+) common.Hash {
+	structHash := crypto.Keccak256(
+		PermitTypeHash.Bytes(),
+		common.BytesToHash(owner.Bytes()).Bytes(),
+		common.BytesToHash(spender.Bytes()).Bytes(),
+		common.BigToHash(value).Bytes(),
+		common.BigToHash(nonce).Bytes(),
+		common.BigToHash(deadline).Bytes(),
+	)
+
+	return crypto.Keccak256Hash([]byte("\x19\x01"), domainSeparator.Bytes(), structHash)
+}
+
+// recoverSigner returns the address that signed the digest. As in the ecrecover
+// based implementations of EIP-2612, only v values of 27 and 28 and s values in
+// the lower half of the curve order are accepted to prevent malleability.
+func recoverSigner(digest common.Hash, v uint8, r, s [32]byte) (common.Address, error) {
+	if v != 27 && v != 28 {
+		return common.Address{}, ErrPermitInvalidSignature
+	}
+
+	recoveryID := v - 27
+	if !crypto.ValidateSignatureValues(recoveryID, new(big.Int).SetBytes(r[:]), new(big.Int).SetBytes(s[:]), true) {
+		return common.Address{}, ErrPermitInvalidSignature
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig[:32], r[:])
+	copy(sig[32:64], s[:])
+	sig[64] = recoveryID
+
+	pubKey, err := crypto.SigToPub(digest.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IFeegrant contract's address.
+address constant FEEGRANT_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000809;
+
+/// @dev The IFeegrant contract's instance.
+IFeegrant constant FEEGRANT_CONTRACT = IFeegrant(FEEGRANT_PRECOMPILE_ADDRESS);
+
+/// @dev AllowanceData defines a fee allowance given by a granter to a grantee.
+/// The periodic fields are only set for periodic allowances and the allowed
+/// messages only for allowed message allowances.
+struct AllowanceData {
+    /// @dev The address of the account that pays the fees
+    address granter;
+    /// @dev The address of the account whose fees are paid
+    address grantee;
+    /// @dev The type URL of the allowance (e.g. /cosmos.feegrant.v1beta1.BasicAllowance)
+    string allowanceType;
+    /// @dev The maximum amount of coins that can be spent, no limit if empty
+    Coin[] spendLimit;
+    /// @dev The unix timestamp in seconds at which the allowance expires, 0 if it never expires
+    int64 expiration;
+    /// @dev The duration of a period in seconds
+    int64 period;
+    /// @dev The maximum amount of coins that can be spent in a period
+    Coin[] periodSpendLimit;
+    /// @dev The amount of coins left to spend in the current period
+    Coin[] periodCanSpend;
+    /// @dev The unix timestamp in seconds at which the current period ends
+    int64 periodReset;
+    /// @dev The type URLs of the messages the allowance can pay fees for, any message if empty
+    string[] allowedMessages;
+}
+
+/// @author Evmos Team
+/// @title Feegrant Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with the Cosmos SDK feegrant module.
+/// We follow this same interface including four-byte function selectors, in the precompile that
+/// wraps the pallet.
+/// @custom:address 0x0000000000000000000000000000000000000809
+interface IFeegrant {
+    /// @dev Emitted when a fee allowance is granted.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param allowanceType The type URL of the granted allowance
+    event GrantAllowance(address indexed granter, address indexed grantee, string allowanceType);
+
+    /// @dev Emitted when a fee allowance is revoked.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    event RevokeAllowance(address indexed granter, address indexed grantee);
+
+    /// @dev GrantBasicAllowance gives a BasicAllowance to the grantee, which allows the grantee
+    /// to pay fees with the granter's funds up to the spend limit.
+    /// @param granter The address of the granter, must be the msg.sender
+    /// @param grantee The address of the grantee
+    /// @param spendLimit The maximum amount of coins that can be spent, no limit if empty
+    /// @param expiration The unix timestamp in seconds at which the allowance expires, 0 if it never expires
+    /// @return success Whether or not the grant was successful
+    function grantBasicAllowance(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev GrantPeriodicAllowance gives a PeriodicAllowance to the grantee, which limits the
+    /// amount of fees that can be paid in each period on top of a basic allowance.
+    /// @param granter The address of the granter, must be the msg.sender
+    /// @param grantee The address of the grantee
+    /// @param spendLimit The maximum amount of coins that can be spent, no limit if empty
+    /// @param expiration The unix timestamp in seconds at which the allowance expires, 0 if it never expires
+    /// @param period The duration of a period in seconds, the first period starts at the current block time
+    /// @param periodSpendLimit The maximum amount of coins that can be spent in a period
+    /// @return success Whether or not the grant was successful
+    function grantPeriodicAllowance(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        int64 expiration,
+        int64 period,
+        Coin[] calldata periodSpendLimit
+    ) external returns (bool success);
+
+    /// @dev GrantAllowedMsgAllowance gives an AllowedMsgAllowance to the grantee, which only pays
+    /// the fees of transactions made of the allowed messages. It wraps a periodic allowance if
+    /// the period is set, or a basic allowance otherwise.
+    /// @param granter The address of the granter, must be the msg.sender
+    /// @param grantee The address of the grantee
+    /// @param spendLimit The maximum amount of coins that can be spent, no limit if empty
+    /// @param expiration The unix timestamp in seconds at which the allowance expires, 0 if it never expires
+    /// @param period The duration of a period in seconds, 0 for a basic allowance
+    /// @param periodSpendLimit The maximum amount of coins that can be spent in a period, ignored if period is 0
+    /// @param allowedMessages The type URLs of the allowed messages (e.g. /cosmos.gov.v1.MsgVote)
+    /// @return success Whether or not the grant was successful
+    function grantAllowedMsgAllowance(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        int64 expiration,
+        int64 period,
+        Coin[] calldata periodSpendLimit,
+        string[] calldata allowedMessages
+    ) external returns (bool success);
+
+    /// @dev RevokeAllowance removes the fee allowance given by the granter to the grantee.
+    /// @param granter The address of the granter, must be the msg.sender
+    /// @param grantee The address of the grantee
+    /// @return success Whether or not the revoke was successful
+    function revokeAllowance(
+        address granter,
+        address grantee
+    ) external returns (bool success);
+
+    /// @dev Allowance returns the fee allowance given by the granter to the grantee.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @return allowance The fee allowance
+    function allowance(
+        address granter,
+        address grantee
+    ) external view returns (AllowanceData memory allowance);
+
+    /// @dev Allowances returns the fee allowances given to the grantee.
+    /// @param grantee The address of the grantee
+    /// @param pagination Pagination configuration for the query
+    /// @return allowances The list of fee allowances
+    /// @return pageResponse Pagination information for the response
+    function allowances(
+        address grantee,
+        PageRequest calldata pagination
+    ) external view returns (AllowanceData[] memory allowances, PageResponse memory pageResponse);
+
+    /// @dev AllowancesByGranter returns the fee allowances given by the granter.
+    /// @param granter The address of the granter
+    /// @param pagination Pagination configuration for the query
+    /// @return allowances The list of fee allowances
+    /// @return pageResponse Pagination information for the response
+    function allowancesByGranter(
+        address granter,
+        PageRequest calldata pagination
+    ) external view returns (AllowanceData[] memory allowances, PageResponse memory pageResponse);
+}
@@ -0,0 +1,20 @@
+package feegrant
+
+const (
+	// ErrInvalidGranter is raised when the granter address is not valid.
+	ErrInvalidGranter = "invalid granter address: %v"
+	// ErrInvalidGrantee is raised when the grantee address is not valid.
+	ErrInvalidGrantee = "invalid grantee address: %v"
+	// ErrInvalidSpendLimit is raised when the spend limit of an allowance is not valid.
+	ErrInvalidSpendLimit = "invalid spend limit: %v"
+	// ErrInvalidExpiration is raised when the expiration timestamp is not valid.
+	ErrInvalidExpiration = "invalid expiration: %v"
+	// ErrInvalidPeriod is raised when the period of a periodic allowance is not valid.
+	ErrInvalidPeriod = "invalid period: %v"
+	// ErrInvalidPeriodSpendLimit is raised when the period spend limit of a periodic allowance is not valid.
+	ErrInvalidPeriodSpendLimit = "invalid period spend limit: %v"
+	// ErrInvalidAllowedMessages is raised when the allowed messages of an allowance are not valid.
+	ErrInvalidAllowedMessages = "invalid allowed messages: %v"
+	// ErrUnknownAllowanceType is raised when a stored allowance has a type that is not supported by the precompile.
+	ErrUnknownAllowanceType = "unknown allowance type: %T"
+)
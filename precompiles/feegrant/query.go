@@ -0,0 +1,90 @@
+package feegrant
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// AllowanceMethod defines the ABI method name for the feegrant Allowance query.
+	AllowanceMethod = "allowance"
+	// AllowancesMethod defines the ABI method name for the feegrant Allowances query.
+	AllowancesMethod = "allowances"
+	// AllowancesByGranterMethod defines the ABI method name for the feegrant AllowancesByGranter query.
+	AllowancesByGranterMethod = "allowancesByGranter"
+)
+
+// Allowance implements the query logic for getting the fee allowance given by
+// a granter to a grantee.
+func (p *Precompile) Allowance(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseAllowanceArgs(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.feegrantQuerier.Allowance(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(AllowanceOutput).FromResponse(res, p.codec, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(out.Allowance)
+}
+
+// Allowances implements the query logic for getting the fee allowances given to a grantee.
+func (p *Precompile) Allowances(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseAllowancesArgs(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.feegrantQuerier.Allowances(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(AllowancesOutput).FromGrants(res.Allowances, res.Pagination, p.codec, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(out.Allowances, out.PageResponse)
+}
+
+// AllowancesByGranter implements the query logic for getting the fee allowances given by a granter.
+func (p *Precompile) AllowancesByGranter(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseAllowancesByGranterArgs(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.feegrantQuerier.AllowancesByGranter(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(AllowancesOutput).FromGrants(res.Allowances, res.Pagination, p.codec, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(out.Allowances, out.PageResponse)
+}
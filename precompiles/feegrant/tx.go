@@ -0,0 +1,130 @@
+package feegrant
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// GrantBasicAllowanceMethod defines the ABI method name for the feegrant GrantAllowance
+	// transaction with a BasicAllowance.
+	GrantBasicAllowanceMethod = "grantBasicAllowance"
+	// GrantPeriodicAllowanceMethod defines the ABI method name for the feegrant GrantAllowance
+	// transaction with a PeriodicAllowance.
+	GrantPeriodicAllowanceMethod = "grantPeriodicAllowance"
+	// GrantAllowedMsgAllowanceMethod defines the ABI method name for the feegrant GrantAllowance
+	// transaction with an AllowedMsgAllowance.
+	GrantAllowedMsgAllowanceMethod = "grantAllowedMsgAllowance"
+	// RevokeAllowanceMethod defines the ABI method name for the feegrant RevokeAllowance transaction.
+	RevokeAllowanceMethod = "revokeAllowance"
+)
+
+// GrantBasicAllowance gives a BasicAllowance from the granter to the grantee.
+func (p *Precompile) GrantBasicAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, event, err := NewMsgGrantBasicAllowance(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grantAllowance(ctx, contract, stateDB, method, msg, event)
+}
+
+// GrantPeriodicAllowance gives a PeriodicAllowance from the granter to the grantee.
+func (p *Precompile) GrantPeriodicAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, event, err := NewMsgGrantPeriodicAllowance(args, ctx.BlockTime(), p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grantAllowance(ctx, contract, stateDB, method, msg, event)
+}
+
+// GrantAllowedMsgAllowance gives an AllowedMsgAllowance from the granter to the grantee.
+func (p *Precompile) GrantAllowedMsgAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, event, err := NewMsgGrantAllowedMsgAllowance(args, ctx.BlockTime(), p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grantAllowance(ctx, contract, stateDB, method, msg, event)
+}
+
+// RevokeAllowance removes the fee allowance given by the granter to the grantee.
+func (p *Precompile) RevokeAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, event, err := NewMsgRevokeAllowance(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != event.Granter {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), event.Granter.String())
+	}
+
+	if _, err = p.feegrantMsgServer.RevokeAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRevokeAllowanceEvent(ctx, stateDB, event); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// grantAllowance checks that the granter is the caller and stores the allowance.
+func (p *Precompile) grantAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	msg *feegrant.MsgGrantAllowance,
+	event *EventGrantAllowance,
+) ([]byte, error) {
+	msgSender := contract.Caller()
+	if msgSender != event.Granter {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), event.Granter.String())
+	}
+
+	if _, err := p.feegrantMsgServer.GrantAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitGrantAllowanceEvent(ctx, stateDB, event); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
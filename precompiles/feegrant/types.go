@@ -0,0 +1,481 @@
+package feegrant
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// EventGrantAllowance defines the event data for the GrantBasicAllowance,
+// GrantPeriodicAllowance and GrantAllowedMsgAllowance transactions.
+type EventGrantAllowance struct {
+	Granter       common.Address
+	Grantee       common.Address
+	AllowanceType string
+}
+
+// EventRevokeAllowance defines the event data for the RevokeAllowance transaction.
+type EventRevokeAllowance struct {
+	Granter common.Address
+	Grantee common.Address
+}
+
+// AllowanceData represents a fee allowance given by a granter to a grantee.
+// The periodic fields are only populated for periodic allowances, and the
+// allowed messages only for allowed message allowances.
+type AllowanceData struct {
+	Granter          common.Address `abi:"granter"`
+	Grantee          common.Address `abi:"grantee"`
+	AllowanceType    string         `abi:"allowanceType"`
+	SpendLimit       []cmn.Coin     `abi:"spendLimit"`
+	Expiration       int64          `abi:"expiration"`
+	Period           int64          `abi:"period"`
+	PeriodSpendLimit []cmn.Coin     `abi:"periodSpendLimit"`
+	PeriodCanSpend   []cmn.Coin     `abi:"periodCanSpend"`
+	PeriodReset      int64          `abi:"periodReset"`
+	AllowedMessages  []string       `abi:"allowedMessages"`
+}
+
+// AllowancesInput defines the input for the Allowances query.
+type AllowancesInput struct {
+	Grantee    common.Address
+	Pagination query.PageRequest
+}
+
+// AllowancesByGranterInput defines the input for the AllowancesByGranter query.
+type AllowancesByGranterInput struct {
+	Granter    common.Address
+	Pagination query.PageRequest
+}
+
+// AllowanceOutput defines the output for the Allowance query.
+type AllowanceOutput struct {
+	Allowance AllowanceData
+}
+
+// AllowancesOutput defines the output for the Allowances and AllowancesByGranter queries.
+type AllowancesOutput struct {
+	Allowances   []AllowanceData
+	PageResponse query.PageResponse
+}
+
+// NewMsgGrantBasicAllowance creates a new MsgGrantAllowance with a BasicAllowance.
+// args: [granter, grantee, spendLimit, expiration]
+func NewMsgGrantBasicAllowance(args []interface{}, addrCdc address.Codec) (*feegrant.MsgGrantAllowance, *EventGrantAllowance, error) {
+	if len(args) != 4 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	granter, grantee, err := parseGranterAndGrantee(args[0], args[1])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	basic, err := newBasicAllowance(args[2], args[3])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return newMsgGrantAllowance(granter, grantee, basic, addrCdc)
+}
+
+// NewMsgGrantPeriodicAllowance creates a new MsgGrantAllowance with a PeriodicAllowance.
+// The first period starts at the given block time.
+// args: [granter, grantee, spendLimit, expiration, period, periodSpendLimit]
+func NewMsgGrantPeriodicAllowance(args []interface{}, blockTime time.Time, addrCdc address.Codec) (*feegrant.MsgGrantAllowance, *EventGrantAllowance, error) {
+	if len(args) != 6 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 6, len(args))
+	}
+
+	granter, grantee, err := parseGranterAndGrantee(args[0], args[1])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	basic, err := newBasicAllowance(args[2], args[3])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	periodic, err := newPeriodicAllowance(basic, args[4], args[5], blockTime)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return newMsgGrantAllowance(granter, grantee, periodic, addrCdc)
+}
+
+// NewMsgGrantAllowedMsgAllowance creates a new MsgGrantAllowance with an AllowedMsgAllowance.
+// The allowance wraps a PeriodicAllowance if the period is set, or a BasicAllowance otherwise.
+// args: [granter, grantee, spendLimit, expiration, period, periodSpendLimit, allowedMessages]
+func NewMsgGrantAllowedMsgAllowance(args []interface{}, blockTime time.Time, addrCdc address.Codec) (*feegrant.MsgGrantAllowance, *EventGrantAllowance, error) {
+	if len(args) != 7 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 7, len(args))
+	}
+
+	granter, grantee, err := parseGranterAndGrantee(args[0], args[1])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	basic, err := newBasicAllowance(args[2], args[3])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var allowance feegrant.FeeAllowanceI = basic
+	if period, ok := args[4].(int64); !ok || period != 0 {
+		if allowance, err = newPeriodicAllowance(basic, args[4], args[5], blockTime); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	allowedMessages, ok := args[6].([]string)
+	if !ok || len(allowedMessages) == 0 {
+		return nil, nil, fmt.Errorf(ErrInvalidAllowedMessages, args[6])
+	}
+
+	allowedMsg, err := feegrant.NewAllowedMsgAllowance(allowance, allowedMessages)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return newMsgGrantAllowance(granter, grantee, allowedMsg, addrCdc)
+}
+
+// NewMsgRevokeAllowance creates a new MsgRevokeAllowance.
+// args: [granter, grantee]
+func NewMsgRevokeAllowance(args []interface{}, addrCdc address.Codec) (*feegrant.MsgRevokeAllowance, *EventRevokeAllowance, error) {
+	if len(args) != 2 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, grantee, err := parseGranterAndGrantee(args[0], args[1])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	granterAddr, granteeAddr, err := encodeGranterAndGrantee(granter, grantee, addrCdc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	msg := &feegrant.MsgRevokeAllowance{
+		Granter: granterAddr,
+		Grantee: granteeAddr,
+	}
+
+	return msg, &EventRevokeAllowance{Granter: granter, Grantee: grantee}, nil
+}
+
+// ParseAllowanceArgs parses the arguments for the Allowance query.
+// args: [granter, grantee]
+func ParseAllowanceArgs(args []interface{}, addrCdc address.Codec) (*feegrant.QueryAllowanceRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, grantee, err := parseGranterAndGrantee(args[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	granterAddr, granteeAddr, err := encodeGranterAndGrantee(granter, grantee, addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.QueryAllowanceRequest{
+		Granter: granterAddr,
+		Grantee: granteeAddr,
+	}, nil
+}
+
+// ParseAllowancesArgs parses the arguments for the Allowances query.
+// args: [grantee, pagination]
+func ParseAllowancesArgs(method *abi.Method, args []interface{}, addrCdc address.Codec) (*feegrant.QueryAllowancesRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesInput: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(input.Grantee.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidGrantee, err)
+	}
+
+	return &feegrant.QueryAllowancesRequest{
+		Grantee:    granteeAddr,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// ParseAllowancesByGranterArgs parses the arguments for the AllowancesByGranter query.
+// args: [granter, pagination]
+func ParseAllowancesByGranterArgs(method *abi.Method, args []interface{}, addrCdc address.Codec) (*feegrant.QueryAllowancesByGranterRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesByGranterInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesByGranterInput: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, input.Granter)
+	}
+
+	granterAddr, err := addrCdc.BytesToString(input.Granter.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidGranter, err)
+	}
+
+	return &feegrant.QueryAllowancesByGranterRequest{
+		Granter:    granterAddr,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// FromResponse populates the output from the response of the Allowance query.
+func (o *AllowanceOutput) FromResponse(res *feegrant.QueryAllowanceResponse, cdc codec.Codec, addrCdc address.Codec) (*AllowanceOutput, error) {
+	if res.Allowance == nil {
+		return nil, fmt.Errorf("empty allowance")
+	}
+
+	data, err := newAllowanceData(res.Allowance, cdc, addrCdc)
+	if err != nil {
+		return nil, err
+	}
+	o.Allowance = data
+	return o, nil
+}
+
+// FromGrants populates the output from the grants returned by the Allowances
+// and AllowancesByGranter queries.
+func (o *AllowancesOutput) FromGrants(
+	grants []*feegrant.Grant,
+	pageRes *query.PageResponse,
+	cdc codec.Codec,
+	addrCdc address.Codec,
+) (*AllowancesOutput, error) {
+	o.Allowances = make([]AllowanceData, len(grants))
+	for i, grant := range grants {
+		data, err := newAllowanceData(grant, cdc, addrCdc)
+		if err != nil {
+			return nil, err
+		}
+		o.Allowances[i] = data
+	}
+
+	if pageRes != nil {
+		o.PageResponse = query.PageResponse{
+			NextKey: pageRes.NextKey,
+			Total:   pageRes.Total,
+		}
+	}
+	return o, nil
+}
+
+// newAllowanceData returns the ABI representation of a fee allowance grant.
+func newAllowanceData(grant *feegrant.Grant, cdc codec.Codec, addrCdc address.Codec) (AllowanceData, error) {
+	granter, err := addrCdc.StringToBytes(grant.Granter)
+	if err != nil {
+		return AllowanceData{}, fmt.Errorf(ErrInvalidGranter, err)
+	}
+	grantee, err := addrCdc.StringToBytes(grant.Grantee)
+	if err != nil {
+		return AllowanceData{}, fmt.Errorf(ErrInvalidGrantee, err)
+	}
+	if grant.Allowance == nil {
+		return AllowanceData{}, fmt.Errorf("empty allowance")
+	}
+
+	var allowance feegrant.FeeAllowanceI
+	if err := cdc.UnpackAny(grant.Allowance, &allowance); err != nil {
+		return AllowanceData{}, err
+	}
+
+	data := AllowanceData{
+		Granter:          common.BytesToAddress(granter),
+		Grantee:          common.BytesToAddress(grantee),
+		AllowanceType:    grant.Allowance.TypeUrl,
+		SpendLimit:       []cmn.Coin{},
+		PeriodSpendLimit: []cmn.Coin{},
+		PeriodCanSpend:   []cmn.Coin{},
+		AllowedMessages:  []string{},
+	}
+
+	if allowedMsg, ok := allowance.(*feegrant.AllowedMsgAllowance); ok {
+		data.AllowedMessages = allowedMsg.AllowedMessages
+		if allowance, err = allowedMsg.GetAllowance(); err != nil {
+			return AllowanceData{}, err
+		}
+	}
+
+	switch a := allowance.(type) {
+	case *feegrant.BasicAllowance:
+		data.setBasic(a)
+	case *feegrant.PeriodicAllowance:
+		data.setBasic(&a.Basic)
+		data.Period = int64(a.Period.Seconds())
+		data.PeriodSpendLimit = cmn.NewCoinsResponse(a.PeriodSpendLimit)
+		data.PeriodCanSpend = cmn.NewCoinsResponse(a.PeriodCanSpend)
+		data.PeriodReset = a.PeriodReset.Unix()
+	default:
+		return AllowanceData{}, fmt.Errorf(ErrUnknownAllowanceType, allowance)
+	}
+
+	return data, nil
+}
+
+func (d *AllowanceData) setBasic(basic *feegrant.BasicAllowance) {
+	d.SpendLimit = cmn.NewCoinsResponse(basic.SpendLimit)
+	if basic.Expiration != nil {
+		d.Expiration = basic.Expiration.Unix()
+	}
+}
+
+// newBasicAllowance builds a BasicAllowance. An empty spend limit defines an
+// allowance without limit and an expiration of 0 an allowance that never expires.
+func newBasicAllowance(spendLimitArg, expirationArg interface{}) (*feegrant.BasicAllowance, error) {
+	spendLimit, err := parseCoins(spendLimitArg, ErrInvalidSpendLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	expiration, ok := expirationArg.(int64)
+	if !ok || expiration < 0 {
+		return nil, fmt.Errorf(ErrInvalidExpiration, expirationArg)
+	}
+
+	basic := &feegrant.BasicAllowance{
+		SpendLimit: spendLimit,
+	}
+	if expiration != 0 {
+		exp := time.Unix(expiration, 0).UTC()
+		basic.Expiration = &exp
+	}
+
+	return basic, nil
+}
+
+// newPeriodicAllowance builds a PeriodicAllowance on top of the basic allowance.
+// The period is defined in seconds and the first period starts at the block time,
+// with the full period spend limit available. The period is rejected if its
+// duration would overflow.
+func newPeriodicAllowance(basic *feegrant.BasicAllowance, periodArg, periodSpendLimitArg interface{}, blockTime time.Time) (*feegrant.PeriodicAllowance, error) {
+	period, ok := periodArg.(int64)
+	if !ok || period <= 0 || period > math.MaxInt64/int64(time.Second) {
+		return nil, fmt.Errorf(ErrInvalidPeriod, periodArg)
+	}
+
+	periodSpendLimit, err := parseCoins(periodSpendLimitArg, ErrInvalidPeriodSpendLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	periodDuration := time.Duration(period) * time.Second
+	return &feegrant.PeriodicAllowance{
+		Basic:            *basic,
+		Period:           periodDuration,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodCanSpend:   periodSpendLimit,
+		PeriodReset:      blockTime.Add(periodDuration),
+	}, nil
+}
+
+// newMsgGrantAllowance builds the MsgGrantAllowance and the event for the given allowance.
+func newMsgGrantAllowance(
+	granter, grantee common.Address,
+	allowance proto.Message,
+	addrCdc address.Codec,
+) (*feegrant.MsgGrantAllowance, *EventGrantAllowance, error) {
+	granterAddr, granteeAddr, err := encodeGranterAndGrantee(granter, grantee, addrCdc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	allowanceAny, err := codectypes.NewAnyWithValue(allowance)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	msg := &feegrant.MsgGrantAllowance{
+		Granter:   granterAddr,
+		Grantee:   granteeAddr,
+		Allowance: allowanceAny,
+	}
+
+	return msg, &EventGrantAllowance{Granter: granter, Grantee: grantee, AllowanceType: allowanceAny.TypeUrl}, nil
+}
+
+// parseCoins parses a coin list argument. An empty list is returned as nil, which
+// defines no limit for the spend limit of a basic allowance.
+func parseCoins(arg interface{}, errFormat string) (sdk.Coins, error) {
+	coins, err := cmn.ToCoins(arg)
+	if err != nil {
+		return nil, fmt.Errorf(errFormat, err)
+	}
+	if len(coins) == 0 {
+		return nil, nil
+	}
+
+	sdkCoins, err := cmn.NewSdkCoinsFromCoins(coins)
+	if err != nil {
+		return nil, fmt.Errorf(errFormat, err)
+	}
+	return sdkCoins, nil
+}
+
+// parseGranterAndGrantee parses and validates the granter and grantee arguments.
+func parseGranterAndGrantee(granterArg, granteeArg interface{}) (common.Address, common.Address, error) {
+	granter, ok := granterArg.(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGranter, granterArg)
+	}
+
+	grantee, ok := granteeArg.(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGrantee, granteeArg)
+	}
+
+	return granter, grantee, nil
+}
+
+// encodeGranterAndGrantee returns the string representation of the granter and grantee addresses.
+func encodeGranterAndGrantee(granter, grantee common.Address, addrCdc address.Codec) (string, string, error) {
+	granterAddr, err := addrCdc.BytesToString(granter.Bytes())
+	if err != nil {
+		return "", "", fmt.Errorf(ErrInvalidGranter, err)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return "", "", fmt.Errorf(ErrInvalidGrantee, err)
+	}
+
+	return granterAddr, granteeAddr, nil
+}
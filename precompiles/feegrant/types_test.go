@@ -0,0 +1,321 @@
+package feegrant
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+)
+
+var (
+	granterAddr = common.HexToAddress("0x1234567890123456789012345678901234567890")
+	granteeAddr = common.HexToAddress("0x0987654321098765432109876543210987654321")
+)
+
+func TestNewMsgGrantBasicAllowance(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	expiration := time.Now().Add(time.Hour).Unix()
+	spendLimit := []cmn.Coin{{Denom: "atest", Amount: big.NewInt(100)}}
+
+	expectedGranter, err := addrCodec.BytesToString(granterAddr.Bytes())
+	require.NoError(t, err)
+	expectedGrantee, err := addrCodec.BytesToString(granteeAddr.Bytes())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		args          []interface{}
+		wantErr       bool
+		errMsg        string
+		wantAllowance *feegrant.BasicAllowance
+	}{
+		{
+			name: "valid",
+			args: []interface{}{granterAddr, granteeAddr, spendLimit, expiration},
+			wantAllowance: &feegrant.BasicAllowance{
+				SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atest", 100)),
+				Expiration: func() *time.Time { t := time.Unix(expiration, 0).UTC(); return &t }(),
+			},
+		},
+		{
+			name:          "valid - no spend limit and no expiration",
+			args:          []interface{}{granterAddr, granteeAddr, []cmn.Coin{}, int64(0)},
+			wantAllowance: &feegrant.BasicAllowance{},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			name:    "empty granter",
+			args:    []interface{}{common.Address{}, granteeAddr, spendLimit, expiration},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidGranter, common.Address{}),
+		},
+		{
+			name:    "invalid grantee type",
+			args:    []interface{}{granterAddr, "grantee", spendLimit, expiration},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidGrantee, "grantee"),
+		},
+		{
+			name:    "invalid spend limit",
+			args:    []interface{}{granterAddr, granteeAddr, "100atest", expiration},
+			wantErr: true,
+			errMsg:  "invalid spend limit",
+		},
+		{
+			name:    "negative expiration",
+			args:    []interface{}{granterAddr, granteeAddr, spendLimit, int64(-1)},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidExpiration, -1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, event, err := NewMsgGrantBasicAllowance(tt.args, addrCodec)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				require.Nil(t, msg)
+				require.Nil(t, event)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, expectedGranter, msg.Granter)
+			require.Equal(t, expectedGrantee, msg.Grantee)
+
+			allowance, err := msg.GetFeeAllowanceI()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantAllowance, allowance)
+
+			require.Equal(t, granterAddr, event.Granter)
+			require.Equal(t, granteeAddr, event.Grantee)
+			require.Equal(t, sdk.MsgTypeURL(&feegrant.BasicAllowance{}), event.AllowanceType)
+		})
+	}
+}
+
+func TestNewMsgGrantPeriodicAllowance(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	spendLimit := []cmn.Coin{{Denom: "atest", Amount: big.NewInt(100)}}
+	periodSpendLimit := []cmn.Coin{{Denom: "atest", Amount: big.NewInt(10)}}
+
+	tests := []struct {
+		name          string
+		args          []interface{}
+		wantErr       bool
+		errMsg        string
+		wantAllowance *feegrant.PeriodicAllowance
+	}{
+		{
+			name: "valid",
+			args: []interface{}{granterAddr, granteeAddr, spendLimit, int64(0), int64(3600), periodSpendLimit},
+			wantAllowance: &feegrant.PeriodicAllowance{
+				Basic:            feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atest", 100))},
+				Period:           time.Hour,
+				PeriodSpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atest", 10)),
+				PeriodCanSpend:   sdk.NewCoins(sdk.NewInt64Coin("atest", 10)),
+				PeriodReset:      blockTime.Add(time.Hour),
+			},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 6, 0),
+		},
+		{
+			name:    "zero period",
+			args:    []interface{}{granterAddr, granteeAddr, spendLimit, int64(0), int64(0), periodSpendLimit},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidPeriod, 0),
+		},
+		{
+			name:    "invalid period spend limit",
+			args:    []interface{}{granterAddr, granteeAddr, spendLimit, int64(0), int64(3600), "10atest"},
+			wantErr: true,
+			errMsg:  "invalid period spend limit",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, event, err := NewMsgGrantPeriodicAllowance(tt.args, blockTime, addrCodec)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				require.Nil(t, msg)
+				require.Nil(t, event)
+				return
+			}
+
+			require.NoError(t, err)
+
+			allowance, err := msg.GetFeeAllowanceI()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantAllowance, allowance)
+			require.NoError(t, allowance.ValidateBasic())
+
+			require.Equal(t, sdk.MsgTypeURL(&feegrant.PeriodicAllowance{}), event.AllowanceType)
+		})
+	}
+}
+
+func TestNewMsgGrantAllowedMsgAllowance(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	spendLimit := []cmn.Coin{{Denom: "atest", Amount: big.NewInt(100)}}
+	periodSpendLimit := []cmn.Coin{{Denom: "atest", Amount: big.NewInt(10)}}
+	allowedMessages := []string{"/cosmos.gov.v1.MsgVote"}
+
+	tests := []struct {
+		name          string
+		args          []interface{}
+		wantErr       bool
+		errMsg        string
+		wantAllowance feegrant.FeeAllowanceI
+	}{
+		{
+			name: "valid - basic allowance",
+			args: []interface{}{granterAddr, granteeAddr, spendLimit, int64(0), int64(0), []cmn.Coin{}, allowedMessages},
+			wantAllowance: &feegrant.BasicAllowance{
+				SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atest", 100)),
+			},
+		},
+		{
+			name: "valid - periodic allowance",
+			args: []interface{}{granterAddr, granteeAddr, spendLimit, int64(0), int64(60), periodSpendLimit, allowedMessages},
+			wantAllowance: &feegrant.PeriodicAllowance{
+				Basic:            feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atest", 100))},
+				Period:           time.Minute,
+				PeriodSpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atest", 10)),
+				PeriodCanSpend:   sdk.NewCoins(sdk.NewInt64Coin("atest", 10)),
+				PeriodReset:      blockTime.Add(time.Minute),
+			},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 7, 0),
+		},
+		{
+			name:    "negative period",
+			args:    []interface{}{granterAddr, granteeAddr, spendLimit, int64(0), int64(-1), periodSpendLimit, allowedMessages},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidPeriod, -1),
+		},
+		{
+			name:    "period duration overflow",
+			args:    []interface{}{granterAddr, granteeAddr, spendLimit, int64(0), int64(math.MaxInt64/int64(time.Second) + 1), periodSpendLimit, allowedMessages},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidPeriod, math.MaxInt64/int64(time.Second)+1),
+		},
+		{
+			name:    "empty allowed messages",
+			args:    []interface{}{granterAddr, granteeAddr, spendLimit, int64(0), int64(0), []cmn.Coin{}, []string{}},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidAllowedMessages, []string{}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, event, err := NewMsgGrantAllowedMsgAllowance(tt.args, blockTime, addrCodec)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				require.Nil(t, msg)
+				require.Nil(t, event)
+				return
+			}
+
+			require.NoError(t, err)
+
+			allowance, err := msg.GetFeeAllowanceI()
+			require.NoError(t, err)
+			allowedMsg, ok := allowance.(*feegrant.AllowedMsgAllowance)
+			require.True(t, ok)
+			require.Equal(t, allowedMessages, allowedMsg.AllowedMessages)
+
+			inner, err := allowedMsg.GetAllowance()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantAllowance, inner)
+
+			require.Equal(t, sdk.MsgTypeURL(&feegrant.AllowedMsgAllowance{}), event.AllowanceType)
+		})
+	}
+}
+
+func TestNewMsgRevokeAllowance(t *testing.T) {
+	addrCodec := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+
+	expectedGranter, err := addrCodec.BytesToString(granterAddr.Bytes())
+	require.NoError(t, err)
+	expectedGrantee, err := addrCodec.BytesToString(granteeAddr.Bytes())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid",
+			args: []interface{}{granterAddr, granteeAddr},
+		},
+		{
+			name:    "no arguments",
+			args:    []interface{}{},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			name:    "empty grantee",
+			args:    []interface{}{granterAddr, common.Address{}},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidGrantee, common.Address{}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, event, err := NewMsgRevokeAllowance(tt.args, addrCodec)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				require.Nil(t, msg)
+				require.Nil(t, event)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, expectedGranter, msg.Granter)
+			require.Equal(t, expectedGrantee, msg.Grantee)
+			require.Equal(t, granterAddr, event.Granter)
+			require.Equal(t, granteeAddr, event.Grantee)
+		})
+	}
+}
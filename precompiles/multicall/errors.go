@@ -0,0 +1,14 @@
+package multicall
+
+import "errors"
+
+// Errors that have formatted information are defined here as a string.
+const (
+	// ErrCannotReceiveFunds is raised when a call to the multicall precompile transfers value.
+	ErrCannotReceiveFunds = "cannot receive funds, received: %s"
+	// ErrCallFailed is raised when a call that is not allowed to fail reverts.
+	ErrCallFailed = "call %d to %s failed: %s"
+)
+
+// ErrSelfCall is raised when one of the calls targets the multicall precompile itself.
+var ErrSelfCall = errors.New("multicall precompile cannot call itself")
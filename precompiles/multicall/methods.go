@@ -0,0 +1,162 @@
+package multicall
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/holiman/uint256"
+)
+
+const (
+	// AggregateMethod defines the ABI method name to execute a batch of calls
+	// that must all succeed.
+	AggregateMethod = "aggregate"
+	// TryAggregateMethod defines the ABI method name to execute a batch of calls
+	// that are optionally allowed to fail.
+	TryAggregateMethod = "tryAggregate"
+	// Aggregate3Method defines the ABI method name to execute a batch of calls
+	// where each call defines whether it is allowed to fail.
+	Aggregate3Method = "aggregate3"
+)
+
+// Aggregate executes the calls in order and returns the current block number
+// and the data returned by each call. The whole batch reverts if any call fails.
+func (p Precompile) Aggregate(
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+	readOnly bool,
+) ([]byte, error) {
+	calls, err := ParseAggregateArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := p.execute(evm, contract, calls, readOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	returnData := make([][]byte, len(results))
+	for i, result := range results {
+		returnData[i] = result.ReturnData
+	}
+
+	return method.Outputs.Pack(evm.Context.BlockNumber, returnData)
+}
+
+// TryAggregate executes the calls in order and returns the result of each call.
+// The whole batch reverts if any call fails and success is required.
+func (p Precompile) TryAggregate(
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+	readOnly bool,
+) ([]byte, error) {
+	calls, err := ParseTryAggregateArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := p.execute(evm, contract, calls, readOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(results)
+}
+
+// Aggregate3 executes the calls in order and returns the result of each call.
+// The whole batch reverts if a call that does not allow failure fails.
+func (p Precompile) Aggregate3(
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+	readOnly bool,
+) ([]byte, error) {
+	calls, err := ParseAggregate3Args(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := p.execute(evm, contract, calls, readOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(results)
+}
+
+// execute dispatches the calls in order on behalf of the caller of the
+// precompile. The state changes of a failed call are reverted by the EVM,
+// while the changes of the successful calls remain in the state journal.
+func (p Precompile) execute(
+	evm *vm.EVM,
+	contract *vm.Contract,
+	calls []Call3,
+	readOnly bool,
+) ([]Result, error) {
+	results := make([]Result, len(calls))
+	for i, call := range calls {
+		if call.Target == p.Address() {
+			return nil, ErrSelfCall
+		}
+
+		ret, err := p.call(evm, contract, call, readOnly)
+		if err != nil && !call.AllowFailure {
+			return nil, fmt.Errorf(ErrCallFailed, i, call.Target, revertReason(ret, err))
+		}
+
+		results[i] = Result{
+			Success:    err == nil,
+			ReturnData: ret,
+		}
+	}
+
+	return results, nil
+}
+
+// call executes a single call with all but one 64th of the remaining gas, as
+// defined in EIP-150, and charges the gas it used to the precompile contract.
+func (p Precompile) call(
+	evm *vm.EVM,
+	contract *vm.Contract,
+	call Call3,
+	readOnly bool,
+) ([]byte, error) {
+	gas := contract.Gas - contract.Gas/64
+
+	var (
+		ret      []byte
+		leftOver uint64
+		err      error
+	)
+
+	if readOnly {
+		ret, leftOver, err = evm.StaticCall(contract.Caller(), call.Target, call.CallData, gas)
+	} else {
+		ret, leftOver, err = evm.Call(contract.Caller(), call.Target, call.CallData, gas, uint256.NewInt(0))
+	}
+
+	contract.UseGas(gas-leftOver, evm.Config.Tracer, tracing.GasChangeCallPrecompiledContract)
+
+	return ret, err
+}
+
+// revertReason returns the reason of a failed call, decoded from its revert
+// data when possible.
+func revertReason(ret []byte, err error) string {
+	if errors.Is(err, vm.ErrExecutionReverted) {
+		if reason, unpackErr := abi.UnpackRevert(ret); unpackErr == nil {
+			return reason
+		}
+	}
+
+	return err.Error()
+}
@@ -0,0 +1,114 @@
+package multicall
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the precompiled contract to batch calls to other
+// precompiles and contracts.
+//
+// NOTE: unlike the stateful precompiles, the multicall precompile does not
+// use a cache context nor a balance handler. Each call is dispatched through
+// the EVM, so that the precompiles it reaches run their own setup, record
+// their native balance changes and commit them to the shared state journal.
+// Wrapping the batch with a balance handler would apply those changes twice.
+type Precompile struct {
+	abi.ABI
+	baseGas uint64
+}
+
+// NewPrecompile creates a new multicall Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(baseGas uint64) (*Precompile, error) {
+	newABI, err := cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		return nil, err
+	}
+
+	if baseGas == 0 {
+		return nil, fmt.Errorf("baseGas cannot be zero")
+	}
+
+	return &Precompile{
+		ABI:     newABI,
+		baseGas: baseGas,
+	}, nil
+}
+
+// Address defines the address of the multicall precompiled contract.
+func (Precompile) Address() common.Address {
+	return common.HexToAddress(evmtypes.MulticallPrecompileAddress)
+}
+
+// RequiredGas returns the base gas of the precompile. The gas used by each
+// call is charged separately when the call is executed.
+func (p Precompile) RequiredGas(_ []byte) uint64 {
+	return p.baseGas
+}
+
+// Run executes the precompiled contract multicall methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	bz, err := p.run(evm, contract, readOnly)
+	if err != nil {
+		return cmn.ReturnRevertError(evm, err)
+	}
+
+	return bz, nil
+}
+
+func (p Precompile) run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	if value := contract.Value(); value != nil && !value.IsZero() {
+		return nil, fmt.Errorf(ErrCannotReceiveFunds, value.String())
+	}
+
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(contract.Input) < 4 {
+		return nil, vm.ErrExecutionReverted
+	}
+
+	methodID := contract.Input[:4]
+	// NOTE: this function iterates over the method map and returns
+	// the method with the given ID
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		return nil, err
+	}
+
+	argsBz := contract.Input[4:]
+	args, err := method.Inputs.Unpack(argsBz)
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	case AggregateMethod:
+		bz, err = p.Aggregate(evm, contract, method, args, readOnly)
+	case TryAggregateMethod:
+		bz, err = p.TryAggregate(evm, contract, method, args, readOnly)
+	case Aggregate3Method:
+		bz, err = p.Aggregate3(evm, contract, method, args, readOnly)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,104 @@
+package multicall
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+)
+
+// Call defines a call to a contract or precompile.
+type Call struct {
+	Target   common.Address `abi:"target"`
+	CallData []byte         `abi:"callData"`
+}
+
+// Call3 defines a call to a contract or precompile that is allowed to fail.
+type Call3 struct {
+	Target       common.Address `abi:"target"`
+	AllowFailure bool           `abi:"allowFailure"`
+	CallData     []byte         `abi:"callData"`
+}
+
+// Result defines the outcome of a call.
+type Result struct {
+	Success    bool   `abi:"success"`
+	ReturnData []byte `abi:"returnData"`
+}
+
+// AggregateInput defines the input of the aggregate method.
+type AggregateInput struct {
+	Calls []Call `abi:"calls"`
+}
+
+// TryAggregateInput defines the input of the tryAggregate method.
+type TryAggregateInput struct {
+	RequireSuccess bool   `abi:"requireSuccess"`
+	Calls          []Call `abi:"calls"`
+}
+
+// Aggregate3Input defines the input of the aggregate3 method.
+type Aggregate3Input struct {
+	Calls []Call3 `abi:"calls"`
+}
+
+// ParseAggregateArgs parses the arguments of the aggregate method and
+// returns the calls to execute.
+func ParseAggregateArgs(method *abi.Method, args []interface{}) ([]Call3, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var input AggregateInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AggregateInput struct: %s", err)
+	}
+
+	return toCalls3(input.Calls, false), nil
+}
+
+// ParseTryAggregateArgs parses the arguments of the tryAggregate method and
+// returns the calls to execute.
+func ParseTryAggregateArgs(method *abi.Method, args []interface{}) ([]Call3, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input TryAggregateInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to TryAggregateInput struct: %s", err)
+	}
+
+	return toCalls3(input.Calls, !input.RequireSuccess), nil
+}
+
+// ParseAggregate3Args parses the arguments of the aggregate3 method and
+// returns the calls to execute.
+func ParseAggregate3Args(method *abi.Method, args []interface{}) ([]Call3, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var input Aggregate3Input
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to Aggregate3Input struct: %s", err)
+	}
+
+	return input.Calls, nil
+}
+
+// toCalls3 converts the given calls into calls with the given failure policy.
+func toCalls3(calls []Call, allowFailure bool) []Call3 {
+	calls3 := make([]Call3, len(calls))
+	for i, call := range calls {
+		calls3[i] = Call3{
+			Target:       call.Target,
+			AllowFailure: allowFailure,
+			CallData:     call.CallData,
+		}
+	}
+
+	return calls3
+}
@@ -7,19 +7,25 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 
 	"github.com/cometbft/cometbft/libs/bytes"
 
 	rpctypes "github.com/cosmos/evm/rpc/types"
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // GetCode returns the contract code at the given address and block number.
@@ -41,8 +47,10 @@ func (b *Backend) GetCode(address common.Address, blockNrOrHash rpctypes.BlockNu
 	return res.Code, nil
 }
 
-// GetProof returns an account object with proof and any storage proofs
-func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.AccountResult, error) {
+// GetProof returns an account object with proof and any storage proofs. If
+// requested by the config, the Cosmos store proofs are returned along with
+// the data needed to verify them against the app hash of a block.
+func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNrOrHash rpctypes.BlockNumberOrHash, config *rpctypes.ProofConfig) (*rpctypes.AccountResult, error) {
 	blockNum, err := b.BlockNumberFromComet(blockNrOrHash)
 	if err != nil {
 		return nil, err
@@ -73,12 +81,24 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 	ctx := rpctypes.ContextWithHeight(height)
 	clientCtx := b.ClientCtx.WithHeight(height)
 
+	var envelope *rpctypes.CosmosProofEnvelope
+	if config != nil && config.CosmosProof {
+		// the app hash of the state at the queried height is committed
+		// in the header of the next block
+		envelope = &rpctypes.CosmosProofEnvelope{
+			Height:        hexutil.Uint64(height),     //#nosec G115 -- height is positive
+			AppHashHeight: hexutil.Uint64(height + 1), //#nosec G115 -- height is positive
+			Storage:       make([]rpctypes.CosmosStoreProof, len(storageKeys)),
+		}
+	}
+
 	// query storage proofs
 	storageProofs := make([]rpctypes.StorageResult, len(storageKeys))
 
 	for i, key := range storageKeys {
 		hexKey := common.HexToHash(key)
-		valueBz, proof, err := b.QueryClient.GetProof(clientCtx, evmtypes.StoreKey, evmtypes.StateKey(address, hexKey.Bytes()))
+		stateKey := evmtypes.StateKey(address, hexKey.Bytes())
+		valueBz, proof, err := b.QueryClient.GetProof(clientCtx, evmtypes.StoreKey, stateKey)
 		if err != nil {
 			return nil, err
 		}
@@ -88,6 +108,9 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 			Value: (*hexutil.Big)(new(big.Int).SetBytes(valueBz)),
 			Proof: GetHexProofs(proof),
 		}
+		if envelope != nil {
+			envelope.Storage[i] = rpctypes.NewCosmosStoreProof(evmtypes.StoreKey, stateKey, valueBz, proof)
+		}
 	}
 
 	// query EVM account
@@ -101,12 +124,41 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 	}
 
 	// query account proofs
-	accountKey := bytes.HexBytes(append(authtypes.AddressStoreKeyPrefix, address.Bytes()...))
-	_, proof, err := b.QueryClient.GetProof(clientCtx, authtypes.StoreKey, accountKey)
+	accountKey := bytes.HexBytes(rpctypes.AccountStoreKey(address))
+	accountBz, proof, err := b.QueryClient.GetProof(clientCtx, authtypes.StoreKey, accountKey)
 	if err != nil {
 		return nil, err
 	}
 
+	if envelope != nil {
+		envelope.Account = rpctypes.NewCosmosStoreProof(authtypes.StoreKey, accountKey, accountBz, proof)
+
+		// the balance and code hash of the account are kept outside of x/auth
+		balanceKey, err := rpctypes.BalanceStoreKey(address, evmtypes.GetEVMCoinDenom())
+		if err != nil {
+			return nil, err
+		}
+		if envelope.Balance, err = b.cosmosStoreProof(clientCtx, banktypes.StoreKey, balanceKey); err != nil {
+			return nil, err
+		}
+		if evmtypes.GetEVMCoinDecimals() != evmtypes.EighteenDecimals {
+			fractional, err := b.cosmosStoreProof(clientCtx, precisebanktypes.StoreKey, rpctypes.FractionalBalanceStoreKey(address))
+			if err != nil {
+				return nil, err
+			}
+			envelope.FractionalBalance = &fractional
+		}
+		if envelope.CodeHash, err = b.cosmosStoreProof(clientCtx, evmtypes.StoreKey, rpctypes.CodeHashStoreKey(address)); err != nil {
+			return nil, err
+		}
+
+		// the next header is not available yet if the latest state was queried
+		header, err := b.CometHeaderByNumber(rpctypes.BlockNumber(height + 1))
+		if err == nil && header != nil && header.Header != nil {
+			envelope.AppHash = hexutil.Bytes(header.Header.AppHash)
+		}
+	}
+
 	balance, ok := sdkmath.NewIntFromString(res.Balance)
 	if !ok {
 		return nil, errors.New("invalid balance")
@@ -120,9 +172,19 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 		Nonce:        hexutil.Uint64(res.Nonce),
 		StorageHash:  common.Hash{}, // NOTE: Cosmos EVM doesn't have a storage hash. TODO: implement?
 		StorageProof: storageProofs,
+		CosmosProof:  envelope,
 	}, nil
 }
 
+// cosmosStoreProof queries the value and proof of the given store key.
+func (b *Backend) cosmosStoreProof(clientCtx client.Context, storeName string, key []byte) (rpctypes.CosmosStoreProof, error) {
+	value, proof, err := b.QueryClient.GetProof(clientCtx, storeName, key)
+	if err != nil {
+		return rpctypes.CosmosStoreProof{}, err
+	}
+	return rpctypes.NewCosmosStoreProof(storeName, key, value, proof), nil
+}
+
 // GetStorageAt returns the contract storage at the given address, block number, and key.
 func (b *Backend) GetStorageAt(address common.Address, key string, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
 	blockNum, err := b.BlockNumberFromComet(blockNrOrHash)
@@ -144,6 +206,170 @@ func (b *Backend) GetStorageAt(address common.Address, key string, blockNrOrHash
 	return value.Bytes(), nil
 }
 
+// StorageRangeAt returns a page of the storage of the given account, ordered by
+// storage key and starting at keyStart. Intermediate states of a block are not
+// available: a txIndex of 0 returns the storage at the beginning of the block
+// and a txIndex equal to its number of Ethereum transactions returns the
+// storage at its end.
+func (b *Backend) StorageRangeAt(
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	txIndex int,
+	address common.Address,
+	keyStart hexutil.Bytes,
+	maxResult int,
+) (rpctypes.StorageRangeResult, error) {
+	height, err := b.stateHeightAtTransaction(blockNrOrHash, txIndex)
+	if err != nil {
+		return rpctypes.StorageRangeResult{}, err
+	}
+
+	var start []byte
+	if len(keyStart) > 0 {
+		start = common.BytesToHash(keyStart).Bytes()
+	}
+
+	req := &evmtypes.QueryStorageRangeRequest{
+		Address: address.String(),
+		Pagination: &query.PageRequest{
+			Key:   start,
+			Limit: rangeLimit(maxResult),
+		},
+	}
+
+	res, err := b.QueryClient.StorageRange(rpctypes.ContextWithHeight(height), req)
+	if err != nil {
+		return rpctypes.StorageRangeResult{}, err
+	}
+
+	result := rpctypes.StorageRangeResult{
+		Storage: make(rpctypes.StorageMap, len(res.Storage)),
+	}
+	for _, entry := range res.Storage {
+		key := common.HexToHash(entry.Key)
+		result.Storage[crypto.Keccak256Hash(key.Bytes())] = rpctypes.StorageEntry{
+			Key:   &key,
+			Value: common.HexToHash(entry.Value),
+		}
+	}
+
+	if res.Pagination != nil && len(res.Pagination.NextKey) > 0 {
+		nextKey := common.BytesToHash(res.Pagination.NextKey)
+		result.NextKey = &nextKey
+	}
+
+	return result, nil
+}
+
+// AccountRange returns a page of the accounts holding contract code at the end
+// of the given block, ordered by address and starting at the start address.
+func (b *Backend) AccountRange(
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	start hexutil.Bytes,
+	maxResults int,
+	nocode, nostorage bool,
+) (state.Dump, error) {
+	blockNum, err := b.BlockNumberFromComet(blockNrOrHash)
+	if err != nil {
+		return state.Dump{}, err
+	}
+
+	req := &evmtypes.QueryAccountRangeRequest{
+		Pagination: &query.PageRequest{
+			Key:   start,
+			Limit: rangeLimit(maxResults),
+		},
+		IncludeCode:    !nocode,
+		IncludeStorage: !nostorage,
+	}
+
+	res, err := b.QueryClient.AccountRange(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+	if err != nil {
+		return state.Dump{}, err
+	}
+
+	dump := state.Dump{
+		Accounts: make(map[string]state.DumpAccount, len(res.Accounts)),
+	}
+	for _, acc := range res.Accounts {
+		address := common.HexToAddress(acc.Address)
+		account := state.DumpAccount{
+			Balance:  acc.Balance,
+			Nonce:    acc.Nonce,
+			CodeHash: common.HexToHash(acc.CodeHash).Bytes(),
+			Code:     acc.Code,
+			Address:  &address,
+		}
+
+		if !nostorage {
+			account.Storage = make(map[common.Hash]string, len(acc.Storage))
+			for _, entry := range acc.Storage {
+				value := common.HexToHash(entry.Value)
+				account.Storage[common.HexToHash(entry.Key)] = common.Bytes2Hex(common.TrimLeftZeroes(value.Bytes()))
+			}
+		}
+
+		dump.Accounts[address.String()] = account
+	}
+
+	if res.Pagination != nil && len(res.Pagination.NextKey) > 0 {
+		dump.Next = res.Pagination.NextKey
+	}
+
+	return dump, nil
+}
+
+// stateHeightAtTransaction returns the height of the state in which the
+// transaction at txIndex of the given block is executed. Only the states at
+// the beginning and at the end of the block are available.
+func (b *Backend) stateHeightAtTransaction(blockNrOrHash rpctypes.BlockNumberOrHash, txIndex int) (int64, error) {
+	blockNum, err := b.BlockNumberFromComet(blockNrOrHash)
+	if err != nil {
+		return 0, err
+	}
+
+	resBlock, err := b.CometBlockByNumber(blockNum)
+	if err != nil {
+		return 0, err
+	}
+
+	if resBlock == nil {
+		return 0, fmt.Errorf("block not found for height %d", blockNum)
+	}
+
+	height := resBlock.Block.Height
+	if txIndex == 0 {
+		// the state at the beginning of a block is the one committed by its parent
+		if height <= 1 {
+			return 0, fmt.Errorf("state before block %d is not available", height)
+		}
+		return height - 1, nil
+	}
+
+	blockRes, err := b.RPCClient.BlockResults(b.Ctx, &height)
+	if err != nil {
+		return 0, fmt.Errorf("block result not found for height %d", height)
+	}
+
+	txCount := len(b.EthMsgsFromCometBlock(resBlock, blockRes))
+	if txIndex != txCount {
+		return 0, fmt.Errorf(
+			"state at transaction index %d of block %d is not available, use 0 or %d for the state at the beginning or at the end of the block",
+			txIndex, height, txCount,
+		)
+	}
+
+	return height, nil
+}
+
+// rangeLimit returns the page size of the range queries, capped to the
+// maximum page size enforced by the EVM module.
+func rangeLimit(maxResults int) uint64 {
+	if maxResults <= 0 || maxResults > evmtypes.MaxRangeQueryLimit {
+		return evmtypes.MaxRangeQueryLimit
+	}
+	return uint64(maxResults)
+}
+
 // GetBalance returns the provided account's *spendable* balance up to the provided block number.
 func (b *Backend) GetBalance(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Big, error) {
 	blockNum, err := b.BlockNumberFromComet(blockNrOrHash)
@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/trie"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
@@ -245,15 +246,23 @@ func (b *Backend) BlockNumberFromComet(blockNrOrHash rpctypes.BlockNumberOrHash)
 	}
 }
 
-// BlockNumberFromCometByHash returns the block height of given block hash
+// BlockNumberFromCometByHash returns the block height of given block hash. The
+// hashes of the blocks pruned from the CometBFT block store are looked up in
+// the header hashes archived by the indexer, if any.
 func (b *Backend) BlockNumberFromCometByHash(blockHash common.Hash) (*big.Int, error) {
 	resHeader, err := b.RPCClient.HeaderByHash(b.Ctx, blockHash.Bytes())
-	if err != nil {
-		return nil, err
+	if err == nil && (resHeader == nil || resHeader.Header == nil) {
+		err = errors.Errorf("header not found for hash %s", blockHash.Hex())
 	}
 
-	if resHeader == nil || resHeader.Header == nil {
-		return nil, errors.Errorf("header not found for hash %s", blockHash.Hex())
+	if err != nil {
+		if hashIndexer, ok := b.Indexer.(cosmosevmtypes.HeaderHashIndexer); ok {
+			height, archiveErr := hashIndexer.GetHeightByHeaderHash(blockHash)
+			if archiveErr == nil && height >= 0 {
+				return big.NewInt(height), nil
+			}
+		}
+		return nil, err
 	}
 
 	return big.NewInt(resHeader.Header.Height), nil
@@ -583,20 +592,8 @@ func (b *Backend) formatTxReceipt(
 	blockHeaderHash string,
 ) (map[string]interface{}, error) {
 	ethTx := ethMsg.AsTransaction()
-	cumulativeGasUsed := uint64(0)
-
-	for _, txResult := range blockRes.TxsResults[0:txResult.TxIndex] {
-		cumulativeGasUsed += uint64(txResult.GasUsed) // #nosec G115 -- checked for int overflow already
-	}
-
-	cumulativeGasUsed += txResult.CumulativeGasUsed
-
-	var status hexutil.Uint
-	if txResult.Failed {
-		status = hexutil.Uint(ethtypes.ReceiptStatusFailed)
-	} else {
-		status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
-	}
+	ethReceipt := b.ethReceiptFromTxResult(ethMsg, txResult, blockRes)
+	logs := ethReceipt.Logs
 
 	chainID, err := b.ChainID()
 	if err != nil {
@@ -608,13 +605,6 @@ func (b *Backend) formatTxReceipt(
 		return nil, err
 	}
 
-	// parse tx logs from events
-	msgIndex := int(txResult.MsgIndex) // #nosec G115 -- checked for int overflow already
-	logs, err := evmtypes.TxLogsFromEvents(blockRes.TxsResults[txResult.TxIndex].Events, msgIndex)
-	if err != nil {
-		b.Logger.Debug("failed to parse logs", "hash", ethMsg.Hash().String(), "error", err.Error())
-	}
-
 	// return error if still unable to find the eth tx index
 	if txResult.EthTxIndex == -1 {
 		return nil, fmt.Errorf("can't find index of ethereum tx")
@@ -622,9 +612,9 @@ func (b *Backend) formatTxReceipt(
 
 	receipt := map[string]interface{}{
 		// Consensus fields: These fields are defined by the Yellow Paper
-		"status":            status,
-		"cumulativeGasUsed": hexutil.Uint64(cumulativeGasUsed),
-		"logsBloom":         ethtypes.CreateBloom(&ethtypes.Receipt{Logs: logs}),
+		"status":            hexutil.Uint(ethReceipt.Status),
+		"cumulativeGasUsed": hexutil.Uint64(ethReceipt.CumulativeGasUsed),
+		"logsBloom":         ethReceipt.Bloom,
 		"logs":              logs,
 
 		// Implementation fields: These fields are added by geth when processing a transaction.
@@ -671,3 +661,104 @@ func (b *Backend) formatTxReceipt(
 
 	return receipt, nil
 }
+
+// ethReceiptFromTxResult rebuilds the consensus fields of the Ethereum receipt
+// of a transaction from its indexed result and the results of its block. The
+// cumulative gas used accounts for all the transactions preceding it in the
+// block, including the Cosmos ones.
+func (b *Backend) ethReceiptFromTxResult(
+	ethMsg *evmtypes.MsgEthereumTx,
+	txResult *cosmosevmtypes.TxResult,
+	blockRes *cmtrpctypes.ResultBlockResults,
+) *ethtypes.Receipt {
+	cumulativeGasUsed := uint64(0)
+	for _, res := range blockRes.TxsResults[0:txResult.TxIndex] {
+		cumulativeGasUsed += uint64(res.GasUsed) // #nosec G115 -- checked for int overflow already
+	}
+	cumulativeGasUsed += txResult.CumulativeGasUsed
+
+	status := ethtypes.ReceiptStatusSuccessful
+	if txResult.Failed {
+		status = ethtypes.ReceiptStatusFailed
+	}
+
+	// parse tx logs from events
+	msgIndex := int(txResult.MsgIndex) // #nosec G115 -- checked for int overflow already
+	logs, err := evmtypes.TxLogsFromEvents(blockRes.TxsResults[txResult.TxIndex].Events, msgIndex)
+	if err != nil {
+		b.Logger.Debug("failed to parse logs", "hash", ethMsg.Hash().String(), "error", err.Error())
+	}
+
+	receipt := &ethtypes.Receipt{
+		Type:              ethMsg.AsTransaction().Type(),
+		Status:            status,
+		CumulativeGasUsed: cumulativeGasUsed,
+		Logs:              logs,
+	}
+	receipt.Bloom = ethtypes.CreateBloom(receipt)
+
+	return receipt
+}
+
+// GetRawReceipts returns the EIP-2718 binary encoded receipts of the Ethereum
+// transactions included in the given block.
+func (b *Backend) GetRawReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]hexutil.Bytes, error) {
+	blockNum, err := b.BlockNumberFromComet(blockNrOrHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block number from hash: %w", err)
+	}
+
+	resBlock, err := b.CometBlockByNumber(blockNum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block by number: %w", err)
+	}
+
+	if resBlock == nil {
+		return nil, fmt.Errorf("block not found for height %d", *blockNum.CmtHeight())
+	}
+
+	blockRes, err := b.RPCClient.BlockResults(b.Ctx, &resBlock.Block.Height)
+	if err != nil {
+		return nil, fmt.Errorf("block result not found for height %d", resBlock.Block.Height)
+	}
+
+	msgs := b.EthMsgsFromCometBlock(resBlock, blockRes)
+	result := make([]hexutil.Bytes, len(msgs))
+	for i, msg := range msgs {
+		txResult, err := b.GetTxByEthHash(msg.Hash())
+		if err != nil {
+			return nil, fmt.Errorf("tx not found: hash=%s, error=%s", msg.Hash(), err.Error())
+		}
+
+		result[i], err = b.ethReceiptFromTxResult(msg, txResult, blockRes).MarshalBinary()
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode receipt for tx %s: %w", msg.Hash().Hex(), err)
+		}
+	}
+
+	return result, nil
+}
+
+// GetRawHeader returns the RLP encoded Ethereum header of the given block.
+func (b *Backend) GetRawHeader(blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
+	var (
+		header *ethtypes.Header
+		err    error
+	)
+
+	if blockNrOrHash.BlockHash != nil {
+		header, err = b.HeaderByHash(*blockNrOrHash.BlockHash)
+	} else {
+		var blockNum rpctypes.BlockNumber
+		blockNum, err = b.BlockNumberFromComet(blockNrOrHash)
+		if err != nil {
+			return nil, err
+		}
+		header, err = b.HeaderByNumber(blockNum)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return rlp.EncodeToBytes(header)
+}
@@ -17,6 +17,7 @@ import (
 	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 
 	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/utils"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
@@ -201,6 +202,18 @@ func (b *Backend) FeeHistory(
 	blockStart := blockEnd + 1 - blocks
 	oldestBlock := (*hexutil.Big)(big.NewInt(blockStart))
 
+	// the rewards require the block txs, otherwise the fee history recorded by
+	// the fee market module is used if it covers the block range
+	if len(rewardPercentiles) == 0 {
+		if baseFees, gasUsedRatios, ok := b.recordedFeeHistory(blockStart, blockEnd); ok {
+			return &rpctypes.FeeHistoryResult{
+				OldestBlock:  oldestBlock,
+				BaseFee:      baseFees,
+				GasUsedRatio: gasUsedRatios,
+			}, nil
+		}
+	}
+
 	// prepare space
 	reward := make([][]*hexutil.Big, blocks)
 	rewardCount := len(rewardPercentiles)
@@ -308,6 +321,99 @@ func (b *Backend) FeeHistory(
 	return &feeHistory, nil
 }
 
+// recordedFeeHistory returns the base fees, including the one of the block
+// following the range, and the gas used ratios of the given block range from
+// the fee history of the fee market module. It returns false if a block of the
+// range is not recorded.
+func (b *Backend) recordedFeeHistory(blockStart, blockEnd int64) ([]*hexutil.Big, []float64, bool) {
+	if blockStart <= 0 {
+		return nil, nil, false
+	}
+
+	// the block following the range is recorded unless the range ends at the
+	// latest block
+	res, err := b.QueryClient.FeeMarket.FeeHistory(b.Ctx, &feemarkettypes.QueryFeeHistoryRequest{
+		FromHeight: blockStart,
+		ToHeight:   blockEnd + 1,
+	})
+	if err != nil {
+		b.Logger.Debug("failed to query the fee history", "from", blockStart, "to", blockEnd, "error", err.Error())
+		return nil, nil, false
+	}
+
+	blocks := blockEnd + 1 - blockStart
+	if int64(len(res.BlockFees)) < blocks {
+		return nil, nil, false
+	}
+
+	baseFees := make([]*hexutil.Big, blocks+1)
+	gasUsedRatios := make([]float64, blocks)
+	for i, blockFee := range res.BlockFees[:blocks] {
+		if blockFee.Height != blockStart+int64(i) {
+			return nil, nil, false
+		}
+
+		baseFees[i] = (*hexutil.Big)(evmtypes.ConvertAmountTo18DecimalsLegacy(blockFee.BaseFee).TruncateInt().BigInt())
+
+		// an unlimited block gas is reported as the max uint32, as in the blocks
+		gasLimit := blockFee.GasLimit
+		if gasLimit == 0 {
+			gasLimit = uint64(^uint32(0))
+		}
+		gasUsedRatios[i] = float64(blockFee.GasUsed) / float64(gasLimit)
+	}
+
+	if int64(len(res.BlockFees)) > blocks && res.BlockFees[blocks].Height == blockEnd+1 {
+		baseFees[blocks] = (*hexutil.Big)(evmtypes.ConvertAmountTo18DecimalsLegacy(res.BlockFees[blocks].BaseFee).TruncateInt().BigInt())
+		return baseFees, gasUsedRatios, true
+	}
+
+	nextBaseFee, err := b.nextBaseFee(res.BlockFees[blocks-1], baseFees[blocks-1].ToInt())
+	if err != nil {
+		b.Logger.Debug("failed to calculate the next base fee", "height", blockEnd, "error", err.Error())
+		return nil, nil, false
+	}
+	baseFees[blocks] = (*hexutil.Big)(nextBaseFee)
+
+	return baseFees, gasUsedRatios, true
+}
+
+// nextBaseFee calculates the base fee of the block following the given
+// recorded block, from its gas wanted as done by the fee market module, which
+// is the gas wanted of the EVM lane if the gas lanes are separate.
+func (b *Backend) nextBaseFee(blockFee feemarkettypes.BlockFee, baseFee *big.Int) (*big.Int, error) {
+	cfg := b.ChainConfig()
+	if !cfg.IsLondon(big.NewInt(blockFee.Height + 1)) {
+		return new(big.Int), nil
+	}
+
+	res, err := b.QueryClient.FeeMarket.Params(rpctypes.ContextWithHeight(blockFee.Height), &feemarkettypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if !res.Params.IsBaseFeeEnabled(blockFee.Height + 1) {
+		return new(big.Int), nil
+	}
+
+	// NOTE: the fee market module uses the max uint64 for an unlimited block gas
+	gasLimit := blockFee.GasLimit
+	if gasLimit == 0 {
+		gasLimit = gomath.MaxUint64
+	}
+
+	gasWanted := blockFee.GasWanted
+	if res.Params.HasSeparateGasLanes() {
+		gasWanted = blockFee.EvmGasWanted
+	}
+
+	return utils.CalcBaseFee(cfg, &ethtypes.Header{
+		Number:   big.NewInt(blockFee.Height),
+		BaseFee:  baseFee,
+		GasLimit: gasLimit,
+		GasUsed:  gasWanted,
+	}, res.Params)
+}
+
 // SuggestGasTipCap returns the suggested tip cap
 // Although we don't support tx prioritization yet, but we return a positive value to help client to
 // mitigate the base fee changes.
@@ -321,20 +427,15 @@ func (b *Backend) SuggestGasTipCap(baseFee *big.Int) (*big.Int, error) {
 	if err != nil {
 		return nil, err
 	}
-	// calculate the maximum base fee delta in current block, assuming all block gas limit is consumed
-	// ```
-	// GasTarget = GasLimit / ElasticityMultiplier
-	// Delta = BaseFee * (GasUsed - GasTarget) / GasTarget / Denominator
-	// ```
-	// The delta is at maximum when `GasUsed` is equal to `GasLimit`, which is:
-	// ```
-	// MaxDelta = BaseFee * (GasLimit - GasLimit / ElasticityMultiplier) / (GasLimit / ElasticityMultiplier) / Denominator
-	//          = BaseFee * (ElasticityMultiplier - 1) / Denominator
-	// ```t
-	maxDelta := baseFee.Int64() * (int64(params.Params.ElasticityMultiplier) - 1) / int64(params.Params.BaseFeeChangeDenominator) // #nosec G115
-	if maxDelta < 0 {
+	// the maximum base fee increase in current block, assuming all block gas
+	// limit is consumed
+	maxDelta, err := utils.MaxBaseFeeIncrease(baseFee, params.Params)
+	if err != nil {
+		return nil, err
+	}
+	if maxDelta.Sign() < 0 {
 		// impossible if the parameter validation passed.
-		maxDelta = 0
+		maxDelta = big.NewInt(0)
 	}
-	return big.NewInt(maxDelta), nil
+	return maxDelta, nil
 }
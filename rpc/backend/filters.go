@@ -4,6 +4,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
 )
 
 // GetLogs returns all the logs from all the ethereum transactions in a block.
@@ -34,3 +36,13 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 func (b *Backend) BloomStatus() (uint64, uint64) {
 	return 4096, 0
 }
+
+// LogIndexer returns the EVM tx indexer if it indexes the logs, or nil
+// otherwise, in which case the logs are filtered by scanning the blocks.
+func (b *Backend) LogIndexer() cosmosevmtypes.EVMLogIndexer {
+	logIndexer, ok := b.Indexer.(cosmosevmtypes.EVMLogIndexer)
+	if !ok {
+		return nil
+	}
+	return logIndexer
+}
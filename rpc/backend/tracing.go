@@ -146,15 +146,19 @@ func (b *Backend) convertConfig(config *rpctypes.TraceConfig) *evmtypes.TraceCon
 
 // TraceBlock configures a new tracer according to the provided configuration, and
 // executes all the transactions contained within. The return value will be one item
-// per transaction, dependent on the requested tracer.
+// per transaction, dependent on the requested tracer. If IncludeCosmosCalls is set,
+// the EVM calls executed by the Cosmos messages and by the begin and end block of the
+// block are traced as well, in block order. Only these EVM calls are replayed, not the
+// rest of their messages.
 func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 	config *rpctypes.TraceConfig,
 	block *tmrpctypes.ResultBlock,
 ) ([]*evmtypes.TxTraceResult, error) {
 	txs := block.Block.Txs
 	txsLength := len(txs)
+	includeCosmosCalls := config != nil && config.IncludeCosmosCalls
 
-	if txsLength == 0 {
+	if txsLength == 0 && !includeCosmosCalls {
 		// If there are no transactions return empty array
 		return []*evmtypes.TxTraceResult{}, nil
 	}
@@ -166,7 +170,15 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 	}
 	txDecoder := b.ClientCtx.TxConfig.TxDecoder()
 
-	var txsMessages []*evmtypes.MsgEthereumTx
+	var (
+		txsMessages []*evmtypes.MsgEthereumTx
+		cosmosCalls []*evmtypes.CosmosEVMCall
+	)
+	if includeCosmosCalls {
+		if cosmosCalls, err = rpctypes.ParseBlockCosmosEVMCalls(blockRes.FinalizeBlockEvents, rpctypes.CosmosCallPhaseBeginBlock, 0); err != nil {
+			return nil, err
+		}
+	}
 	for i, tx := range txs {
 		if !rpctypes.TxSucessOrExpectedFailure(blockRes.TxsResults[i]) {
 			b.Logger.Debug("invalid tx result code", "cosmos-hash", hexutil.Encode(tx.Hash()))
@@ -178,6 +190,14 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 			continue
 		}
 
+		if includeCosmosCalls {
+			calls, err := rpctypes.ParseCosmosEVMCalls(blockRes.TxsResults[i], decodedTx.GetMsgs(), hexutil.Encode(tx.Hash()), i, len(txsMessages))
+			if err != nil {
+				return nil, err
+			}
+			cosmosCalls = append(cosmosCalls, calls...)
+		}
+
 		for _, msg := range decodedTx.GetMsgs() {
 			ethMessage, ok := msg.(*evmtypes.MsgEthereumTx)
 			if !ok {
@@ -188,6 +208,14 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 		}
 	}
 
+	if includeCosmosCalls {
+		endBlockCalls, err := rpctypes.ParseBlockCosmosEVMCalls(blockRes.FinalizeBlockEvents, rpctypes.CosmosCallPhaseEndBlock, len(txsMessages))
+		if err != nil {
+			return nil, err
+		}
+		cosmosCalls = append(cosmosCalls, endBlockCalls...)
+	}
+
 	// minus one to get the context at the beginning of the block
 	contextHeight := height - 1
 	if contextHeight < 1 {
@@ -215,6 +243,7 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 		ProposerAddress: sdk.ConsAddress(block.Block.ProposerAddress),
 		ChainId:         b.EvmChainID.Int64(),
 		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
+		CosmosCalls:     cosmosCalls,
 	}
 
 	res, err := b.QueryClient.TraceBlock(ctxWithHeight, traceBlockRequest)
@@ -229,3 +258,72 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 
 	return decodedResults, nil
 }
+
+// TraceCall configures a new tracer according to the provided configuration, and
+// executes the given call arguments on top of the state of the given block. The
+// return value will be tracer dependent.
+func (b *Backend) TraceCall(
+	args evmtypes.TransactionArgs,
+	config *rpctypes.TraceCallConfig,
+	block *tmrpctypes.ResultBlock,
+) (interface{}, error) {
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		traceConfig    *rpctypes.TraceConfig
+		overrides      *rpctypes.StateOverride
+		blockOverrides *rpctypes.BlockOverrides
+	)
+	if config != nil {
+		traceConfig = &config.TraceConfig
+		overrides = config.StateOverrides
+		blockOverrides = config.BlockOverrides
+	}
+
+	overridesBz, blockOverridesBz, err := marshalOverrides(overrides, blockOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	nc, ok := b.ClientCtx.Client.(tmrpcclient.NetworkClient)
+	if !ok {
+		return nil, errors.New("invalid rpc client")
+	}
+
+	cp, err := nc.ConsensusParams(b.Ctx, &block.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	traceCallRequest := &evmtypes.QueryTraceCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		TraceConfig:     b.convertConfig(traceConfig),
+		BlockNumber:     block.Block.Height,
+		BlockTime:       block.Block.Time,
+		BlockHash:       common.Bytes2Hex(block.BlockID.Hash),
+		ProposerAddress: sdk.ConsAddress(block.Block.ProposerAddress),
+		ChainId:         b.EvmChainID.Int64(),
+		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
+		Overrides:       overridesBz,
+		BlockOverrides:  blockOverridesBz,
+	}
+
+	// the call is executed on top of the state at the end of the requested block
+	traceResult, err := b.QueryClient.TraceCall(rpctypes.ContextWithHeight(block.Block.Height), traceCallRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response format is unknown due to custom tracer config param
+	// More information can be found here https://geth.ethereum.org/docs/dapp/tracing-filtered
+	var decodedResult interface{}
+	if err := json.Unmarshal(traceResult.Data, &decodedResult); err != nil {
+		return nil, err
+	}
+
+	return decodedResult, nil
+}
@@ -129,6 +129,57 @@ func (b *Backend) GetTransactionByHashPending(txHash common.Hash) (*rpctypes.RPC
 	return nil, nil
 }
 
+// GetRawTransaction returns the EIP-2718 binary encoding of the Ethereum
+// transaction identified by hash, looking it up in the mempool if it has not
+// been included in a block yet.
+func (b *Backend) GetRawTransaction(txHash common.Hash) (hexutil.Bytes, error) {
+	res, err := b.GetTxByEthHash(txHash)
+	if err != nil {
+		return b.getRawTransactionPending(txHash)
+	}
+
+	block, err := b.CometBlockByNumber(rpctypes.BlockNumber(res.Height))
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := b.ClientCtx.TxConfig.TxDecoder()(block.Block.Txs[res.TxIndex])
+	if err != nil {
+		return nil, err
+	}
+
+	// the `res.MsgIndex` is inferred from tx index, should be within the bound.
+	msg, ok := tx.GetMsgs()[res.MsgIndex].(*evmtypes.MsgEthereumTx)
+	if !ok {
+		return nil, errors.New("invalid ethereum tx")
+	}
+
+	return msg.AsTransaction().MarshalBinary()
+}
+
+// getRawTransactionPending returns the EIP-2718 binary encoding of a pending
+// Ethereum transaction from the mempool.
+func (b *Backend) getRawTransactionPending(txHash common.Hash) (hexutil.Bytes, error) {
+	txs, err := b.PendingTransactions()
+	if err != nil {
+		b.Logger.Debug("tx not found", "hash", txHash.Hex(), "error", err.Error())
+		return nil, nil
+	}
+
+	for _, tx := range txs {
+		msg, err := evmtypes.UnwrapEthereumMsg(tx, txHash)
+		if err != nil {
+			// not ethereum tx
+			continue
+		}
+
+		return msg.AsTransaction().MarshalBinary()
+	}
+
+	b.Logger.Debug("tx not found", "hash", txHash.Hex())
+	return nil, nil
+}
+
 // GetGasUsed returns gasUsed from transaction
 func (b *Backend) GetGasUsed(res *types.TxResult, price *big.Int, gas uint64) uint64 {
 	// patch gasUsed if tx is reverted and happened before height on which fixed was introduced
@@ -432,7 +483,7 @@ func (b *Backend) createAccessList(args evmtypes.TransactionArgs, blockNrOrHash
 	for {
 		accessList := prevTracer.AccessList()
 		traceArgs.AccessList = &accessList
-		res, err := b.DoCall(*traceArgs, blockNum)
+		res, err := b.DoCall(*traceArgs, blockNum, nil, nil)
 		if err != nil {
 			b.Logger.Error("failed to apply transaction", "error", err)
 			return nil, 0, nil, fmt.Errorf("failed to apply transaction: %v err: %v", traceArgs.ToTransaction(ethtypes.LegacyTxType).Hash(), err)
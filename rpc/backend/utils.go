@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"math/big"
@@ -171,6 +172,9 @@ func (b *Backend) ProcessBlock(
 		if err != nil {
 			return err
 		}
+		if params.Params.HasSeparateGasLanes() {
+			header.GasUsed = b.evmGasUsed(cometBlock, cometBlockResult)
+		}
 		nextBaseFee, err := utils.CalcBaseFee(cfg, &header, params.Params)
 		if err != nil {
 			return err
@@ -252,6 +256,28 @@ func (b *Backend) ProcessBlock(
 	return nil
 }
 
+// evmGasUsed returns the gas used by the txs of the block wrapping Ethereum
+// transactions, which is the gas of the EVM lane of the fee market.
+func (b *Backend) evmGasUsed(cometBlock *cmtrpctypes.ResultBlock, cometBlockResult *cmtrpctypes.ResultBlockResults) uint64 {
+	var gasUsed uint64
+	for i, cometTx := range cometBlock.Block.Txs {
+		if i >= len(cometBlockResult.TxsResults) {
+			break
+		}
+		tx, err := b.ClientCtx.TxConfig.TxDecoder()(cometTx)
+		if err != nil {
+			continue
+		}
+		for _, msg := range tx.GetMsgs() {
+			if _, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+				gasUsed += uint64(cometBlockResult.TxsResults[i].GasUsed) //nolint:gosec // G115 // gas used is not negative
+				break
+			}
+		}
+	}
+	return gasUsed
+}
+
 // ShouldIgnoreGasUsed returns true if the gasUsed in result should be ignored
 // workaround for issue: https://github.com/cosmos/cosmos-sdk/issues/10832
 func ShouldIgnoreGasUsed(res *abci.ExecTxResult) bool {
@@ -291,3 +317,26 @@ func GetHexProofs(proof *crypto.ProofOps) []string {
 	}
 	return proofs
 }
+
+// marshalOverrides returns the JSON encoding of the state and block overrides
+// to be carried in an EthCallRequest. Nil overrides are encoded as empty bytes.
+func marshalOverrides(overrides *types.StateOverride, blockOverrides *types.BlockOverrides) ([]byte, []byte, error) {
+	var (
+		overridesBz, blockOverridesBz []byte
+		err                           error
+	)
+
+	if overrides != nil {
+		if overridesBz, err = json.Marshal(overrides); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if blockOverrides != nil {
+		if blockOverridesBz, err = json.Marshal(blockOverrides); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return overridesBz, blockOverridesBz, nil
+}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/cosmos/evm/rpc/stream"
 	"github.com/cosmos/evm/rpc/types"
+	cosmosevmtypes "github.com/cosmos/evm/types"
 
 	"cosmossdk.io/log"
 
@@ -50,6 +51,7 @@ type Backend interface {
 	BlockBloom(blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
 
 	BloomStatus() (uint64, uint64)
+	LogIndexer() cosmosevmtypes.EVMLogIndexer
 
 	RPCFilterCap() int32
 	RPCLogsCap() int32
@@ -17,6 +17,7 @@ import (
 
 	"github.com/cosmos/evm/rpc/backend"
 	"github.com/cosmos/evm/rpc/types"
+	cosmosevmtypes "github.com/cosmos/evm/types"
 
 	"cosmossdk.io/log"
 )
@@ -165,6 +166,15 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) (logs [
 		return nil, errInvalidBlockRange
 	}
 
+	// Answer the query from the log indices if the indexer covers the range,
+	// in which case the block range is not limited
+	if logIndexer := f.backend.LogIndexer(); logIndexer != nil {
+		logs, indexed, err := f.indexedLogs(logIndexer, from, to, logLimit)
+		if err != nil || indexed {
+			return logs, err
+		}
+	}
+
 	if blockLimit > 0 && to-from > uint64(blockLimit) {
 		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
 	}
@@ -196,6 +206,34 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) (logs [
 	return logs, nil
 }
 
+// indexedLogs returns the logs matching the filter criteria within the [from, to]
+// block range from the log indexer. It returns false if the range is not fully
+// indexed yet.
+func (f *Filter) indexedLogs(logIndexer cosmosevmtypes.EVMLogIndexer, from, to uint64, logLimit int) ([]*ethtypes.Log, bool, error) {
+	first, err := logIndexer.FirstIndexedBlock()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to fetch first indexed block: %w", err)
+	}
+	last, err := logIndexer.LastIndexedBlock()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to fetch last indexed block: %w", err)
+	}
+	if first < 0 || uint64(first) > from || last < 0 || uint64(last) < to {
+		f.logger.Debug("block range not indexed", "from", from, "to", to, "first", first, "last", last)
+		return nil, false, nil
+	}
+
+	// query one more log than the limit to detect if it is exceeded
+	logs, err := logIndexer.GetLogs(int64(from), int64(to), f.criteria.Addresses, f.criteria.Topics, logLimit+1) //#nosec G115
+	if err != nil {
+		return nil, true, fmt.Errorf("failed to fetch logs from indexer: %w", err)
+	}
+	if len(logs) > logLimit {
+		return nil, true, fmt.Errorf("query returned more than %d results", logLimit)
+	}
+	return logs, true, nil
+}
+
 // blockLogs returns the logs matching the filter criteria within a single block.
 func (f *Filter) blockLogs(blockRes *cmtrpctypes.ResultBlockResults, bloom ethtypes.Bloom) ([]*ethtypes.Log, error) {
 	if !bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
@@ -17,6 +17,7 @@ import (
 
 	filtermocks "github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters/mocks"
 	rpctypes "github.com/cosmos/evm/rpc/types"
+	cosmosevmtypes "github.com/cosmos/evm/types"
 
 	"cosmossdk.io/log"
 )
@@ -45,6 +46,10 @@ func (m *MockBackend) BloomStatus() (uint64, uint64) {
 	panic("implement me")
 }
 
+func (m *MockBackend) LogIndexer() cosmosevmtypes.EVMLogIndexer {
+	return nil
+}
+
 func (m *MockBackend) RPCFilterCap() int32 {
 	panic("implement me")
 }
@@ -77,6 +82,30 @@ func (m *MockBackend) HeaderByNumber(blockNum rpctypes.BlockNumber) (*ethtypes.H
 	return args.Get(0).(*ethtypes.Header), args.Error(1)
 }
 
+// fakeLogIndexer is a log indexer that indexed the logs of the [first, last] block range.
+type fakeLogIndexer struct {
+	cosmosevmtypes.EVMTxIndexer
+
+	first, last int64
+	logs        []*ethtypes.Log
+}
+
+func (f fakeLogIndexer) FirstIndexedBlock() (int64, error) {
+	return f.first, nil
+}
+
+func (f fakeLogIndexer) LastIndexedBlock() (int64, error) {
+	return f.last, nil
+}
+
+func (f fakeLogIndexer) GetLogs(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, error) {
+	logs := FilterLogs(f.logs, big.NewInt(from), big.NewInt(to), addresses, topics)
+	if len(logs) > limit {
+		logs = logs[:limit]
+	}
+	return logs, nil
+}
+
 func TestLogs(t *testing.T) {
 	blockHeight := int64(100)
 	fakeHeader := &ethtypes.Header{Number: big.NewInt(blockHeight)}
@@ -187,6 +216,17 @@ func TestLogs(t *testing.T) {
 
 func TestFilter(t *testing.T) {
 	logger := log.NewNopLogger()
+	address := common.HexToAddress("0x1")
+	indexedLogs := []*ethtypes.Log{
+		{Address: address, BlockNumber: 10},
+		{Address: common.HexToAddress("0x2"), BlockNumber: 20},
+		{Address: address, BlockNumber: 90},
+	}
+	manyLogs := make([]*ethtypes.Log, 20)
+	for i := range manyLogs {
+		manyLogs[i] = &ethtypes.Log{Address: address, BlockNumber: uint64(i + 1)} //#nosec G115
+	}
+
 	testCases := []struct {
 		name         string
 		filter       filters.FilterCriteria
@@ -202,6 +242,33 @@ func TestFilter(t *testing.T) {
 			},
 			expErr: "invalid block range params",
 		},
+		{
+			name:   "logs are queried from the log indexer without block range cap",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(100), Addresses: []common.Address{address}},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(100)}, nil)
+				b.EXPECT().LogIndexer().Return(fakeLogIndexer{first: 1, last: 100, logs: indexedLogs})
+			},
+			expLogs: []*ethtypes.Log{indexedLogs[0], indexedLogs[2]},
+		},
+		{
+			name:   "logs from the log indexer exceeding the logs cap return error",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(100)},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(100)}, nil)
+				b.EXPECT().LogIndexer().Return(fakeLogIndexer{first: 1, last: 100, logs: manyLogs})
+			},
+			expErr: "query returned more than 15 results",
+		},
+		{
+			name:   "block range not fully indexed falls back to the block scan",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(100)},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(100)}, nil)
+				b.EXPECT().LogIndexer().Return(fakeLogIndexer{first: 50, last: 100, logs: indexedLogs})
+			},
+			expErr: "maximum [from, to] blocks distance: 50",
+		},
 	}
 
 	for _, tc := range testCases {
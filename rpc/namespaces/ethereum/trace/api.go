@@ -0,0 +1,299 @@
+package trace
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	evmmempool "github.com/cosmos/evm/mempool"
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/stream"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+// Namespace is the JSON-RPC namespace of the OpenEthereum trace API.
+const Namespace = "trace"
+
+const (
+	apiVersion = "1.0"
+	callTracer = "callTracer"
+)
+
+var errInvalidBlockRange = errors.New("invalid block range params")
+
+// Backend defines the methods required by the trace API.
+type Backend interface {
+	BlockNumber() (hexutil.Uint64, error)
+	CometBlockByNumber(blockNum rpctypes.BlockNumber) (*tmrpctypes.ResultBlock, error)
+	CometBlockResultByNumber(height *int64) (*tmrpctypes.ResultBlockResults, error)
+	EthMsgsFromCometBlock(block *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults) []*evmtypes.MsgEthereumTx
+	GetTxByEthHash(txHash common.Hash) (*types.TxResult, error)
+	TraceTransaction(hash common.Hash, config *rpctypes.TraceConfig) (interface{}, error)
+	TraceBlock(height rpctypes.BlockNumber, config *rpctypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
+	RPCBlockRangeCap() int32
+}
+
+// NewAPIs creates the trace namespace APIs. It is an APICreator, to be
+// registered with rpc.RegisterAPINamespace.
+func NewAPIs(
+	ctx *server.Context,
+	clientCtx client.Context,
+	_ *stream.RPCStream,
+	allowUnprotectedTxs bool,
+	indexer types.EVMTxIndexer,
+	mempool *evmmempool.ExperimentalEVMMempool,
+) []rpc.API {
+	evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, mempool)
+	return []rpc.API{
+		{
+			Namespace: Namespace,
+			Version:   apiVersion,
+			Service:   NewAPI(ctx.Logger, evmBackend),
+			Public:    true,
+		},
+	}
+}
+
+// API is the OpenEthereum trace API, serving flat call traces built from the
+// callTracer output.
+type API struct {
+	logger  log.Logger
+	backend Backend
+}
+
+// NewAPI creates a new trace API instance.
+func NewAPI(logger log.Logger, backend Backend) *API {
+	return &API{
+		logger:  logger.With("api", "trace"),
+		backend: backend,
+	}
+}
+
+// FilterRequest defines the criteria of trace_filter. Traces match if they are
+// sent from one of the from addresses and to one of the to addresses, empty
+// lists matching all the addresses.
+type FilterRequest struct {
+	FromBlock   *rpctypes.BlockNumber `json:"fromBlock"`
+	ToBlock     *rpctypes.BlockNumber `json:"toBlock"`
+	FromAddress []common.Address      `json:"fromAddress"`
+	ToAddress   []common.Address      `json:"toAddress"`
+	After       uint64                `json:"after"`
+	Count       *uint64               `json:"count"`
+}
+
+// Transaction returns the traces of the given transaction.
+func (api *API) Transaction(hash common.Hash) ([]*Trace, error) {
+	api.logger.Debug("trace_transaction", "hash", hash)
+
+	txResult, err := api.backend.GetTxByEthHash(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	resBlock, err := api.backend.CometBlockByNumber(rpctypes.BlockNumber(txResult.Height))
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, fmt.Errorf("block %d not found", txResult.Height)
+	}
+
+	result, err := api.backend.TraceTransaction(hash, callTracerConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	frame, err := decodeCallFrame(result)
+	if err != nil {
+		return nil, err
+	}
+
+	return flattenCallFrame(frame, txContext{
+		blockHash:   common.BytesToHash(resBlock.BlockID.Hash),
+		blockNumber: uint64(txResult.Height), //nolint:gosec // G115 // block height won't exceed uint64
+		txHash:      hash,
+		txPosition:  uint64(txResult.EthTxIndex), //nolint:gosec // G115 // the index of an indexed tx is not negative
+	}), nil
+}
+
+// Block returns the traces of all the transactions of the given block.
+func (api *API) Block(blockNum rpctypes.BlockNumber) ([]*Trace, error) {
+	api.logger.Debug("trace_block", "number", blockNum)
+
+	height, err := api.resolveBlockNumber(blockNum)
+	if err != nil {
+		return nil, err
+	}
+	return api.blockTraces(height)
+}
+
+// Filter returns the traces matching the given criteria, over a block range
+// bounded by the block range cap.
+func (api *API) Filter(req FilterRequest) ([]*Trace, error) {
+	api.logger.Debug("trace_filter", "request", req)
+
+	latest := rpctypes.EthLatestBlockNumber
+	fromBlock, toBlock := &latest, &latest
+	if req.FromBlock != nil {
+		fromBlock = req.FromBlock
+	}
+	if req.ToBlock != nil {
+		toBlock = req.ToBlock
+	}
+
+	from, err := api.resolveBlockNumber(*fromBlock)
+	if err != nil {
+		return nil, err
+	}
+	to, err := api.resolveBlockNumber(*toBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	if from > to {
+		return nil, errInvalidBlockRange
+	}
+	if blockLimit := int64(api.backend.RPCBlockRangeCap()); blockLimit > 0 && to-from > blockLimit {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+	}
+
+	fromAddresses := make(map[common.Address]bool, len(req.FromAddress))
+	for _, address := range req.FromAddress {
+		fromAddresses[address] = true
+	}
+	toAddresses := make(map[common.Address]bool, len(req.ToAddress))
+	for _, address := range req.ToAddress {
+		toAddresses[address] = true
+	}
+
+	traces := []*Trace{}
+	if req.Count != nil && *req.Count == 0 {
+		return traces, nil
+	}
+
+	skip := req.After
+	for height := from; height <= to; height++ {
+		blockTraces, err := api.blockTraces(height)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, trace := range blockTraces {
+			if !trace.matchesAddresses(fromAddresses, toAddresses) {
+				continue
+			}
+			if skip > 0 {
+				skip--
+				continue
+			}
+			traces = append(traces, trace)
+			// stop once enough traces were found, without tracing the next blocks
+			if req.Count != nil && uint64(len(traces)) >= *req.Count {
+				return traces, nil
+			}
+		}
+	}
+
+	return traces, nil
+}
+
+// blockTraces returns the traces of all the transactions of the block at the
+// given height.
+func (api *API) blockTraces(height int64) ([]*Trace, error) {
+	resBlock, err := api.backend.CometBlockByNumber(rpctypes.BlockNumber(height))
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, fmt.Errorf("block %d not found", height)
+	}
+
+	blockRes, err := api.backend.CometBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := api.backend.EthMsgsFromCometBlock(resBlock, blockRes)
+	if len(msgs) == 0 {
+		return []*Trace{}, nil
+	}
+
+	results, err := api.backend.TraceBlock(rpctypes.BlockNumber(height), callTracerConfig(), resBlock)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) != len(msgs) {
+		return nil, fmt.Errorf("expected %d tx traces in block %d, got %d", len(msgs), height, len(results))
+	}
+
+	blockHash := common.BytesToHash(resBlock.BlockID.Hash)
+	traces := []*Trace{}
+	for i, result := range results {
+		txHash := msgs[i].Hash()
+		if result.Error != "" {
+			return nil, fmt.Errorf("failed to trace tx %s: %s", txHash.Hex(), result.Error)
+		}
+
+		frame, err := decodeCallFrame(result.Result)
+		if err != nil {
+			return nil, err
+		}
+
+		traces = append(traces, flattenCallFrame(frame, txContext{
+			blockHash:   blockHash,
+			blockNumber: uint64(height), //nolint:gosec // G115 // block height won't exceed uint64
+			txHash:      txHash,
+			txPosition:  uint64(i), //nolint:gosec // G115 // won't exceed uint64
+		})...)
+	}
+
+	return traces, nil
+}
+
+// resolveBlockNumber returns the height of the given block number, resolving
+// the special block numbers. As genesis is not traceable, the earliest block
+// number resolves to the first block. The safe and finalized block numbers are
+// decoded as the latest one, the other negative block numbers are rejected.
+func (api *API) resolveBlockNumber(blockNum rpctypes.BlockNumber) (int64, error) {
+	latest, err := api.backend.BlockNumber()
+	if err != nil {
+		return 0, err
+	}
+
+	var height int64
+	switch {
+	case blockNum == rpctypes.EthLatestBlockNumber || blockNum == rpctypes.EthPendingBlockNumber:
+		height = int64(latest) //nolint:gosec // G115 // block height won't exceed int64
+	case blockNum < 0:
+		return 0, fmt.Errorf("unsupported block number %d", blockNum)
+	case blockNum == rpctypes.EthEarliestBlockNumber:
+		height = 1
+	default:
+		height = int64(blockNum)
+	}
+
+	if height > int64(latest) { //nolint:gosec // G115 // block height won't exceed int64
+		return 0, errInvalidBlockRange
+	}
+	return height, nil
+}
+
+// callTracerConfig returns the trace config of the callTracer, which output is
+// converted into flat traces.
+func callTracerConfig() *rpctypes.TraceConfig {
+	return &rpctypes.TraceConfig{
+		TraceConfig: evmtypes.TraceConfig{Tracer: callTracer},
+	}
+}
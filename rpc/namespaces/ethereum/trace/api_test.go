@@ -0,0 +1,313 @@
+package trace
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+)
+
+var (
+	addr1 = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	addr2 = common.HexToAddress("0x2000000000000000000000000000000000000002")
+	addr3 = common.HexToAddress("0x3000000000000000000000000000000000000003")
+)
+
+// nestedCallFrame is the callTracer output of a call from addr1 to addr2,
+// which creates addr3 and calls a reverting addr1, and addr3 self-destructs.
+var nestedCallFrame = fmt.Sprintf(`{
+	"type": "CALL", "from": "%[1]s", "to": "%[2]s", "gas": "0x5208", "gasUsed": "0x5000",
+	"input": "0x01", "output": "0x02", "value": "0x10",
+	"calls": [
+		{
+			"type": "CREATE2", "from": "%[2]s", "to": "%[3]s", "gas": "0x100", "gasUsed": "0x80",
+			"input": "0x6000", "output": "0x00",
+			"calls": [{"type": "SELFDESTRUCT", "from": "%[3]s", "to": "%[2]s", "gas": "0x0", "gasUsed": "0x0", "input": "0x", "value": "0x1"}]
+		},
+		{
+			"type": "STATICCALL", "from": "%[2]s", "to": "%[1]s", "gas": "0x100", "gasUsed": "0x100",
+			"input": "0x03", "error": "execution reverted"
+		}
+	]
+}`, addr1.Hex(), addr2.Hex(), addr3.Hex())
+
+func callFrameResult(t *testing.T, frame string) interface{} {
+	t.Helper()
+	var result interface{}
+	require.NoError(t, json.Unmarshal([]byte(frame), &result))
+	return result
+}
+
+func TestFlattenCallFrame(t *testing.T) {
+	frame, err := decodeCallFrame(callFrameResult(t, nestedCallFrame))
+	require.NoError(t, err)
+
+	tx := txContext{
+		blockHash:   common.HexToHash("0xb1"),
+		blockNumber: 5,
+		txHash:      common.HexToHash("0xa1"),
+		txPosition:  2,
+	}
+	traces := flattenCallFrame(frame, tx)
+	require.Len(t, traces, 4)
+
+	for _, trace := range traces {
+		require.Equal(t, tx.blockHash, trace.BlockHash)
+		require.Equal(t, tx.blockNumber, trace.BlockNumber)
+		require.Equal(t, tx.txHash, trace.TransactionHash)
+		require.Equal(t, tx.txPosition, trace.TransactionPosition)
+	}
+
+	require.Equal(t, TypeCall, traces[0].Type)
+	require.Equal(t, []int{}, traces[0].TraceAddress)
+	require.Equal(t, 2, traces[0].Subtraces)
+	require.Equal(t, &CallAction{
+		CallType: "call",
+		From:     addr1,
+		Gas:      0x5208,
+		Input:    hexutil.Bytes{0x01},
+		To:       addr2,
+		Value:    (*hexutil.Big)(hexutil.MustDecodeBig("0x10")),
+	}, traces[0].Action)
+	require.Equal(t, &CallResult{GasUsed: 0x5000, Output: hexutil.Bytes{0x02}}, traces[0].Result)
+
+	require.Equal(t, TypeCreate, traces[1].Type)
+	require.Equal(t, []int{0}, traces[1].TraceAddress)
+	require.Equal(t, 1, traces[1].Subtraces)
+	require.Equal(t, "create2", traces[1].Action.(*CreateAction).CreationMethod)
+	require.Equal(t, &CreateResult{Address: addr3, Code: hexutil.Bytes{0x00}, GasUsed: 0x80}, traces[1].Result)
+
+	require.Equal(t, TypeSuicide, traces[2].Type)
+	require.Equal(t, []int{0, 0}, traces[2].TraceAddress)
+	require.Equal(t, &SuicideAction{
+		Address:       addr3,
+		Balance:       (*hexutil.Big)(hexutil.MustDecodeBig("0x1")),
+		RefundAddress: addr2,
+	}, traces[2].Action)
+	require.Nil(t, traces[2].Result)
+
+	require.Equal(t, TypeCall, traces[3].Type)
+	require.Equal(t, []int{1}, traces[3].TraceAddress)
+	require.Equal(t, "staticcall", traces[3].Action.(*CallAction).CallType)
+	require.Equal(t, errReverted, traces[3].Error)
+	require.Nil(t, traces[3].Result)
+}
+
+// fakeBackend serves blocks of a single ethereum tx with the nested call
+// frame.
+type fakeBackend struct {
+	latest   int64
+	rangeCap int32
+	msgs     map[int64]*evmtypes.MsgEthereumTx
+	result   interface{}
+	traced   int
+}
+
+func newFakeBackend(t *testing.T, latest int64, rangeCap int32) *fakeBackend {
+	t.Helper()
+	b := &fakeBackend{
+		latest:   latest,
+		rangeCap: rangeCap,
+		msgs:     make(map[int64]*evmtypes.MsgEthereumTx),
+		result:   callFrameResult(t, nestedCallFrame),
+	}
+	for height := int64(1); height <= latest; height++ {
+		b.msgs[height] = evmtypes.NewTx(&evmtypes.EvmTxArgs{Nonce: uint64(height), GasLimit: 21000, To: &addr2}) //nolint:gosec // G115
+	}
+	return b
+}
+
+func (b *fakeBackend) BlockNumber() (hexutil.Uint64, error) {
+	return hexutil.Uint64(b.latest), nil //nolint:gosec // G115
+}
+
+func (b *fakeBackend) CometBlockByNumber(blockNum rpctypes.BlockNumber) (*tmrpctypes.ResultBlock, error) {
+	return &tmrpctypes.ResultBlock{
+		BlockID: cmttypes.BlockID{Hash: common.BigToHash(big.NewInt(blockNum.Int64())).Bytes()},
+		Block:   &cmttypes.Block{Header: cmttypes.Header{Height: blockNum.Int64()}},
+	}, nil
+}
+
+func (b *fakeBackend) CometBlockResultByNumber(height *int64) (*tmrpctypes.ResultBlockResults, error) {
+	return &tmrpctypes.ResultBlockResults{Height: *height}, nil
+}
+
+func (b *fakeBackend) EthMsgsFromCometBlock(block *tmrpctypes.ResultBlock, _ *tmrpctypes.ResultBlockResults) []*evmtypes.MsgEthereumTx {
+	return []*evmtypes.MsgEthereumTx{b.msgs[block.Block.Height]}
+}
+
+func (b *fakeBackend) GetTxByEthHash(hash common.Hash) (*types.TxResult, error) {
+	for height, msg := range b.msgs {
+		if msg.Hash() == hash {
+			return &types.TxResult{Height: height}, nil
+		}
+	}
+	return nil, fmt.Errorf("tx not found, hash: %s", hash.Hex())
+}
+
+func (b *fakeBackend) TraceTransaction(common.Hash, *rpctypes.TraceConfig) (interface{}, error) {
+	return b.result, nil
+}
+
+func (b *fakeBackend) TraceBlock(rpctypes.BlockNumber, *rpctypes.TraceConfig, *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error) {
+	b.traced++
+	return []*evmtypes.TxTraceResult{{Result: b.result}}, nil
+}
+
+func (b *fakeBackend) RPCBlockRangeCap() int32 {
+	return b.rangeCap
+}
+
+func TestTransactionAndBlock(t *testing.T) {
+	backend := newFakeBackend(t, 3, 0)
+	api := NewAPI(log.NewNopLogger(), backend)
+
+	traces, err := api.Transaction(backend.msgs[2].Hash())
+	require.NoError(t, err)
+	require.Len(t, traces, 4)
+	require.Equal(t, uint64(2), traces[0].BlockNumber)
+	require.Equal(t, backend.msgs[2].Hash(), traces[0].TransactionHash)
+
+	_, err = api.Transaction(common.HexToHash("0x01"))
+	require.ErrorContains(t, err, "tx not found")
+
+	traces, err = api.Block(rpctypes.EthLatestBlockNumber)
+	require.NoError(t, err)
+	require.Len(t, traces, 4)
+	require.Equal(t, uint64(3), traces[0].BlockNumber)
+	require.Equal(t, common.BigToHash(common.Big3), traces[0].BlockHash)
+
+	// genesis is not traceable, earliest is the first block
+	traces, err = api.Block(rpctypes.EthEarliestBlockNumber)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), traces[0].BlockNumber)
+
+	_, err = api.Block(4)
+	require.ErrorIs(t, err, errInvalidBlockRange)
+
+	_, err = api.Block(rpctypes.BlockNumber(-3))
+	require.ErrorContains(t, err, "unsupported block number")
+}
+
+func TestFilter(t *testing.T) {
+	blockNumber := func(n int64) *rpctypes.BlockNumber {
+		blockNum := rpctypes.BlockNumber(n)
+		return &blockNum
+	}
+	count := func(n uint64) *uint64 {
+		return &n
+	}
+
+	testCases := []struct {
+		name      string
+		req       FilterRequest
+		expTraces int
+		expErr    string
+	}{
+		{
+			"latest block by default",
+			FilterRequest{},
+			4,
+			"",
+		},
+		{
+			"genesis is skipped",
+			FilterRequest{FromBlock: blockNumber(0), ToBlock: blockNumber(3)},
+			12,
+			"",
+		},
+		{
+			"from address",
+			FilterRequest{FromBlock: blockNumber(1), ToBlock: blockNumber(3), FromAddress: []common.Address{addr2}},
+			6,
+			"",
+		},
+		{
+			"to address, including the created contract",
+			FilterRequest{FromBlock: blockNumber(1), ToBlock: blockNumber(3), ToAddress: []common.Address{addr3}},
+			3,
+			"",
+		},
+		{
+			"from and to addresses",
+			FilterRequest{FromBlock: blockNumber(1), ToBlock: blockNumber(3), FromAddress: []common.Address{addr3}, ToAddress: []common.Address{addr2}},
+			3,
+			"",
+		},
+		{
+			"after and count",
+			FilterRequest{FromBlock: blockNumber(1), ToBlock: blockNumber(3), After: 5, Count: count(4)},
+			4,
+			"",
+		},
+		{
+			"zero count",
+			FilterRequest{FromBlock: blockNumber(1), ToBlock: blockNumber(3), Count: count(0)},
+			0,
+			"",
+		},
+		{
+			"after all the traces",
+			FilterRequest{FromBlock: blockNumber(1), ToBlock: blockNumber(3), After: 12},
+			0,
+			"",
+		},
+		{
+			"from after to",
+			FilterRequest{FromBlock: blockNumber(3), ToBlock: blockNumber(2)},
+			0,
+			errInvalidBlockRange.Error(),
+		},
+		{
+			"to after latest",
+			FilterRequest{FromBlock: blockNumber(1), ToBlock: blockNumber(10)},
+			0,
+			errInvalidBlockRange.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			api := NewAPI(log.NewNopLogger(), newFakeBackend(t, 3, 0))
+			traces, err := api.Filter(tc.req)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, traces, tc.expTraces)
+		})
+	}
+
+	t.Run("block range cap", func(t *testing.T) {
+		api := NewAPI(log.NewNopLogger(), newFakeBackend(t, 3, 1))
+		_, err := api.Filter(FilterRequest{FromBlock: blockNumber(1), ToBlock: blockNumber(3)})
+		require.ErrorContains(t, err, "maximum [from, to] blocks distance: 1")
+
+		traces, err := api.Filter(FilterRequest{FromBlock: blockNumber(2), ToBlock: blockNumber(3)})
+		require.NoError(t, err)
+		require.Len(t, traces, 8)
+	})
+
+	t.Run("stops tracing once count is reached", func(t *testing.T) {
+		backend := newFakeBackend(t, 3, 0)
+		api := NewAPI(log.NewNopLogger(), backend)
+		traces, err := api.Filter(FilterRequest{FromBlock: blockNumber(1), ToBlock: blockNumber(3), After: 2, Count: count(2)})
+		require.NoError(t, err)
+		require.Len(t, traces, 2)
+		require.Equal(t, 1, backend.traced)
+	})
+}
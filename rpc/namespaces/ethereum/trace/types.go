@@ -0,0 +1,223 @@
+package trace
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Trace types, as defined by OpenEthereum.
+const (
+	TypeCall    = "call"
+	TypeCreate  = "create"
+	TypeSuicide = "suicide"
+)
+
+// errReverted is the OpenEthereum error of the reverted calls.
+const errReverted = "Reverted"
+
+// Trace is a flat OpenEthereum call trace.
+type Trace struct {
+	Action              interface{} `json:"action"`
+	BlockHash           common.Hash `json:"blockHash"`
+	BlockNumber         uint64      `json:"blockNumber"`
+	Error               string      `json:"error,omitempty"`
+	Result              interface{} `json:"result"`
+	Subtraces           int         `json:"subtraces"`
+	TraceAddress        []int       `json:"traceAddress"`
+	TransactionHash     common.Hash `json:"transactionHash"`
+	TransactionPosition uint64      `json:"transactionPosition"`
+	Type                string      `json:"type"`
+}
+
+// CallAction is the action of a call trace.
+type CallAction struct {
+	CallType string         `json:"callType"`
+	From     common.Address `json:"from"`
+	Gas      hexutil.Uint64 `json:"gas"`
+	Input    hexutil.Bytes  `json:"input"`
+	To       common.Address `json:"to"`
+	Value    *hexutil.Big   `json:"value"`
+}
+
+// CreateAction is the action of a create trace.
+type CreateAction struct {
+	CreationMethod string         `json:"creationMethod"`
+	From           common.Address `json:"from"`
+	Gas            hexutil.Uint64 `json:"gas"`
+	Init           hexutil.Bytes  `json:"init"`
+	Value          *hexutil.Big   `json:"value"`
+}
+
+// SuicideAction is the action of a self-destruct trace.
+type SuicideAction struct {
+	Address       common.Address `json:"address"`
+	Balance       *hexutil.Big   `json:"balance"`
+	RefundAddress common.Address `json:"refundAddress"`
+}
+
+// CallResult is the result of a successful call trace.
+type CallResult struct {
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+	Output  hexutil.Bytes  `json:"output"`
+}
+
+// CreateResult is the result of a successful create trace.
+type CreateResult struct {
+	Address common.Address `json:"address"`
+	Code    hexutil.Bytes  `json:"code"`
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+}
+
+// callFrame is a call frame of the callTracer output.
+type callFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	Gas     hexutil.Uint64  `json:"gas"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	To      *common.Address `json:"to,omitempty"`
+	Input   hexutil.Bytes   `json:"input"`
+	Output  hexutil.Bytes   `json:"output,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Calls   []callFrame     `json:"calls,omitempty"`
+	Value   *hexutil.Big    `json:"value,omitempty"`
+}
+
+// txContext identifies the tx of the traces.
+type txContext struct {
+	blockHash   common.Hash
+	blockNumber uint64
+	txHash      common.Hash
+	txPosition  uint64
+}
+
+// decodeCallFrame decodes the JSON decoded callTracer output of a tx.
+func decodeCallFrame(result interface{}) (*callFrame, error) {
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+
+	var frame callFrame
+	if err := json.Unmarshal(bz, &frame); err != nil {
+		return nil, err
+	}
+	return &frame, nil
+}
+
+// flattenCallFrame converts the call frames of a tx into flat traces, in
+// depth-first order.
+func flattenCallFrame(frame *callFrame, tx txContext) []*Trace {
+	var traces []*Trace
+	var flatten func(frame *callFrame, traceAddress []int)
+	flatten = func(frame *callFrame, traceAddress []int) {
+		trace := newTrace(frame, tx)
+		trace.TraceAddress = traceAddress
+		traces = append(traces, trace)
+
+		for i := range frame.Calls {
+			// copy the address of the parent, so that siblings don't share it
+			childAddress := make([]int, len(traceAddress), len(traceAddress)+1)
+			copy(childAddress, traceAddress)
+			flatten(&frame.Calls[i], append(childAddress, i))
+		}
+	}
+	flatten(frame, []int{})
+
+	return traces
+}
+
+// newTrace converts a call frame into a trace, without its subcalls.
+func newTrace(frame *callFrame, tx txContext) *Trace {
+	trace := &Trace{
+		BlockHash:           tx.blockHash,
+		BlockNumber:         tx.blockNumber,
+		Subtraces:           len(frame.Calls),
+		TransactionHash:     tx.txHash,
+		TransactionPosition: tx.txPosition,
+	}
+
+	value := frame.Value
+	if value == nil {
+		value = new(hexutil.Big)
+	}
+	var to common.Address
+	if frame.To != nil {
+		to = *frame.To
+	}
+
+	frameType := strings.ToLower(frame.Type)
+	switch frameType {
+	case "create", "create2":
+		trace.Type = TypeCreate
+		trace.Action = &CreateAction{
+			CreationMethod: frameType,
+			From:           frame.From,
+			Gas:            frame.Gas,
+			Init:           frame.Input,
+			Value:          value,
+		}
+		if frame.Error == "" {
+			trace.Result = &CreateResult{
+				Address: to,
+				Code:    frame.Output,
+				GasUsed: frame.GasUsed,
+			}
+		}
+	case "selfdestruct":
+		trace.Type = TypeSuicide
+		trace.Action = &SuicideAction{
+			Address:       frame.From,
+			Balance:       value,
+			RefundAddress: to,
+		}
+	default:
+		trace.Type = TypeCall
+		trace.Action = &CallAction{
+			CallType: frameType,
+			From:     frame.From,
+			Gas:      frame.Gas,
+			Input:    frame.Input,
+			To:       to,
+			Value:    value,
+		}
+		if frame.Error == "" {
+			trace.Result = &CallResult{
+				GasUsed: frame.GasUsed,
+				Output:  frame.Output,
+			}
+		}
+	}
+
+	if frame.Error != "" {
+		trace.Error = frame.Error
+		if frame.Error == "execution reverted" {
+			trace.Error = errReverted
+		}
+	}
+
+	return trace
+}
+
+// matchesAddresses returns true if the trace is sent from one of the given from
+// addresses and to one of the given to addresses. Empty address lists match all
+// the traces.
+func (t *Trace) matchesAddresses(fromAddresses, toAddresses map[common.Address]bool) bool {
+	var from, to common.Address
+	switch action := t.Action.(type) {
+	case *CallAction:
+		from, to = action.From, action.To
+	case *CreateAction:
+		from = action.From
+		if result, ok := t.Result.(*CreateResult); ok {
+			to = result.Address
+		}
+	case *SuicideAction:
+		from, to = action.Address, action.RefundAddress
+	}
+
+	return (len(fromAddresses) == 0 || fromAddresses[from]) &&
+		(len(toAddresses) == 0 || toAddresses[to])
+}
@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -261,3 +262,116 @@ func fillTxAttributes(tx *ParsedTx, attrs []abci.EventAttribute) error {
 	}
 	return nil
 }
+
+// msgIndexAttributeKey is the attribute added by the baseapp to the events of
+// the messages, with the index of the message in the tx.
+const msgIndexAttributeKey = "msg_index"
+
+// modeAttributeKey is the attribute added by the baseapp to the events emitted
+// outside of the txs, with the block phase that emitted them.
+const modeAttributeKey = "mode"
+
+// The block phases of the EVM calls executed outside of the txs.
+const (
+	CosmosCallPhaseBeginBlock = "BeginBlock"
+	CosmosCallPhaseEndBlock   = "EndBlock"
+)
+
+// ParseCosmosEVMCalls parses the EVM calls executed by the given Cosmos messages
+// of a tx from its events. The calls executed by ethereum txs, e.g. through the
+// precompiles, are traced along the txs and ignored, as well as the calls
+// executed outside of the messages, e.g. in the ante handler. txIndex is the
+// index of the tx in the block, and ethTxIndex is the number of ethereum txs of
+// the block executed before the tx.
+func ParseCosmosEVMCalls(result *abci.ExecTxResult, msgs []sdk.Msg, txHash string, txIndex, ethTxIndex int) ([]*evmtypes.CosmosEVMCall, error) {
+	var calls []*evmtypes.CosmosEVMCall
+	for _, event := range result.Events {
+		if event.Type != evmtypes.EventTypeCosmosCall {
+			continue
+		}
+
+		call, attrs, err := parseCosmosEVMCall(event)
+		if err != nil {
+			return nil, err
+		}
+
+		msgIndex, ok := attrs[msgIndexAttributeKey]
+		if !ok {
+			continue
+		}
+		index, err := strconv.Atoi(msgIndex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s attribute %s: %w", event.Type, msgIndexAttributeKey, err)
+		}
+		if index < 0 || index >= len(msgs) {
+			continue
+		}
+		if _, ok := msgs[index].(*evmtypes.MsgEthereumTx); ok {
+			continue
+		}
+
+		call.EthTxIndex = uint64(ethTxIndex) //nolint:gosec // G115 // won't exceed uint64
+		call.TxHash = txHash
+		call.TxIndex = uint64(txIndex) //nolint:gosec // G115 // won't exceed uint64
+		call.MsgIndex = uint64(index)  //nolint:gosec // G115 // won't exceed uint64
+		call.MsgType = sdk.MsgTypeURL(msgs[index])
+		calls = append(calls, call)
+	}
+
+	return calls, nil
+}
+
+// ParseBlockCosmosEVMCalls parses the EVM calls executed by the modules in the
+// given block phase, e.g. by the governance proposals in the end block, from
+// the events of the block. ethTxIndex is the number of ethereum txs of the
+// block executed before the phase.
+func ParseBlockCosmosEVMCalls(events []abci.Event, phase string, ethTxIndex int) ([]*evmtypes.CosmosEVMCall, error) {
+	var calls []*evmtypes.CosmosEVMCall
+	for _, event := range events {
+		if event.Type != evmtypes.EventTypeCosmosCall {
+			continue
+		}
+
+		call, attrs, err := parseCosmosEVMCall(event)
+		if err != nil {
+			return nil, err
+		}
+		if attrs[modeAttributeKey] != phase {
+			continue
+		}
+
+		call.EthTxIndex = uint64(ethTxIndex) //nolint:gosec // G115 // won't exceed uint64
+		call.Phase = phase
+		calls = append(calls, call)
+	}
+
+	return calls, nil
+}
+
+// parseCosmosEVMCall parses the call of an EVM call event, and returns the
+// other attributes of the event.
+func parseCosmosEVMCall(event abci.Event) (*evmtypes.CosmosEVMCall, map[string]string, error) {
+	call := &evmtypes.CosmosEVMCall{}
+	attrs := make(map[string]string)
+	for _, attr := range event.Attributes {
+		var err error
+		switch attr.Key {
+		case evmtypes.AttributeKeySender:
+			call.From = attr.Value
+		case evmtypes.AttributeKeyRecipient:
+			call.To = attr.Value
+		case evmtypes.AttributeKeyNonce:
+			call.Nonce, err = strconv.ParseUint(attr.Value, 10, 64)
+		case evmtypes.AttributeKeyGasLimit:
+			call.GasLimit, err = strconv.ParseUint(attr.Value, 10, 64)
+		case evmtypes.AttributeKeyInput:
+			call.Data, err = hexutil.Decode(attr.Value)
+		default:
+			attrs[attr.Key] = attr.Value
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid %s attribute %s: %w", event.Type, attr.Key, err)
+		}
+	}
+	return call, attrs, nil
+}
@@ -10,6 +10,9 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 func TestParseTxResult(t *testing.T) {
@@ -190,3 +193,132 @@ func TestParseTxResult(t *testing.T) {
 		})
 	}
 }
+
+func TestParseCosmosEVMCalls(t *testing.T) {
+	from := "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2"
+	to := "0xC6Fe5D33615a1C52c08018c47E8Bc53646A0E101"
+	txHash := "0x0102"
+
+	callEvent := func(input, msgIndex string, recipient string) abci.Event {
+		event := abci.Event{Type: evmtypes.EventTypeCosmosCall, Attributes: []abci.EventAttribute{
+			{Key: evmtypes.AttributeKeySender, Value: from},
+			{Key: evmtypes.AttributeKeyRecipient, Value: recipient},
+			{Key: evmtypes.AttributeKeyNonce, Value: "3"},
+			{Key: evmtypes.AttributeKeyGasLimit, Value: "25000000"},
+			{Key: evmtypes.AttributeKeyInput, Value: input},
+		}}
+		if msgIndex != "" {
+			event.Attributes = append(event.Attributes, abci.EventAttribute{Key: "msg_index", Value: msgIndex})
+		}
+		return event
+	}
+
+	msgs := []sdk.Msg{&banktypes.MsgSend{}, &evmtypes.MsgEthereumTx{}, &banktypes.MsgMultiSend{}}
+
+	testCases := []struct {
+		name     string
+		events   []abci.Event
+		expCalls []*evmtypes.CosmosEVMCall
+		expErr   string
+	}{
+		{
+			"no calls",
+			[]abci.Event{{Type: "coin_received"}},
+			nil,
+			"",
+		},
+		{
+			"calls of cosmos messages",
+			[]abci.Event{callEvent("0xa9059cbb", "0", to), {Type: "coin_received"}, callEvent("0x", "2", "")},
+			[]*evmtypes.CosmosEVMCall{
+				{
+					From:       from,
+					To:         to,
+					Nonce:      3,
+					GasLimit:   25000000,
+					Data:       []byte{0xa9, 0x05, 0x9c, 0xbb},
+					EthTxIndex: 4,
+					TxHash:     txHash,
+					TxIndex:    6,
+					MsgIndex:   0,
+					MsgType:    "/cosmos.bank.v1beta1.MsgSend",
+				},
+				{
+					From:       from,
+					Nonce:      3,
+					GasLimit:   25000000,
+					Data:       []byte{},
+					EthTxIndex: 4,
+					TxHash:     txHash,
+					TxIndex:    6,
+					MsgIndex:   2,
+					MsgType:    "/cosmos.bank.v1beta1.MsgMultiSend",
+				},
+			},
+			"",
+		},
+		{
+			"calls of ethereum txs and outside of the messages are ignored",
+			[]abci.Event{callEvent("0x", "1", to), callEvent("0x", "", to)},
+			nil,
+			"",
+		},
+		{
+			"invalid nonce",
+			[]abci.Event{{Type: evmtypes.EventTypeCosmosCall, Attributes: []abci.EventAttribute{
+				{Key: evmtypes.AttributeKeyNonce, Value: "invalid"},
+			}}},
+			nil,
+			"invalid cosmos_evm_call attribute nonce",
+		},
+		{
+			"invalid input",
+			[]abci.Event{callEvent("a9059cbb", "0", to)},
+			nil,
+			"invalid cosmos_evm_call attribute input",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls, err := ParseCosmosEVMCalls(&abci.ExecTxResult{Events: tc.events}, msgs, txHash, 6, 4)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expCalls, calls)
+		})
+	}
+}
+
+func TestParseBlockCosmosEVMCalls(t *testing.T) {
+	from := "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2"
+
+	callEvent := func(input, mode string) abci.Event {
+		return abci.Event{Type: evmtypes.EventTypeCosmosCall, Attributes: []abci.EventAttribute{
+			{Key: evmtypes.AttributeKeySender, Value: from},
+			{Key: evmtypes.AttributeKeyInput, Value: input},
+			{Key: "mode", Value: mode},
+		}}
+	}
+	events := []abci.Event{
+		callEvent("0x01", CosmosCallPhaseBeginBlock),
+		{Type: "coin_received", Attributes: []abci.EventAttribute{{Key: "mode", Value: CosmosCallPhaseEndBlock}}},
+		callEvent("0x03", CosmosCallPhaseEndBlock),
+		callEvent("0x04", CosmosCallPhaseEndBlock),
+	}
+
+	calls, err := ParseBlockCosmosEVMCalls(events, CosmosCallPhaseBeginBlock, 0)
+	require.NoError(t, err)
+	require.Equal(t, []*evmtypes.CosmosEVMCall{
+		{From: from, Data: []byte{0x01}, Phase: CosmosCallPhaseBeginBlock},
+	}, calls)
+
+	calls, err = ParseBlockCosmosEVMCalls(events, CosmosCallPhaseEndBlock, 2)
+	require.NoError(t, err)
+	require.Equal(t, []*evmtypes.CosmosEVMCall{
+		{From: from, Data: []byte{0x03}, EthTxIndex: 2, Phase: CosmosCallPhaseEndBlock},
+		{From: from, Data: []byte{0x04}, EthTxIndex: 2, Phase: CosmosCallPhaseEndBlock},
+	}, calls)
+}
@@ -0,0 +1,260 @@
+// Package proof verifies the Cosmos store proofs returned by eth_getProof in
+// the verification mode against the app hash of a CometBFT header.
+package proof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/rootmulti"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Config defines the chain parameters needed to check the account fields of an
+// eth_getProof result against the proven store values.
+type Config struct {
+	// Denom is the x/bank denom of the EVM coin and Decimals its decimals.
+	Denom    string
+	Decimals evmtypes.Decimals
+	// BlockTime is the time of the block at the envelope's Height. It is used to
+	// compute the locked coins of vesting accounts.
+	BlockTime time.Time
+}
+
+// accountRegistry resolves the account types stored in x/auth.
+var accountRegistry = newAccountRegistry()
+
+func newAccountRegistry() codectypes.InterfaceRegistry {
+	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	vestingtypes.RegisterInterfaces(registry)
+	return registry
+}
+
+// VerifyAccountResult verifies the Cosmos proofs of an eth_getProof result
+// against the given app hash, which must be taken from a trusted CometBFT
+// header at the height of the envelope's AppHashHeight. It checks that the
+// proofs are made for the account and storage keys of the result and that the
+// returned nonce, balance, code hash and storage values match the proven ones.
+func VerifyAccountResult(appHash []byte, result *rpctypes.AccountResult, config Config) error {
+	if result == nil {
+		return errors.New("empty account result")
+	}
+	envelope := result.CosmosProof
+	if envelope == nil {
+		return errors.New("account result does not contain a cosmos proof")
+	}
+
+	if err := checkStoreKey(envelope.Account, authtypes.StoreKey, rpctypes.AccountStoreKey(result.Address)); err != nil {
+		return fmt.Errorf("account proof: %w", err)
+	}
+	if err := VerifyStoreProof(appHash, envelope.Account); err != nil {
+		return fmt.Errorf("account proof: %w", err)
+	}
+	if err := verifyAccountFields(appHash, result, config); err != nil {
+		return err
+	}
+
+	if len(envelope.Storage) != len(result.StorageProof) {
+		return fmt.Errorf("storage proofs length mismatch: %d != %d", len(envelope.Storage), len(result.StorageProof))
+	}
+	for i, storage := range result.StorageProof {
+		proof := envelope.Storage[i]
+		stateKey := evmtypes.StateKey(result.Address, common.HexToHash(storage.Key).Bytes())
+		if err := checkStoreKey(proof, evmtypes.StoreKey, stateKey); err != nil {
+			return fmt.Errorf("storage proof %s: %w", storage.Key, err)
+		}
+		if storage.Value == nil || storage.Value.ToInt().Cmp(new(big.Int).SetBytes(proof.Value)) != 0 {
+			return fmt.Errorf("storage proof %s: value mismatch", storage.Key)
+		}
+		if err := VerifyStoreProof(appHash, proof); err != nil {
+			return fmt.Errorf("storage proof %s: %w", storage.Key, err)
+		}
+	}
+
+	return nil
+}
+
+// verifyAccountFields checks the nonce, balance and code hash of the result
+// against the proven account, balance and code hash store values. An absent
+// account is returned by the node as an empty account.
+func verifyAccountFields(appHash []byte, result *rpctypes.AccountResult, config Config) error {
+	envelope := result.CosmosProof
+
+	if len(envelope.Account.Value) == 0 {
+		if result.Nonce != 0 || result.Balance == nil || result.Balance.ToInt().Sign() != 0 ||
+			result.CodeHash != common.BytesToHash(evmtypes.EmptyCodeHash) {
+			return errors.New("account proof: non-empty result for an absent account")
+		}
+		return nil
+	}
+
+	account, err := decodeAccount(envelope.Account.Value)
+	if err != nil {
+		return fmt.Errorf("account proof: %w", err)
+	}
+	if uint64(result.Nonce) != account.GetSequence() {
+		return fmt.Errorf("account proof: nonce mismatch: expected %d, got %d", account.GetSequence(), uint64(result.Nonce))
+	}
+
+	balance, err := provenBalance(appHash, result.Address, envelope, account, config)
+	if err != nil {
+		return fmt.Errorf("balance proof: %w", err)
+	}
+	if result.Balance == nil || result.Balance.ToInt().Cmp(balance.BigInt()) != 0 {
+		return fmt.Errorf("balance proof: balance mismatch: expected %s, got %s", balance, result.Balance)
+	}
+
+	if err := checkStoreKey(envelope.CodeHash, evmtypes.StoreKey, rpctypes.CodeHashStoreKey(result.Address)); err != nil {
+		return fmt.Errorf("code hash proof: %w", err)
+	}
+	if err := VerifyStoreProof(appHash, envelope.CodeHash); err != nil {
+		return fmt.Errorf("code hash proof: %w", err)
+	}
+	codeHash := common.BytesToHash(evmtypes.EmptyCodeHash)
+	if len(envelope.CodeHash.Value) > 0 {
+		codeHash = common.BytesToHash(envelope.CodeHash.Value)
+	}
+	if result.CodeHash != codeHash {
+		return fmt.Errorf("code hash proof: code hash mismatch: expected %s, got %s", codeHash, result.CodeHash)
+	}
+
+	return nil
+}
+
+// provenBalance returns the spendable balance of the EVM coin in 18 decimals
+// from the proven x/bank and x/precisebank balances of the account.
+func provenBalance(
+	appHash []byte,
+	address common.Address,
+	envelope *rpctypes.CosmosProofEnvelope,
+	account sdk.AccountI,
+	config Config,
+) (sdkmath.Int, error) {
+	balanceKey, err := rpctypes.BalanceStoreKey(address, config.Denom)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	if err := checkStoreKey(envelope.Balance, banktypes.StoreKey, balanceKey); err != nil {
+		return sdkmath.Int{}, err
+	}
+	if err := VerifyStoreProof(appHash, envelope.Balance); err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	balance := sdkmath.ZeroInt()
+	if len(envelope.Balance.Value) > 0 {
+		if balance, err = banktypes.BalanceValueCodec.Decode(envelope.Balance.Value); err != nil {
+			return sdkmath.Int{}, err
+		}
+	}
+
+	// locked coins of vesting accounts are not spendable in the EVM
+	if vesting, ok := account.(banktypes.VestingAccount); ok {
+		balance = balance.Sub(vesting.LockedCoins(config.BlockTime).AmountOf(config.Denom))
+		if balance.IsNegative() {
+			return sdkmath.Int{}, errors.New("locked coins exceed the balance")
+		}
+	}
+
+	if config.Decimals == evmtypes.EighteenDecimals {
+		return balance, nil
+	}
+
+	fractional := envelope.FractionalBalance
+	if fractional == nil {
+		return sdkmath.Int{}, errors.New("missing fractional balance proof")
+	}
+	if err := checkStoreKey(*fractional, precisebanktypes.StoreKey, rpctypes.FractionalBalanceStoreKey(address)); err != nil {
+		return sdkmath.Int{}, fmt.Errorf("fractional balance: %w", err)
+	}
+	if err := VerifyStoreProof(appHash, *fractional); err != nil {
+		return sdkmath.Int{}, fmt.Errorf("fractional balance: %w", err)
+	}
+
+	fractionalAmount := sdkmath.ZeroInt()
+	if len(fractional.Value) > 0 {
+		if err := fractionalAmount.Unmarshal(fractional.Value); err != nil {
+			return sdkmath.Int{}, fmt.Errorf("fractional balance: %w", err)
+		}
+	}
+
+	return balance.Mul(config.Decimals.ConversionFactor()).Add(fractionalAmount), nil
+}
+
+// decodeAccount decodes an account as stored in x/auth. The public key of the
+// account is left packed, as only its sequence and balance are checked.
+func decodeAccount(bz []byte) (sdk.AccountI, error) {
+	var anyAccount codectypes.Any
+	if err := anyAccount.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	msg, err := accountRegistry.Resolve(anyAccount.TypeUrl)
+	if err != nil {
+		return nil, err
+	}
+	if err := proto.Unmarshal(anyAccount.Value, msg); err != nil {
+		return nil, err
+	}
+	account, ok := msg.(sdk.AccountI)
+	if !ok {
+		return nil, fmt.Errorf("invalid account type %s", anyAccount.TypeUrl)
+	}
+	return account, nil
+}
+
+// VerifyStoreProof verifies the ICS-23 proof of a single store key against the
+// given app hash. A proof with an empty value is verified as a proof of absence.
+func VerifyStoreProof(appHash []byte, proof rpctypes.CosmosStoreProof) error {
+	if len(appHash) == 0 {
+		return errors.New("empty app hash")
+	}
+	if len(proof.ProofOps) == 0 {
+		return errors.New("empty proof")
+	}
+
+	ops := &crypto.ProofOps{Ops: make([]crypto.ProofOp, len(proof.ProofOps))}
+	for i, op := range proof.ProofOps {
+		ops.Ops[i] = crypto.ProofOp{
+			Type: op.Type,
+			Key:  op.Key,
+			Data: op.Data,
+		}
+	}
+
+	keyPath := rpctypes.CosmosKeyPath(proof.StoreName, proof.Key)
+	runtime := rootmulti.DefaultProofRuntime()
+	if len(proof.Value) == 0 {
+		return runtime.VerifyAbsence(ops, appHash, keyPath)
+	}
+	return runtime.VerifyValue(ops, appHash, keyPath, proof.Value)
+}
+
+// checkStoreKey checks that the proof is made for the expected store and key.
+func checkStoreKey(proof rpctypes.CosmosStoreProof, storeName string, key []byte) error {
+	if proof.StoreName != storeName {
+		return fmt.Errorf("invalid store name: expected %s, got %s", storeName, proof.StoreName)
+	}
+	if !bytes.Equal(proof.Key, key) {
+		return fmt.Errorf("invalid key: expected %x, got %x", key, []byte(proof.Key))
+	}
+	return nil
+}
@@ -0,0 +1,353 @@
+package proof_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cosmos/cosmos-db"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/rpc/types/proof"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	testDenom       = "atest"
+	testBalance     = 1000
+	testFractional  = 7
+	testNonce       = 3
+	testVestingTime = 100
+)
+
+var testCodeHash = common.Hash{0xc0}
+
+// setupAccountResult commits an account, its balance, code hash and a storage
+// slot to a multistore and returns the app hash along with an eth_getProof
+// result for the given slots and the config to verify it.
+func setupAccountResult(
+	t *testing.T,
+	decimals evmtypes.Decimals,
+	vesting bool,
+	slots ...common.Hash,
+) ([]byte, *rpctypes.AccountResult, proof.Config) {
+	t.Helper()
+
+	accKey := storetypes.NewKVStoreKey(authtypes.StoreKey)
+	bankKey := storetypes.NewKVStoreKey(banktypes.StoreKey)
+	preciseKey := storetypes.NewKVStoreKey(precisebanktypes.StoreKey)
+	evmKey := storetypes.NewKVStoreKey(evmtypes.StoreKey)
+
+	store := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	for _, key := range []*storetypes.KVStoreKey{accKey, bankKey, preciseKey, evmKey} {
+		store.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, store.LoadLatestVersion())
+
+	address := utiltx.GenerateAddress()
+	config := proof.Config{
+		Denom:     testDenom,
+		Decimals:  decimals,
+		BlockTime: time.Unix(testVestingTime/2, 0),
+	}
+
+	// half of the vesting coins are locked at the block time
+	var account sdk.AccountI = authtypes.NewBaseAccount(address.Bytes(), nil, 0, testNonce)
+	expBalance := sdkmath.NewInt(testBalance)
+	if vesting {
+		vestingCoins := sdk.NewCoins(sdk.NewInt64Coin(testDenom, testBalance/2))
+		vestingAccount, err := vestingtypes.NewContinuousVestingAccount(
+			account.(*authtypes.BaseAccount), vestingCoins, 0, testVestingTime,
+		)
+		require.NoError(t, err)
+		account = vestingAccount
+		expBalance = expBalance.Sub(sdkmath.NewInt(testBalance / 4))
+	}
+	anyAccount, err := codectypes.NewAnyWithValue(account)
+	require.NoError(t, err)
+	accountBz, err := anyAccount.Marshal()
+	require.NoError(t, err)
+
+	balanceKey, err := rpctypes.BalanceStoreKey(address, testDenom)
+	require.NoError(t, err)
+	balanceBz, err := sdk.IntValue.Encode(sdkmath.NewInt(testBalance))
+	require.NoError(t, err)
+	fractionalBz, err := sdkmath.NewInt(testFractional).Marshal()
+	require.NoError(t, err)
+
+	store.GetKVStore(accKey).Set(rpctypes.AccountStoreKey(address), accountBz)
+	store.GetKVStore(bankKey).Set(balanceKey, balanceBz)
+	store.GetKVStore(preciseKey).Set(rpctypes.FractionalBalanceStoreKey(address), fractionalBz)
+	store.GetKVStore(evmKey).Set(rpctypes.CodeHashStoreKey(address), testCodeHash.Bytes())
+	store.GetKVStore(evmKey).Set(evmtypes.StateKey(address, common.Hash{1}.Bytes()), common.BigToHash(big.NewInt(2)).Bytes())
+	commitID := store.Commit()
+
+	query := func(storeName string, key []byte) rpctypes.CosmosStoreProof {
+		res, err := store.Query(&storetypes.RequestQuery{
+			Path:   "/" + storeName + "/key",
+			Data:   key,
+			Height: commitID.Version,
+			Prove:  true,
+		})
+		require.NoError(t, err)
+		return rpctypes.NewCosmosStoreProof(storeName, key, res.Value, res.ProofOps)
+	}
+
+	if decimals != evmtypes.EighteenDecimals {
+		expBalance = expBalance.Mul(decimals.ConversionFactor()).Add(sdkmath.NewInt(testFractional))
+	}
+
+	result := &rpctypes.AccountResult{
+		Address:  address,
+		Balance:  (*hexutil.Big)(expBalance.BigInt()),
+		CodeHash: testCodeHash,
+		Nonce:    testNonce,
+		CosmosProof: &rpctypes.CosmosProofEnvelope{
+			Height:        hexutil.Uint64(commitID.Version),     //nolint:gosec // G115
+			AppHashHeight: hexutil.Uint64(commitID.Version + 1), //nolint:gosec // G115
+			AppHash:       commitID.Hash,
+			Account:       query(authtypes.StoreKey, rpctypes.AccountStoreKey(address)),
+			Balance:       query(banktypes.StoreKey, balanceKey),
+			CodeHash:      query(evmtypes.StoreKey, rpctypes.CodeHashStoreKey(address)),
+		},
+	}
+	if decimals != evmtypes.EighteenDecimals {
+		fractional := query(precisebanktypes.StoreKey, rpctypes.FractionalBalanceStoreKey(address))
+		result.CosmosProof.FractionalBalance = &fractional
+	}
+	for _, slot := range slots {
+		storageProof := query(evmtypes.StoreKey, evmtypes.StateKey(address, slot.Bytes()))
+		result.StorageProof = append(result.StorageProof, rpctypes.StorageResult{
+			Key:   slot.Hex(),
+			Value: (*hexutil.Big)(new(big.Int).SetBytes(storageProof.Value)),
+		})
+		result.CosmosProof.Storage = append(result.CosmosProof.Storage, storageProof)
+	}
+
+	return commitID.Hash, result, config
+}
+
+func TestVerifyAccountResult(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(appHash []byte, result *rpctypes.AccountResult) []byte
+		expPass  bool
+	}{
+		{
+			"pass - existing and absent storage slots",
+			func(appHash []byte, _ *rpctypes.AccountResult) []byte {
+				return appHash
+			},
+			true,
+		},
+		{
+			"fail - no cosmos proof",
+			func(appHash []byte, result *rpctypes.AccountResult) []byte {
+				result.CosmosProof = nil
+				return appHash
+			},
+			false,
+		},
+		{
+			"fail - invalid app hash",
+			func(_ []byte, _ *rpctypes.AccountResult) []byte {
+				return common.Hash{1}.Bytes()
+			},
+			false,
+		},
+		{
+			"fail - account proof for another address",
+			func(appHash []byte, result *rpctypes.AccountResult) []byte {
+				result.Address = utiltx.GenerateAddress()
+				return appHash
+			},
+			false,
+		},
+		{
+			"fail - tampered account value",
+			func(appHash []byte, result *rpctypes.AccountResult) []byte {
+				result.CosmosProof.Account.Value = []byte("tampered")
+				return appHash
+			},
+			false,
+		},
+		{
+			"fail - tampered balance",
+			func(appHash []byte, result *rpctypes.AccountResult) []byte {
+				result.Balance = (*hexutil.Big)(big.NewInt(testBalance + 1))
+				return appHash
+			},
+			false,
+		},
+		{
+			"fail - tampered nonce",
+			func(appHash []byte, result *rpctypes.AccountResult) []byte {
+				result.Nonce = testNonce + 1
+				return appHash
+			},
+			false,
+		},
+		{
+			"fail - tampered code hash",
+			func(appHash []byte, result *rpctypes.AccountResult) []byte {
+				result.CodeHash = common.BytesToHash(evmtypes.EmptyCodeHash)
+				return appHash
+			},
+			false,
+		},
+		{
+			"fail - tampered code hash proof value",
+			func(appHash []byte, result *rpctypes.AccountResult) []byte {
+				result.CodeHash = common.Hash{0xc1}
+				result.CosmosProof.CodeHash.Value = result.CodeHash.Bytes()
+				return appHash
+			},
+			false,
+		},
+		{
+			"fail - balance proof for another denom",
+			func(appHash []byte, result *rpctypes.AccountResult) []byte {
+				key, err := rpctypes.BalanceStoreKey(result.Address, "aother")
+				if err != nil {
+					panic(err)
+				}
+				result.CosmosProof.Balance.Key = key
+				return appHash
+			},
+			false,
+		},
+		{
+			"fail - storage value mismatch",
+			func(appHash []byte, result *rpctypes.AccountResult) []byte {
+				result.StorageProof[0].Value = (*hexutil.Big)(big.NewInt(3))
+				return appHash
+			},
+			false,
+		},
+		{
+			"fail - tampered storage value",
+			func(appHash []byte, result *rpctypes.AccountResult) []byte {
+				result.StorageProof[0].Value = (*hexutil.Big)(big.NewInt(3))
+				result.CosmosProof.Storage[0].Value = common.BigToHash(big.NewInt(3)).Bytes()
+				return appHash
+			},
+			false,
+		},
+		{
+			"fail - existence claimed for an absent slot",
+			func(appHash []byte, result *rpctypes.AccountResult) []byte {
+				result.StorageProof[1].Value = (*hexutil.Big)(big.NewInt(2))
+				result.CosmosProof.Storage[1].Value = common.BigToHash(big.NewInt(2)).Bytes()
+				return appHash
+			},
+			false,
+		},
+		{
+			"fail - missing storage proof",
+			func(appHash []byte, result *rpctypes.AccountResult) []byte {
+				result.CosmosProof.Storage = result.CosmosProof.Storage[:1]
+				return appHash
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			appHash, result, config := setupAccountResult(t, evmtypes.EighteenDecimals, false, common.Hash{1}, common.Hash{2})
+			appHash = tc.malleate(appHash, result)
+
+			err := proof.VerifyAccountResult(appHash, result, config)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestVerifyAccountResultBalance(t *testing.T) {
+	testCases := []struct {
+		name     string
+		decimals evmtypes.Decimals
+		vesting  bool
+		malleate func(result *rpctypes.AccountResult)
+		expPass  bool
+	}{
+		{
+			"pass - vesting account with locked coins",
+			evmtypes.EighteenDecimals,
+			true,
+			func(*rpctypes.AccountResult) {},
+			true,
+		},
+		{
+			"fail - vesting account with locked coins returned as spendable",
+			evmtypes.EighteenDecimals,
+			true,
+			func(result *rpctypes.AccountResult) {
+				result.Balance = (*hexutil.Big)(big.NewInt(testBalance))
+			},
+			false,
+		},
+		{
+			"pass - six decimals with fractional balance",
+			evmtypes.SixDecimals,
+			false,
+			func(*rpctypes.AccountResult) {},
+			true,
+		},
+		{
+			"fail - six decimals without fractional balance",
+			evmtypes.SixDecimals,
+			false,
+			func(result *rpctypes.AccountResult) {
+				result.Balance = (*hexutil.Big)(new(big.Int).Mul(big.NewInt(testBalance), big.NewInt(1e12)))
+				result.CosmosProof.FractionalBalance = nil
+			},
+			false,
+		},
+		{
+			"fail - six decimals with tampered fractional balance",
+			evmtypes.SixDecimals,
+			false,
+			func(result *rpctypes.AccountResult) {
+				result.Balance = (*hexutil.Big)(new(big.Int).Add(result.Balance.ToInt(), big.NewInt(1)))
+				result.CosmosProof.FractionalBalance.Value = []byte("tampered")
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			appHash, result, config := setupAccountResult(t, tc.decimals, tc.vesting)
+			tc.malleate(result)
+
+			err := proof.VerifyAccountResult(appHash, result, config)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
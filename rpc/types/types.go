@@ -23,6 +23,8 @@ type AccountResult struct {
 	Nonce        hexutil.Uint64  `json:"nonce"`
 	StorageHash  common.Hash     `json:"storageHash"`
 	StorageProof []StorageResult `json:"storageProof"`
+	// CosmosProof is only set if requested through the ProofConfig
+	CosmosProof *CosmosProofEnvelope `json:"cosmosProof,omitempty"`
 }
 
 // StorageResult defines the format for storage proof return
@@ -32,6 +34,66 @@ type StorageResult struct {
 	Proof []string     `json:"proof"`
 }
 
+// StorageRangeResult is the result of a debug_storageRangeAt API call.
+type StorageRangeResult struct {
+	Storage StorageMap   `json:"storage"`
+	NextKey *common.Hash `json:"nextKey"` // nil if Storage includes the last key of the account.
+}
+
+// StorageMap maps the Keccak-256 hash of the storage keys to their entries, as
+// returned by debug_storageRangeAt.
+type StorageMap map[common.Hash]StorageEntry
+
+// StorageEntry defines a single storage slot returned by debug_storageRangeAt.
+type StorageEntry struct {
+	Key   *common.Hash `json:"key"`
+	Value common.Hash  `json:"value"`
+}
+
+// ProofConfig defines the optional parameters of eth_getProof.
+type ProofConfig struct {
+	// CosmosProof enables the verification mode, which returns the ICS-23
+	// proofs of the Cosmos store along with the data needed to verify them
+	// against the app hash of a block.
+	CosmosProof bool `json:"cosmosProof"`
+}
+
+// CosmosProofEnvelope wraps the Cosmos store proofs of an account and its
+// storage slots. The proofs are made against the state committed at Height,
+// whose app hash is included in the CometBFT header at AppHashHeight.
+type CosmosProofEnvelope struct {
+	Height        hexutil.Uint64 `json:"height"`
+	AppHashHeight hexutil.Uint64 `json:"appHashHeight"`
+	// AppHash is the app hash of the header at AppHashHeight. It is omitted
+	// if that header has not been produced yet.
+	AppHash hexutil.Bytes    `json:"appHash,omitempty"`
+	Account CosmosStoreProof `json:"account"`
+	// Balance is the proof of the x/bank balance of the EVM coin. For EVM
+	// coins with less than 18 decimals, FractionalBalance is the proof of the
+	// x/precisebank fractional balance.
+	Balance           CosmosStoreProof   `json:"balance"`
+	FractionalBalance *CosmosStoreProof  `json:"fractionalBalance,omitempty"`
+	CodeHash          CosmosStoreProof   `json:"codeHash"`
+	Storage           []CosmosStoreProof `json:"storage"`
+}
+
+// CosmosStoreProof defines the proof of a single key of a Cosmos store. An
+// empty value denotes a proof of absence.
+type CosmosStoreProof struct {
+	StoreName string          `json:"storeName"`
+	Key       hexutil.Bytes   `json:"key"`
+	KeyPath   string          `json:"keyPath"`
+	Value     hexutil.Bytes   `json:"value"`
+	ProofOps  []CosmosProofOp `json:"proofOps"`
+}
+
+// CosmosProofOp defines a single operation of a CometBFT merkle proof.
+type CosmosProofOp struct {
+	Type string        `json:"type"`
+	Key  hexutil.Bytes `json:"key"`
+	Data hexutil.Bytes `json:"data"`
+}
+
 // RPCTransaction represents a transaction that will serialize to the RPC representation of a transaction
 type RPCTransaction struct {
 	BlockHash           *common.Hash                    `json:"blockHash"`
@@ -60,21 +122,15 @@ type RPCTransaction struct {
 }
 
 // StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
+type StateOverride = evmtypes.StateOverride
 
 // OverrideAccount indicates the overriding fields of account during the execution of
 // a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
-}
+type OverrideAccount = evmtypes.OverrideAccount
+
+// BlockOverrides is a set of header fields to override during the execution
+// of a message call.
+type BlockOverrides = evmtypes.BlockOverrides
 
 type FeeHistoryResult struct {
 	OldestBlock  *hexutil.Big     `json:"oldestBlock"`
@@ -107,3 +163,25 @@ type TraceConfig struct {
 	evmtypes.TraceConfig
 	TracerConfig json.RawMessage `json:"tracerConfig"`
 }
+
+// TraceCallConfig is the config for traceCall API. It holds one more
+// field to override the state for tracing.
+type TraceCallConfig struct {
+	TraceConfig
+	StateOverrides *StateOverride  `json:"stateOverrides"`
+	BlockOverrides *BlockOverrides `json:"blockOverrides"`
+}
+
+// SyncStatus is the progress of a node catching up with the network.
+type SyncStatus struct {
+	StartingBlock hexutil.Uint64 `json:"startingBlock"`
+	CurrentBlock  hexutil.Uint64 `json:"currentBlock"`
+	HighestBlock  hexutil.Uint64 `json:"highestBlock"`
+}
+
+// SyncingResult is the notification of a syncing subscription while the node
+// is catching up with the network.
+type SyncingResult struct {
+	Syncing bool       `json:"syncing"`
+	Status  SyncStatus `json:"status"`
+}
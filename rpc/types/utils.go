@@ -12,16 +12,23 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/proto/tendermint/crypto"
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
 	cmttypes "github.com/cometbft/cometbft/types"
 
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // ExceedBlockGasLimitError defines the error message when tx execution exceeds the block gas limit.
@@ -185,6 +192,37 @@ func NewRPCTransaction(
 	if err != nil {
 		return nil, err
 	}
+	return newRPCTransaction(tx, from, blockHash, blockNumber, index, baseFee, chainID), nil
+}
+
+// NewRPCPendingTransaction returns a transaction from the mempool that will
+// serialize to the RPC representation, without any block location metadata.
+func NewRPCPendingTransaction(tx *ethtypes.Transaction, chainID *big.Int) (*RPCTransaction, error) {
+	var signer ethtypes.Signer
+	if tx.Protected() {
+		signer = ethtypes.LatestSignerForChainID(tx.ChainId())
+	} else {
+		signer = ethtypes.FrontierSigner{}
+	}
+	from, err := ethtypes.Sender(signer, tx)
+	if err != nil {
+		return nil, err
+	}
+	return newRPCTransaction(tx, from, common.Hash{}, 0, 0, nil, chainID), nil
+}
+
+// newRPCTransaction returns a transaction sent by the given address that will
+// serialize to the RPC representation, with the given location metadata set
+// (if available).
+func newRPCTransaction(
+	tx *ethtypes.Transaction,
+	from common.Address,
+	blockHash common.Hash,
+	blockNumber,
+	index uint64,
+	baseFee,
+	chainID *big.Int,
+) *RPCTransaction {
 	v, r, s := tx.RawSignatureValues()
 	result := &RPCTransaction{
 		Type:     hexutil.Uint64(tx.Type()),
@@ -263,7 +301,54 @@ func NewRPCTransaction(
 		result.AuthorizationList = tx.SetCodeAuthorizations()
 	}
 
-	return result, nil
+	return result
+}
+
+// FormatSimulatedBlock formats a block simulated by eth_simulateV1 into its RPC
+// representation, along with the results of the simulated calls.
+func FormatSimulatedBlock(result *evmtypes.SimBlockResult, fullTx bool, chainID *big.Int) map[string]interface{} {
+	header := result.Header
+	blockHash := header.Hash()
+
+	transactions := make([]interface{}, 0, len(result.Transactions))
+	for i, tx := range result.Transactions {
+		if !fullTx {
+			transactions = append(transactions, tx.Hash())
+			continue
+		}
+		transactions = append(transactions, newRPCTransaction(
+			tx, result.Senders[i], blockHash, header.Number.Uint64(), uint64(i), header.BaseFee, chainID, //nolint:gosec // G115 // won't exceed uint64
+		))
+	}
+	size := ethtypes.NewBlockWithHeader(header).WithBody(ethtypes.Body{Transactions: result.Transactions}).Size()
+
+	block := map[string]interface{}{
+		"number":           (*hexutil.Big)(header.Number),
+		"hash":             blockHash,
+		"parentHash":       header.ParentHash,
+		"nonce":            header.Nonce,
+		"sha3Uncles":       header.UncleHash,
+		"logsBloom":        header.Bloom,
+		"stateRoot":        header.Root,
+		"miner":            header.Coinbase,
+		"mixHash":          header.MixDigest,
+		"difficulty":       (*hexutil.Big)(header.Difficulty),
+		"extraData":        hexutil.Bytes(header.Extra),
+		"size":             hexutil.Uint64(size),
+		"gasLimit":         hexutil.Uint64(header.GasLimit),
+		"gasUsed":          hexutil.Uint64(header.GasUsed),
+		"timestamp":        hexutil.Uint64(header.Time),
+		"transactionsRoot": header.TxHash,
+		"receiptsRoot":     header.ReceiptHash,
+		"uncles":           []common.Hash{},
+		"transactions":     transactions,
+		"calls":            result.Calls,
+	}
+	if header.BaseFee != nil {
+		block["baseFeePerGas"] = (*hexutil.Big)(header.BaseFee)
+	}
+
+	return block
 }
 
 // effectiveGasPrice computes the transaction gas fee, based on the given basefee value.
@@ -334,3 +419,64 @@ func TxStateDBCommitError(res *abci.ExecTxResult) bool {
 func TxSucessOrExpectedFailure(res *abci.ExecTxResult) bool {
 	return res.Code == 0 || TxExceedBlockGasLimit(res) || TxStateDBCommitError(res)
 }
+
+// AccountStoreKey returns the x/auth store key of the account of the given
+// address.
+func AccountStoreKey(address common.Address) []byte {
+	return append(append([]byte{}, authtypes.AddressStoreKeyPrefix...), address.Bytes()...)
+}
+
+// BalanceStoreKey returns the x/bank store key of the balance of the given
+// address for the given denom.
+func BalanceStoreKey(address common.Address, denom string) ([]byte, error) {
+	return collections.EncodeKeyWithPrefix(
+		banktypes.BalancesPrefix,
+		collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey),
+		collections.Join(sdk.AccAddress(address.Bytes()), denom),
+	)
+}
+
+// FractionalBalanceStoreKey returns the x/precisebank store key of the
+// fractional balance of the given address.
+func FractionalBalanceStoreKey(address common.Address) []byte {
+	key := append([]byte{}, precisebanktypes.FractionalBalancePrefix...)
+	return append(key, precisebanktypes.FractionalBalanceKey(address.Bytes())...)
+}
+
+// CodeHashStoreKey returns the x/vm store key of the code hash of the given
+// address.
+func CodeHashStoreKey(address common.Address) []byte {
+	return append(append([]byte{}, evmtypes.KeyPrefixCodeHash...), address.Bytes()...)
+}
+
+// CosmosKeyPath returns the merkle key path of the given key within the store,
+// as expected by the CometBFT proof runtime.
+func CosmosKeyPath(storeName string, key []byte) string {
+	return merkle.KeyPath{}.
+		AppendKey([]byte(storeName), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingHex).
+		String()
+}
+
+// NewCosmosStoreProof returns the proof of the given store key and value from
+// the proof operations of an ABCI query.
+func NewCosmosStoreProof(storeName string, key, value []byte, proof *crypto.ProofOps) CosmosStoreProof {
+	res := CosmosStoreProof{
+		StoreName: storeName,
+		Key:       key,
+		KeyPath:   CosmosKeyPath(storeName, key),
+		Value:     value,
+		ProofOps:  []CosmosProofOp{},
+	}
+	if proof == nil {
+		return res
+	}
+	for _, op := range proof.Ops {
+		res.ProofOps = append(res.ProofOps, CosmosProofOp{
+			Type: op.Type,
+			Key:  op.Key,
+			Data: op.Data,
+		})
+	}
+	return res
+}
@@ -6,7 +6,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"html"
-	"io"
 	"math/big"
 	"net/http"
 	"net/url"
@@ -14,17 +13,25 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/gorilla/mux"
 	"github.com/gorilla/websocket"
 	"github.com/pkg/errors"
 
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	evmmempool "github.com/cosmos/evm/mempool"
 	rpcfilters "github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
 	"github.com/cosmos/evm/rpc/stream"
+	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
 
 	"cosmossdk.io/log"
@@ -34,6 +41,15 @@ import (
 
 const (
 	maxMessageSize = 1 << 20 // 1 MiB is the max message size for the websocket server
+	txChanSize     = 4096    // size of the channel listening to txpool transaction events
+
+	// txWriteTimeout is the maximum time to write a txpool transaction to a
+	// websocket client, a client not reading in time is dropped
+	txWriteTimeout = 10 * time.Second
+
+	// syncStatusInterval is the minimum interval between two notifications of
+	// a syncing subscription while the node is catching up
+	syncStatusInterval = 5 * time.Second
 )
 
 type WebsocketsServer interface {
@@ -69,8 +85,8 @@ type ErrorMessageJSON struct {
 }
 
 type websocketsServer struct {
-	rpcAddr        string // listen address of rest-server
-	wsAddr         string // listen address of ws server
+	rpcHandler     http.Handler // serves the registered namespaces
+	wsAddr         string       // listen address of ws server
 	certFile       string
 	keyFile        string
 	allowedOrigins []string // allowed origins for WebSocket connections
@@ -78,15 +94,22 @@ type websocketsServer struct {
 	logger         log.Logger
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, stream *stream.RPCStream, cfg *config.Config) WebsocketsServer {
+func NewWebsocketsServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	stream *stream.RPCStream,
+	cfg *config.Config,
+	rpcHandler http.Handler,
+	mempool *evmmempool.ExperimentalEVMMempool,
+) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
 	return &websocketsServer{
-		rpcAddr:        cfg.JSONRPC.Address,
+		rpcHandler:     rpcHandler,
 		wsAddr:         cfg.JSONRPC.WsAddress,
 		certFile:       cfg.TLS.CertificatePath,
 		keyFile:        cfg.TLS.KeyPath,
 		allowedOrigins: cfg.JSONRPC.WSOrigins,
-		api:            newPubSubAPI(clientCtx, logger, stream),
+		api:            newPubSubAPI(clientCtx, logger, stream, mempool, new(big.Int).SetUint64(cfg.EVM.EVMChainID)),
 		logger:         logger,
 	}
 }
@@ -194,8 +217,10 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	conn.SetReadLimit(maxMessageSize)
 
 	ws := &wsConn{
-		mux:  new(sync.Mutex),
-		conn: conn,
+		mux:        new(sync.Mutex),
+		conn:       conn,
+		remoteAddr: r.RemoteAddr,
+		forwarded:  forwardedHeaders(r.Header),
 	}
 
 	s.readLoop(ws)
@@ -217,6 +242,24 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 type wsConn struct {
 	conn *websocket.Conn
 	mux  *sync.Mutex
+
+	// remoteAddr and forwarded identify the client of the upgrade request, so
+	// that the requests served over the connection are rate limited as the
+	// requests of the HTTP server.
+	remoteAddr string
+	forwarded  http.Header
+}
+
+// forwardedHeaders returns the headers of a request identifying the client
+// behind a reverse proxy.
+func forwardedHeaders(header http.Header) http.Header {
+	forwarded := make(http.Header)
+	for _, key := range []string{"X-Forwarded-For", "X-Real-IP"} {
+		if values := header.Values(key); len(values) > 0 {
+			forwarded[http.CanonicalHeaderKey(key)] = values
+		}
+	}
+	return forwarded
 }
 
 func (w *wsConn) WriteJSON(v interface{}) error {
@@ -226,6 +269,19 @@ func (w *wsConn) WriteJSON(v interface{}) error {
 	return w.conn.WriteJSON(v)
 }
 
+// WriteJSONWithTimeout writes v to the connection, failing if the client
+// doesn't read it within the given timeout.
+func (w *wsConn) WriteJSONWithTimeout(v interface{}, timeout time.Duration) error {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+
+	if err := w.conn.SetWriteDeadline(time.Now().Add(timeout)); err != nil {
+		return err
+	}
+	defer w.conn.SetWriteDeadline(time.Time{}) //nolint:errcheck // the connection is dropped on write errors
+	return w.conn.WriteJSON(v)
+}
+
 func (w *wsConn) Close() error {
 	w.mux.Lock()
 	defer w.mux.Unlock()
@@ -260,7 +316,7 @@ readLoop:
 		}
 
 		if isBatch(mb) {
-			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
+			if err := s.serveAndSendResponse(wsConn, mb); err != nil {
 				s.sendErrResponse(wsConn, err.Error())
 			}
 			continue
@@ -276,13 +332,22 @@ readLoop:
 		method, ok := msg["method"].(string)
 		if !ok {
 			// otherwise, call the usual rpc server to respond
-			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
+			if err := s.serveAndSendResponse(wsConn, mb); err != nil {
 				s.sendErrResponse(wsConn, err.Error())
 			}
 
 			continue
 		}
 
+		// the other methods, including notifications that have no ID, are served
+		// by the usual rpc server
+		if method != "eth_subscribe" && method != "eth_unsubscribe" {
+			if err := s.serveAndSendResponse(wsConn, mb); err != nil {
+				s.sendErrResponse(wsConn, err.Error())
+			}
+			continue
+		}
+
 		var connID float64
 		switch id := msg["id"].(type) {
 		case string:
@@ -354,16 +419,11 @@ readLoop:
 				s.logger.Error("error writing unsubscribe response", "error", err.Error())
 				break readLoop
 			}
-		default:
-			// otherwise, call the usual rpc server to respond
-			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
-				s.sendErrResponse(wsConn, err.Error())
-			}
 		}
 	}
 }
 
-// tcpGetAndSendResponse sends error response to client if params is invalid
+// getParamsAndCheckValid sends error response to client if params is invalid
 func (s *websocketsServer) getParamsAndCheckValid(msg map[string]interface{}, wsConn *wsConn) ([]interface{}, bool) {
 	params, ok := msg["params"].([]interface{})
 	if !ok {
@@ -379,49 +439,85 @@ func (s *websocketsServer) getParamsAndCheckValid(msg map[string]interface{}, ws
 	return params, true
 }
 
-// tcpGetAndSendResponse connects to the rest-server over tcp, posts a JSON-RPC request, and sends the response
-// to the client over websockets
-func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) error {
-	req, err := http.NewRequestWithContext(context.Background(), "POST", "http://"+s.rpcAddr, bytes.NewBuffer(mb))
+// serveAndSendResponse serves a JSON-RPC request or batch of requests with the
+// registered namespaces, and sends the response to the client over websockets
+func (s *websocketsServer) serveAndSendResponse(wsConn *wsConn, mb []byte) error {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, "/", bytes.NewReader(mb))
 	if err != nil {
-		return errors.Wrap(err, "Could not build request")
+		return errors.Wrap(err, "could not build request")
+	}
+	for key, values := range wsConn.forwarded {
+		req.Header[key] = values
 	}
-
 	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return errors.Wrap(err, "Could not perform request")
+	req.RemoteAddr = wsConn.remoteAddr
+
+	// the request is handled in-process, the same way as on the HTTP server
+	res := &responseBuffer{header: make(http.Header)}
+	s.rpcHandler.ServeHTTP(res, req)
+
+	// the status is not set if nothing is written, e.g. for notifications
+	if res.status != 0 && res.status != http.StatusOK && !json.Valid(res.body.Bytes()) {
+		// JSON-RPC errors, e.g. from the rate limiter, are sent back as is
+		msg := strings.TrimSpace(res.body.String())
+		if msg == "" {
+			msg = http.StatusText(res.status)
+		}
+		return errors.New(msg)
+	}
+	if res.body.Len() == 0 {
+		// nothing to send back, e.g. for notifications
+		return nil
 	}
 
-	defer resp.Body.Close()
+	return wsConn.WriteJSON(json.RawMessage(res.body.Bytes()))
+}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return errors.Wrap(err, "could not read body from response")
-	}
+// responseBuffer is an in-memory http.ResponseWriter that collects the response
+// of the JSON-RPC server to a websocket message.
+type responseBuffer struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
 
-	var wsSend interface{}
-	err = json.Unmarshal(body, &wsSend)
-	if err != nil {
-		return errors.Wrap(err, "failed to unmarshal rest-server response")
+func (w *responseBuffer) Header() http.Header {
+	return w.header
+}
+
+func (w *responseBuffer) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
 	}
+}
 
-	return wsConn.WriteJSON(wsSend)
+func (w *responseBuffer) Write(b []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.body.Write(b)
 }
 
 // pubSubAPI is the eth_ prefixed set of APIs in the Web3 JSON-RPC spec
 type pubSubAPI struct {
 	events    *stream.RPCStream
+	mempool   *evmmempool.ExperimentalEVMMempool // nil if the EVM mempool is disabled
+	chainID   *big.Int
 	logger    log.Logger
 	clientCtx client.Context
 }
 
 // newPubSubAPI creates an instance of the ethereum PubSub API.
-func newPubSubAPI(clientCtx client.Context, logger log.Logger, stream *stream.RPCStream) *pubSubAPI {
+func newPubSubAPI(
+	clientCtx client.Context,
+	logger log.Logger,
+	stream *stream.RPCStream,
+	mempool *evmmempool.ExperimentalEVMMempool,
+	chainID *big.Int,
+) *pubSubAPI {
 	logger = logger.With("module", "websocket-client")
 	return &pubSubAPI{
 		events:    stream,
+		mempool:   mempool,
+		chainID:   chainID,
 		logger:    logger,
 		clientCtx: clientCtx,
 	}
@@ -443,6 +539,17 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface
 		}
 		return api.subscribeLogs(wsConn, subID, nil)
 	case "newPendingTransactions":
+		var extra interface{}
+		if len(params) > 1 {
+			extra = params[1]
+		}
+		fullTx, includeQueued, err := parsePendingTxsParams(extra)
+		if err != nil {
+			return nil, err
+		}
+		if fullTx || includeQueued {
+			return api.subscribeTxPoolTransactions(wsConn, subID, fullTx, includeQueued)
+		}
 		return api.subscribePendingTransactions(wsConn, subID)
 	case "syncing":
 		return api.subscribeSyncing(wsConn, subID)
@@ -659,8 +766,259 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 	return cancel, nil
 }
 
-func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (context.CancelFunc, error) {
-	return nil, errors.New("syncing subscription is not implemented")
+// parsePendingTxsParams parses the optional parameter of a newPendingTransactions
+// subscription. Like in Geth, it can be the fullTx flag, or an object that also
+// opts in to the non-executable transactions queued in the EVM txpool:
+//
+//	{"fullTx": true, "includeQueued": true}
+func parsePendingTxsParams(extra interface{}) (fullTx, includeQueued bool, err error) {
+	switch params := extra.(type) {
+	case nil:
+		return false, false, nil
+	case bool:
+		return params, false, nil
+	case map[string]interface{}:
+		for key, value := range params {
+			flag, ok := value.(bool)
+			if !ok {
+				return false, false, errors.Errorf("invalid %s flag: %v", key, value)
+			}
+			switch key {
+			case "fullTx":
+				fullTx = flag
+			case "includeQueued":
+				includeQueued = flag
+			default:
+				return false, false, errors.Errorf("unsupported option %s", key)
+			}
+		}
+		return fullTx, includeQueued, nil
+	default:
+		return false, false, errors.Errorf("invalid pending transactions options: %v", extra)
+	}
+}
+
+// subscribeTxPoolTransactions streams the transactions added to the EVM txpool,
+// either as hashes or as complete RPC transactions. Non-executable transactions
+// are only included if includeQueued is set. The txpool feeds wait for their
+// subscribers, so the transactions are written from a queue, and a client that
+// can't keep up with the queue is dropped rather than stalling the txpool.
+func (api *pubSubAPI) subscribeTxPoolTransactions(wsConn *wsConn, subID rpc.ID, fullTx, includeQueued bool) (context.CancelFunc, error) {
+	if api.mempool == nil {
+		return nil, errors.New("transactions from the txpool are not available, the EVM mempool is disabled")
+	}
+
+	txsCh := make(chan core.NewTxsEvent, txChanSize)
+	subs := []event.Subscription{api.mempool.GetTxPool().SubscribeTransactions(txsCh, false)}
+	if includeQueued {
+		subs = append(subs, api.mempool.SubscribeQueuedTransactions(txsCh))
+	}
+	sub := event.JoinSubscriptions(subs...)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	dropPeer := func() {
+		cancel()
+		try(func() {
+			_ = wsConn.Close()
+		}, api.logger, "closing websocket peer sub")
+	}
+	queue := relayTxEvents(ctx, sub, txsCh, func() {
+		api.logger.Debug("txpool transactions queue full, will drop peer", "subscription", subID)
+		dropPeer()
+	})
+
+	go func() {
+		for ev := range queue {
+			if ctx.Err() != nil {
+				// unsubscribed, the queued transactions are discarded
+				return
+			}
+			for _, tx := range ev.Txs {
+				var result interface{} = tx.Hash()
+				if fullTx {
+					rpcTx, err := rpctypes.NewRPCPendingTransaction(tx, api.chainID)
+					if err != nil {
+						api.logger.Debug("failed to build pending transaction", "hash", tx.Hash(), "error", err.Error())
+						continue
+					}
+					result = rpcTx
+				}
+
+				// write to ws conn
+				res := &SubscriptionNotification{
+					Jsonrpc: "2.0",
+					Method:  "eth_subscription",
+					Params: &SubscriptionResult{
+						Subscription: subID,
+						Result:       result,
+					},
+				}
+
+				if err := wsConn.WriteJSONWithTimeout(res, txWriteTimeout); err != nil {
+					api.logger.Debug("error writing transaction, will drop peer", "error", err.Error())
+					dropPeer()
+					return
+				}
+			}
+		}
+	}()
+
+	return cancel, nil
+}
+
+// relayTxEvents forwards the events of a txpool subscription to the returned
+// queue, without ever blocking the txpool feed. If the queue is full, onFull is
+// called and the relay stops. The queue is closed once the relay stops.
+func relayTxEvents(ctx context.Context, sub event.Subscription, txsCh <-chan core.NewTxsEvent, onFull func()) <-chan core.NewTxsEvent {
+	queue := make(chan core.NewTxsEvent, txChanSize)
+	go func() {
+		defer close(queue)
+		defer sub.Unsubscribe()
+
+		for {
+			select {
+			case ev := <-txsCh:
+				select {
+				case queue <- ev:
+				default:
+					onFull()
+					return
+				}
+			case <-sub.Err():
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return queue
+}
+
+// subscribeSyncing notifies the progress of the node catching up with the network,
+// checked on new blocks at most once per syncStatusInterval. Like in Geth, a
+// final false is sent once the node is synced again.
+func (api *pubSubAPI) subscribeSyncing(wsConn *wsConn, subID rpc.ID) (context.CancelFunc, error) {
+	if api.clientCtx.Client == nil {
+		return nil, errors.New("syncing subscription is not available without a CometBFT client")
+	}
+
+	state := &syncState{}
+	ctx, cancel := context.WithCancel(context.Background())
+	//nolint: errcheck
+	go api.events.HeaderStream().Subscribe(ctx, func(_ []stream.RPCHeader, _ int) error {
+		result, err := api.syncNotification(ctx, state, time.Now())
+		if err != nil {
+			api.logger.Debug("failed to get sync status", "error", err.Error())
+			return nil
+		}
+		if result == nil {
+			return nil
+		}
+
+		// write to ws conn
+		res := &SubscriptionNotification{
+			Jsonrpc: "2.0",
+			Method:  "eth_subscription",
+			Params: &SubscriptionResult{
+				Subscription: subID,
+				Result:       result,
+			},
+		}
+
+		if err := wsConn.WriteJSON(res); err != nil {
+			api.logger.Debug("error writing sync status, will drop peer", "error", err.Error())
+
+			try(func() {
+				if err != websocket.ErrCloseSent {
+					_ = wsConn.Close()
+				}
+			}, api.logger, "closing websocket peer sub")
+			return err
+		}
+		return nil
+	})
+
+	return cancel, nil
+}
+
+// syncState is the sync progress last seen by a syncing subscription.
+type syncState struct {
+	syncing       bool  // whether the node was catching up at the last check
+	startingBlock int64 // latest block of the node when it started catching up
+	lastChecked   time.Time
+}
+
+// syncNotification checks the sync status of the node, unless it was checked
+// less than syncStatusInterval ago, and returns the notification of the
+// subscription: the progress while the node is catching up, false once it is
+// synced again, or nil if there is nothing to notify.
+func (api *pubSubAPI) syncNotification(ctx context.Context, state *syncState, now time.Time) (interface{}, error) {
+	if now.Sub(state.lastChecked) < syncStatusInterval {
+		return nil, nil
+	}
+	state.lastChecked = now
+
+	status, err := api.clientCtx.Client.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !status.SyncInfo.CatchingUp {
+		if !state.syncing {
+			return nil, nil
+		}
+		state.syncing = false
+		return false, nil
+	}
+
+	current := status.SyncInfo.LatestBlockHeight
+	if !state.syncing {
+		state.syncing, state.startingBlock = true, current
+	}
+	return &rpctypes.SyncingResult{
+		Syncing: true,
+		Status: rpctypes.SyncStatus{
+			StartingBlock: hexutil.Uint64(state.startingBlock),                     //nolint:gosec // G115 // won't exceed uint64
+			CurrentBlock:  hexutil.Uint64(current),                                 //nolint:gosec // G115 // won't exceed uint64
+			HighestBlock:  hexutil.Uint64(max(current, api.highestPeerBlock(ctx))), //nolint:gosec // G115 // won't exceed uint64
+		},
+	}, nil
+}
+
+// consensusStateClient is implemented by the CometBFT clients exposing the
+// consensus state of the node, which includes the round state of its peers.
+type consensusStateClient interface {
+	DumpConsensusState(context.Context) (*coretypes.ResultDumpConsensusState, error)
+}
+
+// highestPeerBlock returns the highest block committed by the peers of the node,
+// or 0 if it is unknown. Peers keep gossiping their round state while the node
+// is catching up, and a peer at a given height has committed the previous block.
+func (api *pubSubAPI) highestPeerBlock(ctx context.Context) int64 {
+	client, ok := api.clientCtx.Client.(consensusStateClient)
+	if !ok {
+		return 0
+	}
+
+	res, err := client.DumpConsensusState(ctx)
+	if err != nil {
+		api.logger.Debug("failed to get consensus state", "error", err.Error())
+		return 0
+	}
+
+	var highest int64
+	for _, peer := range res.Peers {
+		var state struct {
+			RoundState struct {
+				Height int64 `json:"height,string"`
+			} `json:"round_state"`
+		}
+		if err := json.Unmarshal(peer.PeerState, &state); err != nil {
+			continue
+		}
+		highest = max(highest, state.RoundState.Height-1)
+	}
+	return highest
 }
 
 // copy from github.com/ethereum/go-ethereum/rpc/json.go
@@ -1,16 +1,30 @@
 package rpc
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/evm/rpc/backend/mocks"
 	"github.com/cosmos/evm/rpc/stream"
+	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/server/config"
 
 	"cosmossdk.io/log"
@@ -21,22 +35,34 @@ import (
 func newTestWebsocketServer() *websocketsServer {
 	// dummy values for testing
 	cfg := &config.Config{}
-	cfg.JSONRPC.Address = "localhost:9999"   // not used
 	cfg.JSONRPC.WsAddress = "localhost:9999" // not used
 	cfg.TLS.CertificatePath = ""
 	cfg.TLS.KeyPath = ""
 
+	rpcServer := rpc.NewServer()
+	rpcServer.SetBatchLimits(2, 1<<20)
+	if err := rpcServer.RegisterName("test", testService{}); err != nil {
+		panic(err)
+	}
+
 	return &websocketsServer{
-		rpcAddr:        cfg.JSONRPC.Address,
+		rpcHandler:     rpcServer,
 		wsAddr:         cfg.JSONRPC.WsAddress,
 		certFile:       cfg.TLS.CertificatePath,
 		keyFile:        cfg.TLS.KeyPath,
-		api:            newPubSubAPI(client.Context{}, log.NewNopLogger(), &stream.RPCStream{}),
+		api:            newPubSubAPI(client.Context{}, log.NewNopLogger(), &stream.RPCStream{}, nil, big.NewInt(config.DefaultEVMChainID)),
 		logger:         log.NewNopLogger(),
 		allowedOrigins: []string{"*"},
 	}
 }
 
+// testService is a JSON-RPC namespace served by the test websocket server.
+type testService struct{}
+
+func (testService) Echo(s string) string {
+	return s
+}
+
 func TestWebsocketPayloadLimit(t *testing.T) {
 	srv := newTestWebsocketServer()
 
@@ -214,3 +240,334 @@ func TestSanitizeOriginForLogging(t *testing.T) {
 		})
 	}
 }
+
+func TestParsePendingTxsParams(t *testing.T) {
+	tests := []struct {
+		name             string
+		extra            interface{}
+		expFullTx        bool
+		expIncludeQueued bool
+		expErr           string
+	}{
+		{
+			name: "no params - hashes only",
+		},
+		{
+			name:      "fullTx flag",
+			extra:     true,
+			expFullTx: true,
+		},
+		{
+			name:             "options object",
+			extra:            map[string]interface{}{"fullTx": true, "includeQueued": true},
+			expFullTx:        true,
+			expIncludeQueued: true,
+		},
+		{
+			name:             "queued hashes",
+			extra:            map[string]interface{}{"includeQueued": true},
+			expIncludeQueued: true,
+		},
+		{
+			name:   "invalid flag type",
+			extra:  map[string]interface{}{"fullTx": "true"},
+			expErr: "invalid fullTx flag",
+		},
+		{
+			name:   "unsupported option",
+			extra:  map[string]interface{}{"fromAddress": true},
+			expErr: "unsupported option fromAddress",
+		},
+		{
+			name:   "invalid params",
+			extra:  "true",
+			expErr: "invalid pending transactions options",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fullTx, includeQueued, err := parsePendingTxsParams(tt.extra)
+			if tt.expErr != "" {
+				require.ErrorContains(t, err, tt.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expFullTx, fullTx)
+			require.Equal(t, tt.expIncludeQueued, includeQueued)
+		})
+	}
+}
+
+func TestSubscribeFullPendingTransactionsWithoutMempool(t *testing.T) {
+	srv := newTestWebsocketServer()
+
+	_, err := srv.api.subscribe(nil, "0x1", []interface{}{"newPendingTransactions", true})
+	require.ErrorContains(t, err, "the EVM mempool is disabled")
+}
+
+func TestSyncNotification(t *testing.T) {
+	peerState := func(height int64) json.RawMessage {
+		return json.RawMessage(fmt.Sprintf(`{"round_state":{"height":"%d","round":0,"step":1}}`, height))
+	}
+	syncing := func(starting, current, highest uint64) *rpctypes.SyncingResult {
+		return &rpctypes.SyncingResult{Syncing: true, Status: rpctypes.SyncStatus{
+			StartingBlock: hexutil.Uint64(starting),
+			CurrentBlock:  hexutil.Uint64(current),
+			HighestBlock:  hexutil.Uint64(highest),
+		}}
+	}
+
+	// each step is a new block, seen after the given delay; a nil sync info
+	// means the status is not checked
+	steps := []struct {
+		name     string
+		delay    time.Duration
+		syncInfo *coretypes.SyncInfo
+		peers    []coretypes.PeerStateInfo
+		expNotif interface{}
+	}{
+		{
+			name:     "synced - nothing to notify",
+			delay:    syncStatusInterval,
+			syncInfo: &coretypes.SyncInfo{EarliestBlockHeight: 1, LatestBlockHeight: 100},
+		},
+		{
+			name:  "throttled",
+			delay: time.Second,
+		},
+		{
+			name:     "starts catching up",
+			delay:    syncStatusInterval,
+			syncInfo: &coretypes.SyncInfo{EarliestBlockHeight: 1, LatestBlockHeight: 110, CatchingUp: true},
+			peers: []coretypes.PeerStateInfo{
+				{PeerState: peerState(150)},
+				{PeerState: peerState(201)},
+				{PeerState: json.RawMessage(`invalid`)},
+			},
+			expNotif: syncing(110, 110, 200),
+		},
+		{
+			name:  "throttled while catching up",
+			delay: time.Second,
+		},
+		{
+			name:     "catching up - starting block is kept",
+			delay:    syncStatusInterval,
+			syncInfo: &coretypes.SyncInfo{EarliestBlockHeight: 1, LatestBlockHeight: 180, CatchingUp: true},
+			expNotif: syncing(110, 180, 180),
+		},
+		{
+			name:     "synced again",
+			delay:    syncStatusInterval,
+			syncInfo: &coretypes.SyncInfo{EarliestBlockHeight: 1, LatestBlockHeight: 230},
+			expNotif: false,
+		},
+		{
+			name:     "still synced - nothing to notify",
+			delay:    syncStatusInterval,
+			syncInfo: &coretypes.SyncInfo{EarliestBlockHeight: 1, LatestBlockHeight: 240},
+		},
+		{
+			name:     "catching up again - new starting block",
+			delay:    syncStatusInterval,
+			syncInfo: &coretypes.SyncInfo{EarliestBlockHeight: 1, LatestBlockHeight: 250, CatchingUp: true},
+			expNotif: syncing(250, 250, 250),
+		},
+	}
+
+	cometClient := mocks.NewClient(t)
+	api := newPubSubAPI(client.Context{Client: cometClient}, log.NewNopLogger(), &stream.RPCStream{}, nil, big.NewInt(config.DefaultEVMChainID))
+	state := &syncState{}
+	now := time.Now()
+
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			if step.syncInfo != nil {
+				cometClient.On("Status", mock.Anything).Return(&coretypes.ResultStatus{SyncInfo: *step.syncInfo}, nil).Once()
+				if step.syncInfo.CatchingUp {
+					cometClient.On("DumpConsensusState", mock.Anything).Return(&coretypes.ResultDumpConsensusState{Peers: step.peers}, nil).Once()
+				}
+			}
+
+			now = now.Add(step.delay)
+			notif, err := api.syncNotification(context.Background(), state, now)
+			require.NoError(t, err)
+			require.Equal(t, step.expNotif, notif)
+			cometClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWebsocketServeRequests(t *testing.T) {
+	srv := newTestWebsocketServer()
+
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	u.Scheme = "ws"
+
+	conn, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	tests := []struct {
+		name   string
+		req    string
+		expRes string
+	}{
+		{
+			name:   "single request",
+			req:    `{"jsonrpc":"2.0","id":1,"method":"test_echo","params":["hello"]}`,
+			expRes: `{"jsonrpc":"2.0","id":1,"result":"hello"}`,
+		},
+		{
+			name:   "unknown method",
+			req:    `{"jsonrpc":"2.0","id":2,"method":"test_unknown","params":[]}`,
+			expRes: `{"jsonrpc":"2.0","id":2,"error":{"code":-32601,"message":"the method test_unknown does not exist/is not available"}}`,
+		},
+		{
+			name:   "batch request",
+			req:    `[{"jsonrpc":"2.0","id":3,"method":"test_echo","params":["a"]},{"jsonrpc":"2.0","id":4,"method":"test_echo","params":["b"]}]`,
+			expRes: `[{"jsonrpc":"2.0","id":3,"result":"a"},{"jsonrpc":"2.0","id":4,"result":"b"}]`,
+		},
+		{
+			name:   "batch request above the limit",
+			req:    `[{"jsonrpc":"2.0","id":5,"method":"test_echo","params":["a"]},{"jsonrpc":"2.0","id":6,"method":"test_echo","params":["b"]},{"jsonrpc":"2.0","id":7,"method":"test_echo","params":["c"]}]`,
+			expRes: `[{"jsonrpc":"2.0","id":5,"error":{"code":-32600,"message":"batch too large"}}]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(tt.req)))
+
+			_, res, err := conn.ReadMessage()
+			require.NoError(t, err)
+			require.JSONEq(t, tt.expRes, string(res))
+		})
+	}
+}
+
+func TestWebsocketServeNotifications(t *testing.T) {
+	srv := newTestWebsocketServer()
+
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	u.Scheme = "ws"
+
+	conn, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	notifications := []string{
+		`{"jsonrpc":"2.0","method":"test_echo","params":["a"]}`,
+		`[{"jsonrpc":"2.0","method":"test_echo","params":["a"]}]`,
+	}
+	for i, notification := range notifications {
+		t.Run(notification, func(t *testing.T) {
+			// notifications have no response, the next message is the response
+			// of the following request
+			require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(notification)))
+			req := fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"method":"test_echo","params":["b"]}`, i)
+			require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(req)))
+
+			_, res, err := conn.ReadMessage()
+			require.NoError(t, err)
+			require.JSONEq(t, fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"result":"b"}`, i), string(res))
+		})
+	}
+}
+
+func TestWebsocketForwardedClient(t *testing.T) {
+	srv := newTestWebsocketServer()
+
+	served := make(chan *http.Request, 1)
+	rpcHandler := srv.rpcHandler
+	srv.rpcHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served <- r
+		rpcHandler.ServeHTTP(w, r)
+	})
+
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	u.Scheme = "ws"
+
+	header := http.Header{}
+	header.Set("X-Forwarded-For", "10.0.0.1")
+	header.Set("X-Real-IP", "10.0.0.2")
+	conn, _, err := websocket.DefaultDialer.Dial(u.String(), header)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":1,"method":"test_echo","params":["a"]}`)))
+	_, _, err = conn.ReadMessage()
+	require.NoError(t, err)
+
+	// the requests served over the connection identify the client of the
+	// upgrade request
+	req := <-served
+	require.Equal(t, "10.0.0.1", req.Header.Get("X-Forwarded-For"))
+	require.Equal(t, "10.0.0.2", req.Header.Get("X-Real-IP"))
+	require.True(t, strings.HasPrefix(req.RemoteAddr, "127.0.0.1:"))
+}
+
+func TestRelayTxEventsDropsSlowClient(t *testing.T) {
+	var feed event.Feed
+	txsCh := make(chan core.NewTxsEvent, txChanSize)
+	sub := feed.Subscribe(txsCh)
+
+	full := make(chan struct{})
+	queue := relayTxEvents(context.Background(), sub, txsCh, func() { close(full) })
+
+	// the queue is never read, the feed must not block once it is full
+	sent := make(chan struct{})
+	go func() {
+		defer close(sent)
+		for i := 0; i < 3*txChanSize; i++ {
+			feed.Send(core.NewTxsEvent{})
+		}
+	}()
+
+	select {
+	case <-sent:
+	case <-time.After(10 * time.Second):
+		t.Fatal("txpool feed blocked by a slow client")
+	}
+
+	select {
+	case <-full:
+	case <-time.After(time.Second):
+		t.Fatal("expected the slow client to be dropped")
+	}
+
+	// the queued events are delivered, then the queue is closed
+	received := 0
+	for range queue {
+		received++
+	}
+	require.Equal(t, txChanSize, received)
+}
+
+func TestRelayTxEventsStopsOnCancel(t *testing.T) {
+	var feed event.Feed
+	txsCh := make(chan core.NewTxsEvent, txChanSize)
+	sub := feed.Subscribe(txsCh)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	queue := relayTxEvents(ctx, sub, txsCh, func() {})
+
+	feed.Send(core.NewTxsEvent{})
+	require.Equal(t, core.NewTxsEvent{}, <-queue)
+
+	cancel()
+	_, ok := <-queue
+	require.False(t, ok, "expected the queue to be closed")
+	// the subscription is removed from the feed
+	require.Eventually(t, func() bool { return feed.Send(core.NewTxsEvent{}) == 0 }, time.Second, 10*time.Millisecond)
+}
@@ -3,7 +3,10 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net/netip"
 	"path"
+	"strconv"
+	gostrings "strings"
 	"time"
 
 	"github.com/spf13/viper"
@@ -48,6 +51,12 @@ const (
 	// DefaultJSONRPCWsAddress is the default address the JSON-RPC WebSocket server binds to.
 	DefaultJSONRPCWsAddress = "127.0.0.1:8546"
 
+	// DefaultJSONRPCAuthAddress is the default address the JSON-RPC auth server binds to.
+	DefaultJSONRPCAuthAddress = "127.0.0.1:8551"
+
+	// DefaultJWTSecret is the default path of the JWT secret file, relative to the node home directory.
+	DefaultJWTSecret = "config/jwt-secret.hex"
+
 	// DefaultJsonRPCMetricsAddress is the default address the JSON-RPC Metrics server binds to.
 	DefaultJSONRPCMetricsAddress = "127.0.0.1:6065"
 
@@ -66,6 +75,12 @@ const (
 	// DefaultEVMChainID is the default EVM Chain ID if one is not provided
 	DefaultEVMChainID = 262144
 
+	// DefaultMempoolJournal is the default file name of the local transactions journal
+	DefaultMempoolJournal = "transactions.rlp"
+
+	// DefaultMempoolRejournal is the default time interval to regenerate the local transactions journal
+	DefaultMempoolRejournal = time.Hour
+
 	// DefaultGasCap is the default cap on gas that can be used in eth_call/estimateGas
 	DefaultGasCap uint64 = 25_000_000
 
@@ -118,9 +133,29 @@ const (
 
 	// DefaultEnableProfiling toggles whether profiling is enabled in the `debug` namespace
 	DefaultEnableProfiling = false
+
+	// DefaultIndexerBackend is the default backend of the custom EVM tx indexer
+	DefaultIndexerBackend = IndexerBackendKV
+
+	// DefaultRateLimitIPRate is the default number of compute units per second each IP address can spend
+	DefaultRateLimitIPRate float64 = 100
+
+	// DefaultRateLimitIPBurst is the default maximum number of compute units each IP address can spend at once
+	DefaultRateLimitIPBurst = 500
 )
 
-var evmTracers = []string{"json", "markdown", "struct", "access_list"}
+const (
+	// IndexerBackendKV indexes the eth txs in a local key-value database
+	IndexerBackendKV = "kv"
+
+	// IndexerBackendPSQL indexes the eth txs and their logs in a PostgreSQL database
+	IndexerBackendPSQL = "psql"
+)
+
+var (
+	evmTracers      = []string{"json", "markdown", "struct", "access_list"}
+	indexerBackends = []string{IndexerBackendKV, IndexerBackendPSQL}
+)
 
 // Config defines the server's top level configuration. It includes the default app config
 // from the SDK as well as the EVM configuration to enable the JSON-RPC APIs.
@@ -143,6 +178,10 @@ type EVMConfig struct {
 	EnablePreimageRecording bool `mapstructure:"cache-preimage"`
 	// EVMChainID defines the EIP-155 replay-protection chain ID.
 	EVMChainID uint64 `mapstructure:"evm-chain-id"`
+	// MempoolJournal defines the file of the local transactions journal, relative to the node data directory.
+	MempoolJournal string `mapstructure:"mempool-journal"`
+	// MempoolRejournal defines the time interval to regenerate the local transactions journal.
+	MempoolRejournal time.Duration `mapstructure:"mempool-rejournal"`
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -187,6 +226,10 @@ type JSONRPCConfig struct {
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// IndexerBackend defines the backend of the custom indexer service (kv|psql).
+	IndexerBackend string `mapstructure:"indexer-backend"`
+	// IndexerPSQLConn defines the PostgreSQL connection string of the psql indexer backend.
+	IndexerPSQLConn string `mapstructure:"indexer-psql-conn"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
@@ -195,6 +238,28 @@ type JSONRPCConfig struct {
 	WSOrigins []string `mapstructure:"ws-origins"`
 	// EnableProfiling enables the profiling in the `debug` namespace. SHOULD NOT be used on public tracing nodes
 	EnableProfiling bool `mapstructure:"enable-profiling"`
+	// EnableRateLimit enables the rate limiting of the JSON-RPC requests.
+	EnableRateLimit bool `mapstructure:"enable-rate-limit"`
+	// RateLimitIPRate is the number of compute units per second each IP address can spend.
+	RateLimitIPRate float64 `mapstructure:"rate-limit-ip-rate"`
+	// RateLimitIPBurst is the maximum number of compute units each IP address can spend at once.
+	RateLimitIPBurst int `mapstructure:"rate-limit-ip-burst"`
+	// RateLimitMethods defines "method:rate" limits in requests per second, shared by all the callers.
+	RateLimitMethods []string `mapstructure:"rate-limit-methods"`
+	// RateLimitComputeUnits defines "method:units" weights of the methods, other methods cost 1 unit.
+	RateLimitComputeUnits []string `mapstructure:"rate-limit-compute-units"`
+	// RateLimitAllowList defines the IP addresses and CIDR ranges that are not rate limited.
+	RateLimitAllowList []string `mapstructure:"rate-limit-allow-list"`
+	// RateLimitTrustedProxies defines the IP addresses and CIDR ranges of the proxies whose
+	// X-Forwarded-For and X-Real-IP headers are trusted to identify the callers.
+	RateLimitTrustedProxies []string `mapstructure:"rate-limit-trusted-proxies"`
+	// AuthAddress defines the JSON-RPC auth server to listen on
+	AuthAddress string `mapstructure:"auth-address"`
+	// AuthAPI defines the namespaces that require JWT authentication. They are
+	// only served by the auth server, which serves the public namespaces as well.
+	AuthAPI []string `mapstructure:"auth-api"`
+	// JWTSecret defines the path of the hex encoded HS256 secret of the JWT tokens.
+	JWTSecret string `mapstructure:"jwt-secret"`
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -212,6 +277,8 @@ func DefaultEVMConfig() *EVMConfig {
 		MaxTxGasWanted:          DefaultMaxTxGasWanted,
 		EVMChainID:              DefaultEVMChainID,
 		EnablePreimageRecording: DefaultEnablePreimageRecording,
+		MempoolJournal:          DefaultMempoolJournal,
+		MempoolRejournal:        DefaultMempoolRejournal,
 	}
 }
 
@@ -221,6 +288,10 @@ func (c EVMConfig) Validate() error {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
+	if c.MempoolRejournal < 0 {
+		return errors.New("mempool rejournal duration cannot be negative")
+	}
+
 	return nil
 }
 
@@ -234,6 +305,12 @@ func GetAPINamespaces() []string {
 	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
 }
 
+// GetDefaultRateLimitComputeUnits returns the default compute units of the
+// JSON-RPC methods that are more expensive to serve.
+func GetDefaultRateLimitComputeUnits() []string {
+	return []string{"eth_call:5", "eth_estimateGas:5", "eth_getLogs:20", "debug_*:50", "trace_*:50"}
+}
+
 // GetDefaultWSOrigins returns the default WebSocket origins.
 func GetDefaultWSOrigins() []string {
 	return []string{DefaultWSOrigins, "localhost"}
@@ -261,10 +338,21 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		BatchResponseMaxSize:     DefaultBatchResponseMaxSize,
 		MaxOpenConnections:       DefaultMaxOpenConnections,
 		EnableIndexer:            false,
+		IndexerBackend:           DefaultIndexerBackend,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
 		WSOrigins:                GetDefaultWSOrigins(),
 		EnableProfiling:          DefaultEnableProfiling,
+		EnableRateLimit:          false,
+		RateLimitIPRate:          DefaultRateLimitIPRate,
+		RateLimitIPBurst:         DefaultRateLimitIPBurst,
+		RateLimitMethods:         []string{},
+		RateLimitComputeUnits:    GetDefaultRateLimitComputeUnits(),
+		RateLimitAllowList:       []string{},
+		RateLimitTrustedProxies:  []string{},
+		AuthAddress:              DefaultJSONRPCAuthAddress,
+		AuthAPI:                  []string{},
+		JWTSecret:                DefaultJWTSecret,
 	}
 }
 
@@ -314,6 +402,57 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC batch response max size cannot be negative")
 	}
 
+	if c.IndexerBackend != "" && !strings.StringInSlice(c.IndexerBackend, indexerBackends) {
+		return fmt.Errorf("invalid indexer backend %s, available backends: %v", c.IndexerBackend, indexerBackends)
+	}
+
+	if c.EnableIndexer && c.IndexerBackend == IndexerBackendPSQL && c.IndexerPSQLConn == "" {
+		return errors.New("JSON-RPC indexer-psql-conn cannot be empty with the psql indexer backend")
+	}
+
+	if c.EnableRateLimit && (c.RateLimitIPRate <= 0 || c.RateLimitIPBurst <= 0) {
+		return errors.New("JSON-RPC rate limit ip rate and burst must be positive")
+	}
+
+	if _, err := ParseMethodValues(c.RateLimitMethods); err != nil {
+		return fmt.Errorf("invalid JSON-RPC rate limit methods: %w", err)
+	}
+
+	computeUnits, err := ParseMethodValues(c.RateLimitComputeUnits)
+	if err != nil {
+		return fmt.Errorf("invalid JSON-RPC rate limit compute units: %w", err)
+	}
+
+	for method, units := range computeUnits {
+		if c.EnableRateLimit && units > float64(c.RateLimitIPBurst) {
+			return fmt.Errorf("JSON-RPC compute units of %s cannot exceed the rate limit ip burst", method)
+		}
+	}
+
+	if _, err := ParseAllowList(c.RateLimitAllowList); err != nil {
+		return fmt.Errorf("invalid JSON-RPC rate limit allow list: %w", err)
+	}
+
+	if _, err := ParseAllowList(c.RateLimitTrustedProxies); err != nil {
+		return fmt.Errorf("invalid JSON-RPC rate limit trusted proxies: %w", err)
+	}
+
+	if len(c.AuthAPI) > 0 && c.AuthAddress == "" {
+		return errors.New("JSON-RPC auth-address cannot be empty with authenticated API namespaces")
+	}
+
+	if len(c.AuthAPI) > 0 && c.JWTSecret == "" {
+		return errors.New("JSON-RPC jwt-secret cannot be empty with authenticated API namespaces")
+	}
+
+	seenAuthAPIs := make(map[string]bool)
+	for _, api := range c.AuthAPI {
+		if seenAuthAPIs[api] {
+			return fmt.Errorf("repeated auth API namespace '%s'", api)
+		}
+		seenAuthAPIs[api] = true
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -327,6 +466,48 @@ func (c JSONRPCConfig) Validate() error {
 	return nil
 }
 
+// ParseMethodValues parses a list of "method:value" entries, where the method
+// is either a full method name (e.g. "eth_getLogs") or all the methods of a
+// namespace (e.g. "debug_*"). Values must be positive.
+func ParseMethodValues(entries []string) (map[string]float64, error) {
+	values := make(map[string]float64, len(entries))
+	for _, entry := range entries {
+		method, valueStr, ok := gostrings.Cut(entry, ":")
+		if !ok || method == "" {
+			return nil, fmt.Errorf("invalid entry '%s', expected method:value", entry)
+		}
+
+		value, err := strconv.ParseFloat(valueStr, 64)
+		if err != nil || value <= 0 {
+			return nil, fmt.Errorf("invalid value of entry '%s', expected a positive number", entry)
+		}
+
+		if _, ok := values[method]; ok {
+			return nil, fmt.Errorf("repeated method '%s'", method)
+		}
+		values[method] = value
+	}
+	return values, nil
+}
+
+// ParseAllowList parses a list of IP addresses and CIDR ranges.
+func ParseAllowList(entries []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(entries))
+	for _, entry := range entries {
+		if addr, err := netip.ParseAddr(entry); err == nil {
+			prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid IP address or CIDR range '%s'", entry)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	return prefixes, nil
+}
+
 // DefaultTLSConfig returns the default TLS configuration
 func DefaultTLSConfig() *TLSConfig {
 	return &TLSConfig{
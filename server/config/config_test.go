@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
@@ -55,6 +56,38 @@ func TestGetConfig(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"test unmarshal EVM mempool journal",
+			func() *viper.Viper {
+				v := viper.New()
+				v.Set("evm.mempool-journal", "")
+				v.Set("evm.mempool-rejournal", "10m")
+				return v
+			},
+			func() serverconfig.Config {
+				cfg := serverconfig.DefaultConfig()
+				cfg.EVM.MempoolJournal = ""
+				cfg.EVM.MempoolRejournal = 10 * time.Minute
+				return *cfg
+			},
+			false,
+		},
+		{
+			"test unmarshal psql indexer backend",
+			func() *viper.Viper {
+				v := viper.New()
+				v.Set("json-rpc.indexer-backend", "psql")
+				v.Set("json-rpc.indexer-psql-conn", "postgresql://localhost:5432/evmindexer")
+				return v
+			},
+			func() serverconfig.Config {
+				cfg := serverconfig.DefaultConfig()
+				cfg.JSONRPC.IndexerBackend = serverconfig.IndexerBackendPSQL
+				cfg.JSONRPC.IndexerPSQLConn = "postgresql://localhost:5432/evmindexer"
+				return *cfg
+			},
+			false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -69,3 +102,198 @@ func TestGetConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONRPCConfigValidateIndexer(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(cfg *serverconfig.JSONRPCConfig)
+		expErr   string
+	}{
+		{
+			"default kv backend",
+			func(*serverconfig.JSONRPCConfig) {},
+			"",
+		},
+		{
+			"invalid backend",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.IndexerBackend = "sqlite"
+			},
+			"invalid indexer backend sqlite",
+		},
+		{
+			"enabled psql backend without connection string",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.EnableIndexer = true
+				cfg.IndexerBackend = serverconfig.IndexerBackendPSQL
+			},
+			"indexer-psql-conn cannot be empty",
+		},
+		{
+			"enabled psql backend with connection string",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.EnableIndexer = true
+				cfg.IndexerBackend = serverconfig.IndexerBackendPSQL
+				cfg.IndexerPSQLConn = "postgresql://localhost:5432/evmindexer"
+			},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := serverconfig.DefaultJSONRPCConfig()
+			tc.malleate(cfg)
+
+			err := cfg.Validate()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestJSONRPCConfigValidateRateLimit(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(cfg *serverconfig.JSONRPCConfig)
+		expErr   string
+	}{
+		{
+			"default rate limit",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.EnableRateLimit = true
+			},
+			"",
+		},
+		{
+			"valid methods and allow list",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.EnableRateLimit = true
+				cfg.RateLimitMethods = []string{"eth_getLogs:50", "debug_*:0.5"}
+				cfg.RateLimitAllowList = []string{"127.0.0.1", "::1", "10.0.0.0/8"}
+			},
+			"",
+		},
+		{
+			"zero ip rate",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.EnableRateLimit = true
+				cfg.RateLimitIPRate = 0
+			},
+			"ip rate and burst must be positive",
+		},
+		{
+			"method without value",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.RateLimitMethods = []string{"eth_getLogs"}
+			},
+			"invalid entry 'eth_getLogs'",
+		},
+		{
+			"negative compute units",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.RateLimitComputeUnits = []string{"eth_call:-1"}
+			},
+			"invalid value of entry 'eth_call:-1'",
+		},
+		{
+			"repeated method",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.RateLimitMethods = []string{"eth_call:1", "eth_call:2"}
+			},
+			"repeated method 'eth_call'",
+		},
+		{
+			"compute units above the burst",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.EnableRateLimit = true
+				cfg.RateLimitIPBurst = 10
+			},
+			"cannot exceed the rate limit ip burst",
+		},
+		{
+			"invalid allow list",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.RateLimitAllowList = []string{"localhost"}
+			},
+			"invalid IP address or CIDR range 'localhost'",
+		},
+		{
+			"invalid trusted proxies",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.RateLimitTrustedProxies = []string{"10.0.0.0/33"}
+			},
+			"invalid JSON-RPC rate limit trusted proxies",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := serverconfig.DefaultJSONRPCConfig()
+			tc.malleate(cfg)
+
+			err := cfg.Validate()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestJSONRPCConfigValidateAuth(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(cfg *serverconfig.JSONRPCConfig)
+		expErr   string
+	}{
+		{
+			"authenticated namespaces",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.AuthAPI = []string{"debug", "personal"}
+			},
+			"",
+		},
+		{
+			"empty auth address",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.AuthAPI = []string{"debug"}
+				cfg.AuthAddress = ""
+			},
+			"auth-address cannot be empty",
+		},
+		{
+			"empty jwt secret",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.AuthAPI = []string{"debug"}
+				cfg.JWTSecret = ""
+			},
+			"jwt-secret cannot be empty",
+		},
+		{
+			"repeated namespace",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.AuthAPI = []string{"debug", "debug"}
+			},
+			"repeated auth API namespace 'debug'",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := serverconfig.DefaultJSONRPCConfig()
+			tc.malleate(cfg)
+
+			err := cfg.Validate()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
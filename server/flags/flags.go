@@ -37,26 +37,38 @@ const (
 
 // JSON-RPC flags
 const (
-	JSONRPCEnable               = "json-rpc.enable"
-	JSONRPCAPI                  = "json-rpc.api"
-	JSONRPCAddress              = "json-rpc.address"
-	JSONWsAddress               = "json-rpc.ws-address"
-	JSONRPCWSOrigins            = "json-rpc.ws-origins"
-	JSONRPCGasCap               = "json-rpc.gas-cap"
-	JSONRPCAllowInsecureUnlock  = "json-rpc.allow-insecure-unlock"
-	JSONRPCEVMTimeout           = "json-rpc.evm-timeout"
-	JSONRPCTxFeeCap             = "json-rpc.txfee-cap"
-	JSONRPCFilterCap            = "json-rpc.filter-cap"
-	JSONRPCLogsCap              = "json-rpc.logs-cap"
-	JSONRPCBlockRangeCap        = "json-rpc.block-range-cap"
-	JSONRPCHTTPTimeout          = "json-rpc.http-timeout"
-	JSONRPCHTTPIdleTimeout      = "json-rpc.http-idle-timeout"
-	JSONRPCAllowUnprotectedTxs  = "json-rpc.allow-unprotected-txs"
-	JSONRPCMaxOpenConnections   = "json-rpc.max-open-connections"
-	JSONRPCEnableIndexer        = "json-rpc.enable-indexer"
-	JSONRPCBatchRequestLimit    = "json-rpc.batch-request-limit"
-	JSONRPCBatchResponseMaxSize = "json-rpc.batch-response-max-size"
-	JSONRPCEnableProfiling      = "json-rpc.enable-profiling"
+	JSONRPCEnable                = "json-rpc.enable"
+	JSONRPCAPI                   = "json-rpc.api"
+	JSONRPCAddress               = "json-rpc.address"
+	JSONWsAddress                = "json-rpc.ws-address"
+	JSONRPCWSOrigins             = "json-rpc.ws-origins"
+	JSONRPCGasCap                = "json-rpc.gas-cap"
+	JSONRPCAllowInsecureUnlock   = "json-rpc.allow-insecure-unlock"
+	JSONRPCEVMTimeout            = "json-rpc.evm-timeout"
+	JSONRPCTxFeeCap              = "json-rpc.txfee-cap"
+	JSONRPCFilterCap             = "json-rpc.filter-cap"
+	JSONRPCLogsCap               = "json-rpc.logs-cap"
+	JSONRPCBlockRangeCap         = "json-rpc.block-range-cap"
+	JSONRPCHTTPTimeout           = "json-rpc.http-timeout"
+	JSONRPCHTTPIdleTimeout       = "json-rpc.http-idle-timeout"
+	JSONRPCAllowUnprotectedTxs   = "json-rpc.allow-unprotected-txs"
+	JSONRPCMaxOpenConnections    = "json-rpc.max-open-connections"
+	JSONRPCEnableIndexer         = "json-rpc.enable-indexer"
+	JSONRPCIndexerBackend        = "json-rpc.indexer-backend"
+	JSONRPCIndexerPSQLConn       = "json-rpc.indexer-psql-conn"
+	JSONRPCBatchRequestLimit     = "json-rpc.batch-request-limit"
+	JSONRPCBatchResponseMaxSize  = "json-rpc.batch-response-max-size"
+	JSONRPCEnableProfiling       = "json-rpc.enable-profiling"
+	JSONRPCEnableRateLimit       = "json-rpc.enable-rate-limit"
+	JSONRPCRateLimitIPRate       = "json-rpc.rate-limit-ip-rate"
+	JSONRPCRateLimitIPBurst      = "json-rpc.rate-limit-ip-burst"
+	JSONRPCRateLimitMethods      = "json-rpc.rate-limit-methods"
+	JSONRPCRateLimitComputeUnits = "json-rpc.rate-limit-compute-units"
+	JSONRPCRateLimitAllowList    = "json-rpc.rate-limit-allow-list"
+	JSONRPCRateLimitProxies      = "json-rpc.rate-limit-trusted-proxies"
+	JSONRPCAuthAddress           = "json-rpc.auth-address"
+	JSONRPCAuthAPI               = "json-rpc.auth-api"
+	JSONRPCJWTSecret             = "json-rpc.jwt-secret"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
@@ -70,6 +82,8 @@ const (
 	EVMMaxTxGasWanted          = "evm.max-tx-gas-wanted"
 	EVMEnablePreimageRecording = "evm.cache-preimage"
 	EVMChainID                 = "evm.evm-chain-id"
+	EVMMempoolJournal          = "evm.mempool-journal"
+	EVMMempoolRejournal        = "evm.mempool-rejournal"
 )
 
 // TLS flags
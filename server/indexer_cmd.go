@@ -3,13 +3,15 @@ package server
 import (
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/cobra"
 
 	cmtconfig "github.com/cometbft/cometbft/config"
 	sm "github.com/cometbft/cometbft/state"
 	cmtstore "github.com/cometbft/cometbft/store"
 
-	"github.com/cosmos/evm/indexer"
+	cosmosevmserverconfig "github.com/cosmos/evm/server/config"
+	cosmosevmtypes "github.com/cosmos/evm/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -24,6 +26,9 @@ func NewIndexTxCmd() *cobra.Command {
 		- backward: index the blocks from the first indexed block to the earliest block in the chain, if indexer db is empty, start from the latest block.
 		- forward: index the blocks from the latest indexed block to latest block in the chain.
 
+		The txs are indexed in the backend configured by 'json-rpc.indexer-backend'. With the psql backend, the logs are
+		indexed as well, so that 'eth_getLogs' queries over the indexed blocks are answered from the log indices.
+
 		When start the node, the indexer start from the latest indexed block to avoid creating gap.
         Backward mode should be used most of the time, so the latest indexed block is always up-to-date.
 		`,
@@ -40,15 +45,19 @@ func NewIndexTxCmd() *cobra.Command {
 				return fmt.Errorf("unknown index direction, expect: backward|forward, got: %s", direction)
 			}
 
+			evmCfg, err := cosmosevmserverconfig.GetConfig(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+
 			cfg := serverCtx.Config
 			home := cfg.RootDir
 			logger := serverCtx.Logger
-			idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(serverCtx.Viper))
+			idxer, err := OpenEVMTxIndexer(evmCfg.JSONRPC, home, server.GetAppDBBackend(serverCtx.Viper), logger.With("module", "evmindex"), clientCtx)
 			if err != nil {
 				logger.Error("failed to open evm indexer DB", "error", err.Error())
 				return err
 			}
-			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
 
 			// open local CometBFT db, because the local rpc won't be available.
 			tmdb, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "blockstore", Config: cfg})
@@ -119,3 +128,67 @@ func NewIndexTxCmd() *cobra.Command {
 	}
 	return cmd
 }
+
+// NewIndexHeaderHashCmd creates a new Cobra command to archive the historical
+// block header hashes in the indexer db.
+func NewIndexHeaderHashCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "index-header-hash",
+		Short: "Archive historical block header hashes",
+		Long: `Archive the header hashes of the blocks in the local block store into the indexer db, configured by
+'json-rpc.indexer-backend'.
+
+The EIP-2935 history storage only keeps the hashes of the latest 'history_serve_window' blocks, while the archived
+hashes are served over the whole chain history, to the EVM queries and to the block lookups by hash, including
+after the blocks are pruned from the block store. The indexer archives the hashes of the new blocks while the node
+runs, so this command only has to be run once to backfill the blocks indexed before.
+		`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			evmCfg, err := cosmosevmserverconfig.GetConfig(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+
+			cfg := serverCtx.Config
+			logger := serverCtx.Logger
+			idxer, err := OpenEVMTxIndexer(evmCfg.JSONRPC, cfg.RootDir, server.GetAppDBBackend(serverCtx.Viper), logger.With("module", "evmindex"), clientCtx)
+			if err != nil {
+				logger.Error("failed to open evm indexer DB", "error", err.Error())
+				return err
+			}
+
+			hashIndexer, ok := idxer.(cosmosevmtypes.HeaderHashIndexer)
+			if !ok {
+				return fmt.Errorf("indexer backend %s doesn't archive header hashes", evmCfg.JSONRPC.IndexerBackend)
+			}
+
+			// open local CometBFT db, because the local rpc won't be available.
+			tmdb, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "blockstore", Config: cfg})
+			if err != nil {
+				return err
+			}
+			blockStore := cmtstore.NewBlockStore(tmdb)
+
+			for height := blockStore.Base(); height > 0 && height <= blockStore.Height(); height++ {
+				meta := blockStore.LoadBlockMeta(height)
+				if meta == nil {
+					return fmt.Errorf("block meta not found %d", height)
+				}
+				if err := hashIndexer.IndexHeaderHash(height, common.BytesToHash(meta.BlockID.Hash)); err != nil {
+					return err
+				}
+			}
+
+			cmd.Printf("archived header hashes of blocks %d to %d\n", blockStore.Base(), blockStore.Height())
+			return nil
+		},
+	}
+	return cmd
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -60,23 +62,70 @@ func StartJSONRPC(
 
 	rpcServer.SetBatchLimits(config.JSONRPC.BatchRequestLimit, config.JSONRPC.BatchResponseMaxSize)
 	allowUnprotectedTxs := config.JSONRPC.AllowUnprotectedTxs
-	rpcAPIArr := config.JSONRPC.API
+	rpcAPIArr := slices.Clone(config.JSONRPC.API)
+
+	// the authenticated namespaces are only registered on the auth server,
+	// which serves the public namespaces as well
+	var (
+		authServer *ethrpc.Server
+		jwtSecret  []byte
+	)
+	authAPIs := make(map[string]bool, len(config.JSONRPC.AuthAPI))
+	if len(config.JSONRPC.AuthAPI) > 0 {
+		var err error
+		jwtSecret, err = LoadJWTSecret(ResolveJWTSecretPath(srvCtx.Config.RootDir, config.JSONRPC.JWTSecret))
+		if err != nil {
+			return nil, fmt.Errorf("%w, it can be generated with the generate-jwt-secret command", err)
+		}
+
+		authServer = ethrpc.NewServer()
+		authServer.SetBatchLimits(config.JSONRPC.BatchRequestLimit, config.JSONRPC.BatchResponseMaxSize)
+
+		for _, ns := range config.JSONRPC.AuthAPI {
+			authAPIs[ns] = true
+			if !slices.Contains(rpcAPIArr, ns) {
+				rpcAPIArr = append(rpcAPIArr, ns)
+			}
+		}
+	}
 
 	apis := rpc.GetRPCAPIs(srvCtx, clientCtx, stream, allowUnprotectedTxs, indexer, rpcAPIArr, mempool)
 
 	for _, api := range apis {
-		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
-			logger.Error(
-				"failed to register service in JSON RPC namespace",
-				"namespace", api.Namespace,
-				"service", api.Service,
-			)
+		if !authAPIs[api.Namespace] {
+			if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
+				logger.Error(
+					"failed to register service in JSON RPC namespace",
+					"namespace", api.Namespace,
+					"service", api.Service,
+				)
+				return nil, err
+			}
+		}
+
+		if authServer != nil {
+			if err := authServer.RegisterName(api.Namespace, api.Service); err != nil {
+				logger.Error(
+					"failed to register service in authenticated JSON RPC namespace",
+					"namespace", api.Namespace,
+					"service", api.Service,
+				)
+				return nil, err
+			}
+		}
+	}
+
+	var rpcHandler http.Handler = rpcServer
+	if config.JSONRPC.EnableRateLimit {
+		rateLimiter, err := newRateLimiter(rpcServer, config.JSONRPC, logger.With("module", "rate-limit"))
+		if err != nil {
 			return nil, err
 		}
+		rpcHandler = rateLimiter
 	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", rpcHandler).Methods("POST")
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -91,15 +140,56 @@ func StartJSONRPC(
 		WriteTimeout:      config.JSONRPC.HTTPTimeout,
 		IdleTimeout:       config.JSONRPC.HTTPIdleTimeout,
 	}
-	httpSrvDone := make(chan struct{}, 1)
 
 	ln, err := Listen(httpSrv.Addr, config)
 	if err != nil {
 		return nil, err
 	}
 
+	serveJSONRPC(ctx, srvCtx, g, httpSrv, ln, "JSON-RPC server")
+
+	if authServer != nil {
+		authRouter := mux.NewRouter()
+		authRouter.Handle("/", newJWTHandler(jwtSecret, authServer)).Methods("POST")
+
+		authSrv := &http.Server{
+			Addr:              config.JSONRPC.AuthAddress,
+			Handler:           authRouter,
+			ReadHeaderTimeout: config.JSONRPC.HTTPTimeout,
+			ReadTimeout:       config.JSONRPC.HTTPTimeout,
+			WriteTimeout:      config.JSONRPC.HTTPTimeout,
+			IdleTimeout:       config.JSONRPC.HTTPIdleTimeout,
+		}
+
+		authLn, err := Listen(authSrv.Addr, config)
+		if err != nil {
+			return nil, err
+		}
+
+		serveJSONRPC(ctx, srvCtx, g, authSrv, authLn, "JSON-RPC auth server")
+	}
+
+	srvCtx.Logger.Info("Starting JSON WebSocket server", "address", config.JSONRPC.WsAddress)
+
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, logger, stream, config, rpcHandler, mempool)
+	wsSrv.Start()
+	return httpSrv, nil
+}
+
+// serveJSONRPC serves the HTTP requests of the given JSON-RPC server until the
+// context is canceled, then gracefully shuts the server down.
+func serveJSONRPC(
+	ctx context.Context,
+	srvCtx *server.Context,
+	g *errgroup.Group,
+	httpSrv *http.Server,
+	ln net.Listener,
+	name string,
+) {
+	logger := srvCtx.Logger.With("module", "geth")
+
 	g.Go(func() error {
-		srvCtx.Logger.Info("Starting JSON-RPC server", "address", config.JSONRPC.Address)
+		srvCtx.Logger.Info("Starting "+name, "address", httpSrv.Addr)
 		errCh := make(chan error)
 		go func() {
 			errCh <- httpSrv.Serve(ln)
@@ -111,28 +201,21 @@ func StartJSONRPC(
 		case <-ctx.Done():
 			// The calling process canceled or closed the provided context, so we must
 			// gracefully stop the JSON-RPC server.
-			logger.Info("stopping JSON-RPC server...", "address", config.JSONRPC.Address)
+			logger.Info("stopping "+name+"...", "address", httpSrv.Addr)
 			ctxShutdown, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
 			defer cancel()
 			if err := httpSrv.Shutdown(ctxShutdown); err != nil {
-				logger.Error("failed to shutdown JSON-RPC server", "error", err.Error())
+				logger.Error("failed to shutdown "+name, "error", err.Error())
 			}
 			return nil
 
 		case err := <-errCh:
 			if err == http.ErrServerClosed {
-				close(httpSrvDone)
 				return nil
 			}
 
-			srvCtx.Logger.Error("failed to start JSON-RPC server", "error", err.Error())
+			srvCtx.Logger.Error("failed to start "+name, "error", err.Error())
 			return err
 		}
 	})
-
-	srvCtx.Logger.Info("Starting JSON WebSocket server", "address", config.JSONRPC.WsAddress)
-
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, logger, stream, config)
-	wsSrv.Start()
-	return httpSrv, nil
 }
@@ -0,0 +1,175 @@
+package server
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/spf13/cobra"
+
+	srvflags "github.com/cosmos/evm/server/flags"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+const (
+	// jwtSecretLength is the length in bytes of the HS256 secret, as in the Engine API.
+	jwtSecretLength = 32
+	// jwtExpiryTimeout is the maximum drift allowed between the issued-at claim
+	// of a token and the local time.
+	jwtExpiryTimeout = 60 * time.Second
+
+	flagForce = "force"
+)
+
+// jwtHandler authenticates the requests with a bearer JWT token signed with
+// the HS256 secret, following the Engine API authentication spec.
+type jwtHandler struct {
+	secret []byte
+	next   http.Handler
+}
+
+// newJWTHandler wraps the given handler with the JWT authentication.
+func newJWTHandler(secret []byte, next http.Handler) http.Handler {
+	return &jwtHandler{
+		secret: secret,
+		next:   next,
+	}
+}
+
+// ServeHTTP implements http.Handler
+func (h *jwtHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var (
+		strToken string
+		claims   jwt.RegisteredClaims
+	)
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+		strToken = strings.TrimPrefix(auth, "Bearer ")
+	}
+	if strToken == "" {
+		http.Error(w, "missing token", http.StatusUnauthorized)
+		return
+	}
+
+	// Only HS256 is allowed. The claims are checked below instead of by the
+	// parser, which requires the issued-at claim to be no later than now,
+	// while some clock drift is allowed.
+	token, err := jwt.ParseWithClaims(strToken, &claims, func(*jwt.Token) (interface{}, error) {
+		return h.secret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithoutClaimsValidation())
+
+	switch {
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+	case !token.Valid:
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+	case !claims.VerifyExpiresAt(time.Now(), false):
+		http.Error(w, "token is expired", http.StatusUnauthorized)
+	case claims.IssuedAt == nil:
+		http.Error(w, "missing issued-at", http.StatusUnauthorized)
+	case time.Since(claims.IssuedAt.Time) > jwtExpiryTimeout:
+		http.Error(w, "stale token", http.StatusUnauthorized)
+	case time.Until(claims.IssuedAt.Time) > jwtExpiryTimeout:
+		http.Error(w, "future token", http.StatusUnauthorized)
+	default:
+		h.next.ServeHTTP(w, r)
+	}
+}
+
+// ResolveJWTSecretPath returns the path of the JWT secret file. Relative paths
+// are resolved from the node home directory.
+func ResolveJWTSecretPath(home, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(home, path)
+}
+
+// LoadJWTSecret reads the hex encoded 32 bytes JWT secret from the given file.
+func LoadJWTSecret(path string) ([]byte, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWT secret: %w", err)
+	}
+
+	secret := common.FromHex(strings.TrimSpace(string(bz)))
+	if len(secret) != jwtSecretLength {
+		return nil, fmt.Errorf("invalid JWT secret in %s, expected %d hex encoded bytes, got %d", path, jwtSecretLength, len(secret))
+	}
+
+	return secret, nil
+}
+
+// GenerateJWTSecret writes a new random hex encoded JWT secret to the given
+// file, which must not exist unless overwrite is set.
+func GenerateJWTSecret(path string, overwrite bool) ([]byte, error) {
+	if _, err := os.Stat(path); err == nil && !overwrite {
+		return nil, fmt.Errorf("JWT secret file %s already exists", path)
+	} else if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	secret := make([]byte, jwtSecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(path, []byte(hexutil.Encode(secret)), 0o600); err != nil {
+		return nil, err
+	}
+
+	return secret, nil
+}
+
+// NewGenerateJWTSecretCmd creates a new Cobra command to generate the secret
+// used to authenticate the requests to the JSON-RPC auth server.
+func NewGenerateJWTSecretCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate-jwt-secret [file]",
+		Short: "Generate the JWT secret of the JSON-RPC auth server",
+		Long: `Generate a random hex encoded 32 bytes secret, used to authenticate the requests to the JSON-RPC auth server
+with HS256 JWT tokens, as in the Engine API. The secret is written to the given file, or to 'json-rpc.jwt-secret'
+relative to the node home directory if no file is given.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			var path string
+			if len(args) > 0 {
+				path = args[0]
+			} else {
+				jwtSecret := serverCtx.Viper.GetString(srvflags.JSONRPCJWTSecret)
+				if jwtSecret == "" {
+					return fmt.Errorf("no file given and %s is not set", srvflags.JSONRPCJWTSecret)
+				}
+				path = ResolveJWTSecretPath(serverCtx.Config.RootDir, jwtSecret)
+			}
+
+			overwrite, err := cmd.Flags().GetBool(flagForce)
+			if err != nil {
+				return err
+			}
+
+			if _, err := GenerateJWTSecret(path, overwrite); err != nil {
+				return err
+			}
+
+			cmd.Printf("JWT secret written to %s\n", path)
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagForce, false, "Overwrite the existing secret file")
+	return cmd
+}
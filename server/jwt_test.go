@@ -0,0 +1,135 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJWTHandler(t *testing.T) {
+	secret := make([]byte, jwtSecretLength)
+	secret[0] = 1
+
+	signedToken := func(t *testing.T, method jwt.SigningMethod, key interface{}, claims jwt.MapClaims) string {
+		t.Helper()
+		token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+		require.NoError(t, err)
+		return token
+	}
+
+	testCases := []struct {
+		name       string
+		authHeader func(t *testing.T) string
+		expCode    int
+		expBody    string
+	}{
+		{
+			"missing token",
+			func(*testing.T) string { return "" },
+			http.StatusUnauthorized,
+			"missing token",
+		},
+		{
+			"valid token",
+			func(t *testing.T) string {
+				return "Bearer " + signedToken(t, jwt.SigningMethodHS256, secret, jwt.MapClaims{"iat": time.Now().Unix()})
+			},
+			http.StatusOK,
+			"",
+		},
+		{
+			"token signed with another secret",
+			func(t *testing.T) string {
+				return "Bearer " + signedToken(t, jwt.SigningMethodHS256, []byte("other"), jwt.MapClaims{"iat": time.Now().Unix()})
+			},
+			http.StatusUnauthorized,
+			"signature is invalid",
+		},
+		{
+			"token signed with another method",
+			func(t *testing.T) string {
+				return "Bearer " + signedToken(t, jwt.SigningMethodHS512, secret, jwt.MapClaims{"iat": time.Now().Unix()})
+			},
+			http.StatusUnauthorized,
+			"signing method HS512 is invalid",
+		},
+		{
+			"missing issued-at",
+			func(t *testing.T) string {
+				return "Bearer " + signedToken(t, jwt.SigningMethodHS256, secret, jwt.MapClaims{})
+			},
+			http.StatusUnauthorized,
+			"missing issued-at",
+		},
+		{
+			"stale token",
+			func(t *testing.T) string {
+				return "Bearer " + signedToken(t, jwt.SigningMethodHS256, secret, jwt.MapClaims{"iat": time.Now().Add(-2 * jwtExpiryTimeout).Unix()})
+			},
+			http.StatusUnauthorized,
+			"stale token",
+		},
+		{
+			"future token",
+			func(t *testing.T) string {
+				return "Bearer " + signedToken(t, jwt.SigningMethodHS256, secret, jwt.MapClaims{"iat": time.Now().Add(2 * jwtExpiryTimeout).Unix()})
+			},
+			http.StatusUnauthorized,
+			"future token",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+			handler := newJWTHandler(secret, next)
+
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			if header := tc.authHeader(t); header != "" {
+				req.Header.Set("Authorization", header)
+			}
+			res := httptest.NewRecorder()
+			handler.ServeHTTP(res, req)
+
+			require.Equal(t, tc.expCode, res.Code)
+			require.Contains(t, res.Body.String(), tc.expBody)
+		})
+	}
+}
+
+func TestGenerateAndLoadJWTSecret(t *testing.T) {
+	home := t.TempDir()
+	path := ResolveJWTSecretPath(home, "config/jwt-secret.hex")
+	require.Equal(t, filepath.Join(home, "config", "jwt-secret.hex"), path)
+	require.Equal(t, "/tmp/jwt.hex", ResolveJWTSecretPath(home, "/tmp/jwt.hex"))
+
+	_, err := LoadJWTSecret(path)
+	require.ErrorContains(t, err, "failed to read JWT secret")
+
+	secret, err := GenerateJWTSecret(path, false)
+	require.NoError(t, err)
+	require.Len(t, secret, jwtSecretLength)
+
+	loaded, err := LoadJWTSecret(path)
+	require.NoError(t, err)
+	require.Equal(t, secret, loaded)
+
+	// existing secrets are only replaced on demand
+	_, err = GenerateJWTSecret(path, false)
+	require.ErrorContains(t, err, "already exists")
+	newSecret, err := GenerateJWTSecret(path, true)
+	require.NoError(t, err)
+	require.NotEqual(t, secret, newSecret)
+
+	require.NoError(t, os.WriteFile(path, []byte("0x1234"), 0o600))
+	_, err = LoadJWTSecret(path)
+	require.ErrorContains(t, err, "expected 32 hex encoded bytes, got 2")
+}
@@ -0,0 +1,348 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/metrics"
+	"golang.org/x/time/rate"
+
+	serverconfig "github.com/cosmos/evm/server/config"
+
+	"cosmossdk.io/log"
+)
+
+const (
+	// rateLimitErrorCode is the JSON-RPC error code of the rejected requests,
+	// as used by the Ethereum clients for exceeded limits.
+	rateLimitErrorCode = -32005
+
+	// batchTooLargeErrorCode is the JSON-RPC error code of the batches costing
+	// more compute units than the ip burst, which can never be served.
+	batchTooLargeErrorCode = -32600
+
+	// maxRateLimitedBodySize is the maximum size of the request bodies inspected
+	// by the rate limiter, which matches the body limit of the JSON-RPC server.
+	maxRateLimitedBodySize = 5 * 1024 * 1024
+
+	// ipLimiterTTL is the duration after which the bucket of an idle IP address
+	// is released.
+	ipLimiterTTL = 10 * time.Minute
+)
+
+// rateLimitError is the error of the requests rejected by a rate limit.
+var rateLimitError = rpcError{Code: rateLimitErrorCode, Message: "rate limit exceeded"}
+
+var (
+	rateLimitRejectedCounter   = metrics.NewRegisteredCounter("rpc/ratelimit/rejected", nil)
+	rateLimitRejectedIPCounter = metrics.NewRegisteredCounter("rpc/ratelimit/rejected/ip", nil)
+)
+
+// rateLimiter is a JSON-RPC middleware rejecting the requests of the callers
+// that exceed their limits. Each IP address has a token bucket of compute units
+// that the methods of its requests consume, and methods can also be limited by
+// a token bucket shared by all the callers. Behind trusted proxies, the caller
+// is the client address forwarded by the proxies.
+type rateLimiter struct {
+	next   http.Handler
+	logger log.Logger
+
+	ipRate       rate.Limit
+	ipBurst      int
+	computeUnits map[string]float64
+	methods      map[string]*rate.Limiter
+	allowList    []netip.Prefix
+	proxies      []netip.Prefix
+
+	mu          sync.Mutex
+	ips         map[netip.Addr]*ipLimiter
+	lastCleanup time.Time
+}
+
+// ipLimiter is the token bucket of an IP address.
+type ipLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// rpcMessage is the part of a JSON-RPC request inspected by the rate limiter.
+type rpcMessage struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+}
+
+// rpcErrorResponse is the response to a rejected JSON-RPC request.
+type rpcErrorResponse struct {
+	Jsonrpc string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   rpcError        `json:"error"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// newRateLimiter returns the rate limiting middleware of the given handler, as
+// defined by the JSON-RPC configuration.
+func newRateLimiter(next http.Handler, cfg serverconfig.JSONRPCConfig, logger log.Logger) (*rateLimiter, error) {
+	methodRates, err := serverconfig.ParseMethodValues(cfg.RateLimitMethods)
+	if err != nil {
+		return nil, err
+	}
+
+	computeUnits, err := serverconfig.ParseMethodValues(cfg.RateLimitComputeUnits)
+	if err != nil {
+		return nil, err
+	}
+
+	allowList, err := serverconfig.ParseAllowList(cfg.RateLimitAllowList)
+	if err != nil {
+		return nil, err
+	}
+
+	proxies, err := serverconfig.ParseAllowList(cfg.RateLimitTrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+
+	methods := make(map[string]*rate.Limiter, len(methodRates))
+	for method, r := range methodRates {
+		methods[method] = rate.NewLimiter(rate.Limit(r), max(1, int(r)))
+	}
+
+	return &rateLimiter{
+		next:         next,
+		logger:       logger,
+		ipRate:       rate.Limit(cfg.RateLimitIPRate),
+		ipBurst:      cfg.RateLimitIPBurst,
+		computeUnits: computeUnits,
+		methods:      methods,
+		allowList:    allowList,
+		proxies:      proxies,
+		ips:          make(map[netip.Addr]*ipLimiter),
+		lastCleanup:  time.Now(),
+	}, nil
+}
+
+func (rl *rateLimiter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	addr, ok := rl.clientAddr(r)
+	if r.Method != http.MethodPost || (ok && isInPrefixes(rl.allowList, addr)) {
+		rl.next.ServeHTTP(w, r)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRateLimitedBodySize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	// the JSON-RPC server reads the whole body, including the part above the limit
+	r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), r.Body))
+
+	msgs, batch := parseRPCMessages(body)
+
+	units := 0.0
+	counts := make(map[string]int)
+	for _, msg := range msgs {
+		units += rl.methodComputeUnits(msg.Method)
+		if key, ok := methodKey(rl.methods, msg.Method); ok {
+			counts[key]++
+		}
+	}
+
+	// a batch above the burst would never be allowed, however long the caller waits
+	if ok && int(units) > rl.ipBurst {
+		rateLimitRejectedCounter.Inc(1)
+		rl.logger.Debug("JSON-RPC batch rejected above the ip rate limit burst", "address", addr.String(), "compute-units", units)
+		rl.reject(w, msgs, batch, http.StatusRequestEntityTooLarge, rpcError{Code: batchTooLargeErrorCode, Message: "batch too large for the rate limit"})
+		return
+	}
+
+	// the limited methods are reserved first, and released if the IP address
+	// is rejected, so that the rejected requests don't consume any bucket
+	now := time.Now()
+	reservations := make([]*rate.Reservation, 0, len(counts))
+	cancel := func() {
+		for _, reservation := range reservations {
+			reservation.CancelAt(now)
+		}
+	}
+	for key, count := range counts {
+		reservation := rl.methods[key].ReserveN(now, count)
+		if !reservation.OK() || reservation.DelayFrom(now) > 0 {
+			reservation.CancelAt(now)
+			cancel()
+			rateLimitRejectedCounter.Inc(1)
+			metrics.GetOrRegisterCounter("rpc/ratelimit/rejected/method/"+key, nil).Inc(1)
+			rl.logger.Debug("JSON-RPC request rejected by the method rate limit", "method", key)
+			rl.reject(w, msgs, batch, http.StatusTooManyRequests, rateLimitError)
+			return
+		}
+		reservations = append(reservations, reservation)
+	}
+
+	if ok && !rl.ipLimiter(addr).AllowN(now, max(1, int(units))) {
+		cancel()
+		rateLimitRejectedCounter.Inc(1)
+		rateLimitRejectedIPCounter.Inc(1)
+		rl.logger.Debug("JSON-RPC request rejected by the ip rate limit", "address", addr.String(), "compute-units", units)
+		rl.reject(w, msgs, batch, http.StatusTooManyRequests, rateLimitError)
+		return
+	}
+
+	rl.next.ServeHTTP(w, r)
+}
+
+// clientAddr returns the IP address of the caller of a request. The requests
+// of trusted proxies are charged to the client address they forward, which is
+// the last address of the X-Forwarded-For header not set by a trusted proxy,
+// or the X-Real-IP header.
+func (rl *rateLimiter) clientAddr(r *http.Request) (netip.Addr, bool) {
+	addr, ok := remoteAddr(r)
+	if !ok || !isInPrefixes(rl.proxies, addr) {
+		return addr, ok
+	}
+
+	if forwarded := r.Header.Values("X-Forwarded-For"); len(forwarded) > 0 {
+		hops := strings.Split(strings.Join(forwarded, ","), ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+			if err != nil {
+				// the remaining hops can't be trusted, charge the last known hop
+				return addr, true
+			}
+			addr = hop.Unmap()
+			if !isInPrefixes(rl.proxies, addr) {
+				return addr, true
+			}
+		}
+		return addr, true
+	}
+
+	if realIP, err := netip.ParseAddr(strings.TrimSpace(r.Header.Get("X-Real-IP"))); err == nil {
+		return realIP.Unmap(), true
+	}
+	return addr, true
+}
+
+// isInPrefixes returns true if the IP address is in one of the prefixes.
+func isInPrefixes(prefixes []netip.Prefix, addr netip.Addr) bool {
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// methodComputeUnits returns the compute units of a method, 1 by default.
+func (rl *rateLimiter) methodComputeUnits(method string) float64 {
+	if key, ok := methodKey(rl.computeUnits, method); ok {
+		return rl.computeUnits[key]
+	}
+	return 1
+}
+
+// ipLimiter returns the token bucket of an IP address, and releases the ones
+// of the idle IP addresses.
+func (rl *rateLimiter) ipLimiter(addr netip.Addr) *rate.Limiter {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(rl.lastCleanup) > ipLimiterTTL {
+		for ip, l := range rl.ips {
+			if now.Sub(l.lastSeen) > ipLimiterTTL {
+				delete(rl.ips, ip)
+			}
+		}
+		rl.lastCleanup = now
+	}
+
+	l, ok := rl.ips[addr]
+	if !ok {
+		l = &ipLimiter{limiter: rate.NewLimiter(rl.ipRate, rl.ipBurst)}
+		rl.ips[addr] = l
+	}
+	l.lastSeen = now
+	return l.limiter
+}
+
+// reject responds to the rejected requests with a JSON-RPC error.
+func (rl *rateLimiter) reject(w http.ResponseWriter, msgs []rpcMessage, batch bool, status int, rpcErr rpcError) {
+	responses := make([]rpcErrorResponse, 0, len(msgs))
+	for _, msg := range msgs {
+		id := msg.ID
+		if len(id) == 0 {
+			id = json.RawMessage("null")
+		}
+		responses = append(responses, rpcErrorResponse{
+			Jsonrpc: "2.0",
+			ID:      id,
+			Error:   rpcErr,
+		})
+	}
+
+	var res interface{} = responses
+	if !batch && len(responses) == 1 {
+		res = responses[0]
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		rl.logger.Debug("failed to write the rate limit response", "error", err.Error())
+	}
+}
+
+// parseRPCMessages parses a single JSON-RPC request or a batch of requests.
+// A body that cannot be parsed counts as a single request without method.
+func parseRPCMessages(body []byte) ([]rpcMessage, bool) {
+	trimmed := bytes.TrimLeft(body, " \t\r\n")
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		var msgs []rpcMessage
+		if err := json.Unmarshal(trimmed, &msgs); err == nil && len(msgs) > 0 {
+			return msgs, true
+		}
+		return []rpcMessage{{}}, true
+	}
+
+	var msg rpcMessage
+	_ = json.Unmarshal(trimmed, &msg) // #nosec G703 -- invalid requests are rejected by the server
+	return []rpcMessage{msg}, false
+}
+
+// methodKey returns the key of the value of a method, set either for the method
+// itself or for all the methods of its namespace (e.g. "debug_*").
+func methodKey[V any](values map[string]V, method string) (string, bool) {
+	if _, ok := values[method]; ok {
+		return method, true
+	}
+	if namespace, _, ok := strings.Cut(method, "_"); ok {
+		if _, ok := values[namespace+"_*"]; ok {
+			return namespace + "_*", true
+		}
+	}
+	return "", false
+}
+
+// remoteAddr returns the IP address of the caller of a request.
+func remoteAddr(r *http.Request) (netip.Addr, bool) {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr.Unmap(), true
+}
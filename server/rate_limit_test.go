@@ -0,0 +1,241 @@
+package server
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	serverconfig "github.com/cosmos/evm/server/config"
+
+	"cosmossdk.io/log"
+)
+
+func TestRateLimiter(t *testing.T) {
+	const (
+		ip        = "10.0.0.1:1234"
+		trustedIP = "192.168.1.5:1234"
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func(cfg *serverconfig.JSONRPCConfig)
+		run      func(t *testing.T, rl *rateLimiter)
+	}{
+		{
+			"ip bucket is consumed by compute units",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.RateLimitIPBurst = 25
+				cfg.RateLimitComputeUnits = []string{"eth_getLogs:20"}
+			},
+			func(t *testing.T, rl *rateLimiter) {
+				res := serve(rl, ip, `{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[]}`)
+				require.Equal(t, http.StatusOK, res.Code)
+
+				res = serve(rl, ip, `{"jsonrpc":"2.0","id":2,"method":"eth_getLogs","params":[]}`)
+				require.Equal(t, http.StatusTooManyRequests, res.Code)
+				require.JSONEq(t, `{"jsonrpc":"2.0","id":2,"error":{"code":-32005,"message":"rate limit exceeded"}}`, res.Body.String())
+
+				// cheaper methods can still be called, and other ips are not limited
+				res = serve(rl, ip, `{"jsonrpc":"2.0","id":3,"method":"eth_blockNumber","params":[]}`)
+				require.Equal(t, http.StatusOK, res.Code)
+				res = serve(rl, "10.0.0.2:1234", `{"jsonrpc":"2.0","id":4,"method":"eth_getLogs","params":[]}`)
+				require.Equal(t, http.StatusOK, res.Code)
+			},
+		},
+		{
+			"batch requests are charged all their compute units",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.RateLimitIPBurst = 15
+				cfg.RateLimitComputeUnits = []string{"eth_call:5"}
+			},
+			func(t *testing.T, rl *rateLimiter) {
+				res := serve(rl, ip, `[{"jsonrpc":"2.0","id":1,"method":"eth_call"},{"jsonrpc":"2.0","id":2,"method":"eth_call"}]`)
+				require.Equal(t, http.StatusOK, res.Code)
+
+				res = serve(rl, ip, `[{"jsonrpc":"2.0","id":3,"method":"eth_call"},{"jsonrpc":"2.0","id":4,"method":"eth_call"}]`)
+				require.Equal(t, http.StatusTooManyRequests, res.Code)
+				require.JSONEq(t, `[
+					{"jsonrpc":"2.0","id":3,"error":{"code":-32005,"message":"rate limit exceeded"}},
+					{"jsonrpc":"2.0","id":4,"error":{"code":-32005,"message":"rate limit exceeded"}}
+				]`, res.Body.String())
+			},
+		},
+		{
+			"batch requests above the ip burst are too large",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.RateLimitIPBurst = 10
+				cfg.RateLimitComputeUnits = []string{"eth_call:5"}
+			},
+			func(t *testing.T, rl *rateLimiter) {
+				res := serve(rl, ip, `[{"jsonrpc":"2.0","id":1,"method":"eth_call"},{"jsonrpc":"2.0","id":2,"method":"eth_call"},{"jsonrpc":"2.0","id":3,"method":"eth_call"}]`)
+				require.Equal(t, http.StatusRequestEntityTooLarge, res.Code)
+				require.JSONEq(t, `[
+					{"jsonrpc":"2.0","id":1,"error":{"code":-32600,"message":"batch too large for the rate limit"}},
+					{"jsonrpc":"2.0","id":2,"error":{"code":-32600,"message":"batch too large for the rate limit"}},
+					{"jsonrpc":"2.0","id":3,"error":{"code":-32600,"message":"batch too large for the rate limit"}}
+				]`, res.Body.String())
+
+				// the rejected batch didn't consume the bucket
+				res = serve(rl, ip, `[{"jsonrpc":"2.0","id":4,"method":"eth_call"},{"jsonrpc":"2.0","id":5,"method":"eth_call"}]`)
+				require.Equal(t, http.StatusOK, res.Code)
+			},
+		},
+		{
+			"method bucket is shared by all the ips",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.RateLimitMethods = []string{"debug_*:1"}
+			},
+			func(t *testing.T, rl *rateLimiter) {
+				res := serve(rl, ip, `{"jsonrpc":"2.0","id":1,"method":"debug_traceTransaction","params":[]}`)
+				require.Equal(t, http.StatusOK, res.Code)
+
+				res = serve(rl, "10.0.0.2:1234", `{"jsonrpc":"2.0","id":2,"method":"debug_traceBlockByNumber","params":[]}`)
+				require.Equal(t, http.StatusTooManyRequests, res.Code)
+
+				res = serve(rl, "10.0.0.2:1234", `{"jsonrpc":"2.0","id":3,"method":"eth_chainId","params":[]}`)
+				require.Equal(t, http.StatusOK, res.Code)
+			},
+		},
+		{
+			"requests rejected by a method limit don't consume the ip bucket",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.RateLimitIPBurst = 20
+				cfg.RateLimitComputeUnits = []string{"eth_getLogs:10"}
+				cfg.RateLimitMethods = []string{"eth_getLogs:1"}
+			},
+			func(t *testing.T, rl *rateLimiter) {
+				res := serve(rl, "10.0.0.2:1234", `{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[]}`)
+				require.Equal(t, http.StatusOK, res.Code)
+
+				for i := 0; i < 5; i++ {
+					res = serve(rl, ip, `{"jsonrpc":"2.0","id":2,"method":"eth_getLogs","params":[]}`)
+					require.Equal(t, http.StatusTooManyRequests, res.Code)
+				}
+
+				// the ip bucket is still full
+				res = serve(rl, ip, `[{"jsonrpc":"2.0","id":3,"method":"eth_blockNumber"},{"jsonrpc":"2.0","id":4,"method":"eth_call"}]`)
+				require.Equal(t, http.StatusOK, res.Code)
+				for i := 0; i < 18; i++ {
+					res = serve(rl, ip, `{"jsonrpc":"2.0","id":5,"method":"eth_chainId","params":[]}`)
+					require.Equal(t, http.StatusOK, res.Code)
+				}
+				res = serve(rl, ip, `{"jsonrpc":"2.0","id":6,"method":"eth_chainId","params":[]}`)
+				require.Equal(t, http.StatusTooManyRequests, res.Code)
+			},
+		},
+		{
+			"requests rejected by the ip limit don't consume the method bucket",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.RateLimitIPBurst = 10
+				cfg.RateLimitComputeUnits = []string{"eth_getLogs:10"}
+				cfg.RateLimitMethods = []string{"eth_getLogs:1"}
+			},
+			func(t *testing.T, rl *rateLimiter) {
+				res := serve(rl, ip, `{"jsonrpc":"2.0","id":1,"method":"eth_chainId","params":[]}`)
+				require.Equal(t, http.StatusOK, res.Code)
+				res = serve(rl, ip, `{"jsonrpc":"2.0","id":2,"method":"eth_getLogs","params":[]}`)
+				require.Equal(t, http.StatusTooManyRequests, res.Code)
+
+				res = serve(rl, "10.0.0.2:1234", `{"jsonrpc":"2.0","id":3,"method":"eth_getLogs","params":[]}`)
+				require.Equal(t, http.StatusOK, res.Code)
+			},
+		},
+		{
+			"trusted proxies forward the client address",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.RateLimitIPBurst = 1
+				cfg.RateLimitComputeUnits = []string{}
+				cfg.RateLimitTrustedProxies = []string{"172.16.0.0/12"}
+			},
+			func(t *testing.T, rl *rateLimiter) {
+				const proxy = "172.16.0.1:1234"
+				forwarded := func(header, value string) *httptest.ResponseRecorder {
+					return serveWithHeader(rl, proxy, header, value, `{"jsonrpc":"2.0","id":1,"method":"eth_chainId","params":[]}`)
+				}
+
+				// each client behind the proxy has its own bucket
+				require.Equal(t, http.StatusOK, forwarded("X-Forwarded-For", "10.0.0.1").Code)
+				require.Equal(t, http.StatusTooManyRequests, forwarded("X-Forwarded-For", "10.0.0.1").Code)
+				require.Equal(t, http.StatusOK, forwarded("X-Forwarded-For", "10.0.0.2").Code)
+				require.Equal(t, http.StatusOK, forwarded("X-Real-IP", "10.0.0.3").Code)
+				require.Equal(t, http.StatusTooManyRequests, forwarded("X-Real-IP", "10.0.0.3").Code)
+
+				// the addresses set before the last untrusted hop are ignored
+				require.Equal(t, http.StatusOK, forwarded("X-Forwarded-For", "10.0.0.1, 10.0.0.4, 172.16.0.2").Code)
+				require.Equal(t, http.StatusTooManyRequests, forwarded("X-Forwarded-For", "10.0.0.5, 10.0.0.4").Code)
+
+				// the proxy itself is charged without forwarded address
+				require.Equal(t, http.StatusOK, forwarded("X-Forwarded-For", "").Code)
+				require.Equal(t, http.StatusTooManyRequests, forwarded("X-Forwarded-For", "").Code)
+
+				// the headers of untrusted callers are ignored
+				res := serveWithHeader(rl, "10.0.0.8:1234", "X-Forwarded-For", "10.0.0.6", `{"jsonrpc":"2.0","id":1,"method":"eth_chainId","params":[]}`)
+				require.Equal(t, http.StatusOK, res.Code)
+				res = serveWithHeader(rl, "10.0.0.8:1234", "X-Forwarded-For", "10.0.0.7", `{"jsonrpc":"2.0","id":1,"method":"eth_chainId","params":[]}`)
+				require.Equal(t, http.StatusTooManyRequests, res.Code)
+			},
+		},
+		{
+			"allow listed ips are not limited",
+			func(cfg *serverconfig.JSONRPCConfig) {
+				cfg.RateLimitIPBurst = 1
+				cfg.RateLimitComputeUnits = []string{}
+				cfg.RateLimitMethods = []string{"eth_getLogs:1"}
+				cfg.RateLimitAllowList = []string{"192.168.1.0/24"}
+			},
+			func(t *testing.T, rl *rateLimiter) {
+				for i := 0; i < 5; i++ {
+					res := serve(rl, trustedIP, `{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[]}`)
+					require.Equal(t, http.StatusOK, res.Code)
+				}
+			},
+		},
+		{
+			"request body is forwarded",
+			func(*serverconfig.JSONRPCConfig) {},
+			func(t *testing.T, rl *rateLimiter) {
+				res := serve(rl, ip, `{"jsonrpc":"2.0","id":1,"method":"eth_chainId","params":[]}`)
+				require.Equal(t, http.StatusOK, res.Code)
+				require.Equal(t, `{"jsonrpc":"2.0","id":1,"method":"eth_chainId","params":[]}`, res.Body.String())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := serverconfig.DefaultJSONRPCConfig()
+			cfg.EnableRateLimit = true
+			cfg.RateLimitIPRate = 0.001 // buckets are not refilled during the test
+			tc.malleate(cfg)
+			require.NoError(t, cfg.Validate())
+
+			// the next handler echoes the request body
+			echo := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = io.Copy(w, r.Body)
+			})
+			rl, err := newRateLimiter(echo, *cfg, log.NewNopLogger())
+			require.NoError(t, err)
+
+			tc.run(t, rl)
+		})
+	}
+}
+
+func serve(handler http.Handler, remoteAddr, body string) *httptest.ResponseRecorder {
+	return serveWithHeader(handler, remoteAddr, "", "", body)
+}
+
+func serveWithHeader(handler http.Handler, remoteAddr, header, value, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.RemoteAddr = remoteAddr
+	if header != "" && value != "" {
+		req.Header.Set(header, value)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
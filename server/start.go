@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"io"
 	"net"
@@ -33,6 +34,7 @@ import (
 	cosmosevmserverconfig "github.com/cosmos/evm/server/config"
 	srvflags "github.com/cosmos/evm/server/flags"
 	cosmosevmtypes "github.com/cosmos/evm/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
@@ -63,6 +65,12 @@ type Application interface {
 	SetClientCtx(clientCtx client.Context)
 }
 
+// AppWithHeaderHashStore defines an app that serves the block hashes beyond the
+// EIP-2935 history serve window from the header hashes archived by the indexer.
+type AppWithHeaderHashStore interface {
+	SetHeaderHashStore(store evmtypes.HeaderHashStore)
+}
+
 // AppCreator is a function that allows us to lazily initialize an application implementing with AppWithPendingTxStream.
 type AppCreator func(log.Logger, dbm.DB, io.Writer, types.AppOptions) Application
 
@@ -212,13 +220,27 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, cosmosevmserverconfig.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, cosmosevmserverconfig.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().String(srvflags.JSONRPCIndexerBackend, cosmosevmserverconfig.DefaultIndexerBackend, "Sets the backend of the custom tx indexer (kv|psql)")
+	cmd.Flags().String(srvflags.JSONRPCIndexerPSQLConn, "", "Sets the PostgreSQL connection string of the psql indexer backend")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableProfiling, false, "Enables the profiling in the debug namespace")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableRateLimit, false, "Enables the rate limiting of the JSON-RPC requests")
+	cmd.Flags().Float64(srvflags.JSONRPCRateLimitIPRate, cosmosevmserverconfig.DefaultRateLimitIPRate, "Sets the number of compute units per second each IP address can spend")                  //nolint:lll
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitIPBurst, cosmosevmserverconfig.DefaultRateLimitIPBurst, "Sets the maximum number of compute units each IP address can spend at once")               //nolint:lll
+	cmd.Flags().StringSlice(srvflags.JSONRPCRateLimitMethods, []string{}, "Defines per-method limits in requests per second shared by all the callers, as method:rate entries")                  //nolint:lll
+	cmd.Flags().StringSlice(srvflags.JSONRPCRateLimitComputeUnits, cosmosevmserverconfig.GetDefaultRateLimitComputeUnits(), "Defines the compute units of the methods, as method:units entries") //nolint:lll
+	cmd.Flags().StringSlice(srvflags.JSONRPCRateLimitAllowList, []string{}, "Defines the IP addresses and CIDR ranges that are not rate limited")
+	cmd.Flags().StringSlice(srvflags.JSONRPCRateLimitProxies, []string{}, "Defines the IP addresses and CIDR ranges of the trusted proxies forwarding the client addresses") //nolint:lll
+	cmd.Flags().String(srvflags.JSONRPCAuthAddress, cosmosevmserverconfig.DefaultJSONRPCAuthAddress, "the JSON-RPC auth server address to listen on")
+	cmd.Flags().StringSlice(srvflags.JSONRPCAuthAPI, []string{}, "Defines the namespaces that require JWT authentication, only served by the JSON-RPC auth server")                      //nolint:lll
+	cmd.Flags().String(srvflags.JSONRPCJWTSecret, cosmosevmserverconfig.DefaultJWTSecret, "the path of the JWT secret file of the JSON-RPC auth server, relative to the home directory") //nolint:lll
 
 	cmd.Flags().String(srvflags.EVMTracer, cosmosevmserverconfig.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, cosmosevmserverconfig.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
 	cmd.Flags().Bool(srvflags.EVMEnablePreimageRecording, cosmosevmserverconfig.DefaultEnablePreimageRecording, "Enables tracking of SHA3 preimages in the EVM (not implemented yet)")                      //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMChainID, cosmosevmserverconfig.DefaultEVMChainID, "the EIP-155 compatible replay protection chain ID")
+	cmd.Flags().String(srvflags.EVMMempoolJournal, cosmosevmserverconfig.DefaultMempoolJournal, "the file, relative to the node data directory, to journal the EVM mempool transactions to (empty=disabled)") //nolint:lll
+	cmd.Flags().Duration(srvflags.EVMMempoolRejournal, cosmosevmserverconfig.DefaultMempoolRejournal, "the time interval to regenerate the EVM mempool journal")
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
@@ -402,13 +424,32 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 	var (
 		tmNode   *node.Node
 		gRPCOnly = svrCtx.Viper.GetBool(srvflags.GRPCOnly)
+		idxer    cosmosevmtypes.EVMTxIndexer
 	)
 
 	if gRPCOnly {
 		logger.Info("starting node in query only mode; CometBFT is disabled")
 		config.GRPC.Enable = true
 		config.JSONRPC.EnableIndexer = false
-	} else {
+	}
+
+	// the indexer is opened before the node is started, so that the app serves
+	// the archived header hashes as soon as it executes queries
+	if config.JSONRPC.EnableIndexer {
+		idxer, err = OpenEVMTxIndexer(config.JSONRPC, home, server.GetAppDBBackend(svrCtx.Viper), svrCtx.Logger.With("indexer", "evm"), clientCtx)
+		if err != nil {
+			logger.Error("failed to open evm indexer DB", "error", err.Error())
+			return err
+		}
+
+		hashIndexer, isHashIndexer := idxer.(cosmosevmtypes.HeaderHashIndexer)
+		hashApp, isHashApp := app.(AppWithHeaderHashStore)
+		if isHashIndexer && isHashApp {
+			hashApp.SetHeaderHashStore(hashIndexer)
+		}
+	}
+
+	if !gRPCOnly {
 		logger.Info("starting node with ABCI CometBFT in-process")
 
 		cmtApp := server.NewCometABCIWrapper(app)
@@ -464,18 +505,9 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 		ethmetricsexp.Setup(config.JSONRPC.MetricsAddress)
 	}
 
-	var idxer cosmosevmtypes.EVMTxIndexer
 	if config.JSONRPC.EnableIndexer {
-		idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(svrCtx.Viper))
-		if err != nil {
-			logger.Error("failed to open evm indexer DB", "error", err.Error())
-			return err
-		}
-
-		idxLogger := svrCtx.Logger.With("indexer", "evm")
-		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
 		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client))
-		indexerService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: idxLogger})
+		indexerService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: svrCtx.Logger.With("indexer", "evm")})
 
 		g.Go(func() error {
 			return indexerService.Start()
@@ -533,6 +565,45 @@ func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error)
 	return dbm.NewDB("evmindexer", backendType, dataDir)
 }
 
+// BackfillEVMTxIndexer defines an EVM tx indexer that can be backfilled from
+// the first indexed block.
+type BackfillEVMTxIndexer interface {
+	cosmosevmtypes.EVMTxIndexer
+
+	// FirstIndexedBlock returns -1 if indexer db is empty
+	FirstIndexedBlock() (int64, error)
+}
+
+// OpenEVMTxIndexer opens the custom EVM tx indexer with the backend defined in
+// the JSON-RPC configuration.
+func OpenEVMTxIndexer(
+	cfg cosmosevmserverconfig.JSONRPCConfig,
+	rootDir string,
+	backendType dbm.BackendType,
+	logger log.Logger,
+	clientCtx client.Context,
+) (BackfillEVMTxIndexer, error) {
+	if cfg.IndexerBackend == cosmosevmserverconfig.IndexerBackendPSQL {
+		db, err := sql.Open(indexer.SQLDriverName, cfg.IndexerPSQLConn)
+		if err != nil {
+			return nil, err
+		}
+
+		idxer, err := indexer.NewSQLIndexer(db, logger, clientCtx)
+		if err != nil {
+			_ = db.Close()
+			return nil, err
+		}
+		return idxer, nil
+	}
+
+	idxDB, err := OpenIndexerDB(rootDir, backendType)
+	if err != nil {
+		return nil, err
+	}
+	return indexer.NewKVIndexer(idxDB, logger, clientCtx), nil
+}
+
 // openTraceWriter opens a trace writer if a trace store file is specified.
 // Parameters:
 // - traceWriterFile: The path to the trace store file. If this is an empty string, no file will be opened.
@@ -55,6 +55,8 @@ func AddCommands(
 
 		// custom tx indexer command
 		NewIndexTxCmd(),
+		NewIndexHeaderHashCmd(),
+		NewGenerateJWTSecretCmd(),
 	)
 }
 
@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	"github.com/cosmos/evm/testutil/integration/evm/utils"
 	testkeyring "github.com/cosmos/evm/testutil/keyring"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	storetypes "cosmossdk.io/store/types"
@@ -126,6 +127,11 @@ func (s *EvmUnitAnteTestSuite) TestCheckGasWanted() {
 					unitNetwork.GetContext(),
 				)
 				s.Require().Equal(tc.expectedTransientGasWanted, transientGasWanted)
+				// the gas of the Ethereum txs is tracked in the EVM gas lane
+				evmGasWanted := unitNetwork.App.GetFeeMarketKeeper().GetTransientLaneGasWanted(
+					unitNetwork.GetContext(), feemarkettypes.GasLaneEVM,
+				)
+				s.Require().Equal(tc.expectedTransientGasWanted, evmGasWanted)
 			}
 
 			// Start from a fresh block and ctx
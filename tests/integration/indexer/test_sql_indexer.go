@@ -0,0 +1,325 @@
+package indexer
+
+import (
+	"database/sql"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/indexer"
+	"github.com/cosmos/evm/testutil/constants"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// TestSQLIndexer runs the SQL indexer against the PostgreSQL database of the
+// given data source name. The indexer tables are dropped before every case,
+// so the database must be dedicated to the tests.
+func TestSQLIndexer(t *testing.T, dsn string, create network.CreateEvmApp, options ...network.ConfigOption) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	to := common.BigToAddress(big.NewInt(1))
+	ethTxParams := types.EvmTxArgs{
+		Nonce:    0,
+		To:       &to,
+		Amount:   big.NewInt(1000),
+		GasLimit: 21000,
+	}
+	tx := types.NewTx(&ethTxParams)
+	tx.From = from.Bytes()
+	require.NoError(t, tx.Sign(ethSigner, signer))
+	txHash := tx.AsTransaction().Hash()
+
+	nw := network.New(create, options...)
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	// build cosmos-sdk wrapper tx
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), constants.ExampleAttoDenom)
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	// build an invalid wrapper tx
+	builder := clientCtx.TxConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(tx))
+	tmTx2 := builder.GetTx()
+	txBz2, err := clientCtx.TxConfig.TxEncoder()(tmTx2)
+	require.NoError(t, err)
+
+	ethTxEvent := abci.Event{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+		{Key: "ethereumTxHash", Value: txHash.Hex()},
+		{Key: "txIndex", Value: "0"},
+		{Key: "amount", Value: "1000"},
+		{Key: "txGasUsed", Value: "21000"},
+		{Key: "txHash", Value: ""},
+		{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+	}}
+
+	contractA := common.HexToAddress("0xa")
+	contractB := common.HexToAddress("0xb")
+	topicA := common.HexToHash("0x1a")
+	topicB := common.HexToHash("0x1b")
+	blockHash := common.HexToHash("0xbb")
+	ethLogs := []*ethtypes.Log{
+		{Address: contractA, Topics: []common.Hash{topicA}, Data: []byte{1}, TxHash: txHash, BlockHash: blockHash, Index: 0},
+		{Address: contractB, Topics: []common.Hash{topicA, topicB}, TxHash: txHash, BlockHash: blockHash, Index: 1},
+		{Address: contractA, Topics: []common.Hash{}, Data: []byte{3}, TxHash: txHash, BlockHash: blockHash, Index: 2},
+	}
+	logsEvent := abci.Event{Type: types.EventTypeTxLog}
+	for _, ethLog := range ethLogs {
+		bz, err := json.Marshal(types.NewLogFromEth(ethLog))
+		require.NoError(t, err)
+		logsEvent.Attributes = append(logsEvent.Attributes, abci.EventAttribute{Key: types.AttributeKeyTxLog, Value: string(bz)})
+	}
+
+	// newIndexer creates an indexer on an empty database
+	newIndexer := func(t *testing.T) (*sql.DB, *indexer.SQLIndexer) {
+		db, err := sql.Open(indexer.SQLDriverName, dsn)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = db.Close() })
+		_, err = db.Exec("DROP TABLE IF EXISTS evm_logs, evm_txs, evm_blocks, evm_header_hashes")
+		require.NoError(t, err)
+
+		idxer, err := indexer.NewSQLIndexer(db, log.NewNopLogger(), clientCtx)
+		require.NoError(t, err)
+		return db, idxer
+	}
+
+	t.Run("index block", func(t *testing.T) {
+		testCases := []struct {
+			name        string
+			block       *cmttypes.Block
+			blockResult []*abci.ExecTxResult
+			expSuccess  bool
+		}{
+			{
+				"success",
+				&cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}},
+				[]*abci.ExecTxResult{{Code: 0, Events: []abci.Event{ethTxEvent}}},
+				true,
+			},
+			{
+				"success, exceed block gas limit",
+				&cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}},
+				[]*abci.ExecTxResult{
+					{
+						Code:   11,
+						Log:    "out of gas in location: block gas meter; gasWanted: 21000",
+						Events: []abci.Event{},
+					},
+				},
+				true,
+			},
+			{
+				"fail, failed eth tx",
+				&cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}},
+				[]*abci.ExecTxResult{{Code: 15, Log: "nonce mismatch", Events: []abci.Event{}}},
+				false,
+			},
+			{
+				"fail, invalid events",
+				&cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}},
+				[]*abci.ExecTxResult{{Code: 0, Events: []abci.Event{}}},
+				false,
+			},
+			{
+				"fail, not eth tx",
+				&cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz2}}},
+				[]*abci.ExecTxResult{{Code: 0, Events: []abci.Event{}}},
+				false,
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, idxer := newIndexer(t)
+
+				first, err := idxer.FirstIndexedBlock()
+				require.NoError(t, err)
+				require.Equal(t, int64(-1), first)
+
+				require.NoError(t, idxer.IndexBlock(tc.block, tc.blockResult))
+
+				// the blocks are indexed even without eth txs
+				first, err = idxer.FirstIndexedBlock()
+				require.NoError(t, err)
+				require.Equal(t, tc.block.Height, first)
+				last, err := idxer.LastIndexedBlock()
+				require.NoError(t, err)
+				require.Equal(t, tc.block.Height, last)
+
+				res1, err := idxer.GetByTxHash(txHash)
+				if !tc.expSuccess {
+					require.ErrorContains(t, err, "tx not found")
+					require.Nil(t, res1)
+					_, err = idxer.GetByBlockAndIndex(1, 0)
+					require.ErrorContains(t, err, "tx not found")
+					return
+				}
+				require.NoError(t, err)
+				require.Equal(t, tc.block.Height, res1.Height)
+				require.Equal(t, int32(0), res1.EthTxIndex)
+				require.Equal(t, uint64(21000), res1.GasUsed)
+				require.Equal(t, uint64(21000), res1.CumulativeGasUsed)
+				res2, err := idxer.GetByBlockAndIndex(1, 0)
+				require.NoError(t, err)
+				require.Equal(t, res1, res2)
+
+				_, err = idxer.GetByBlockAndIndex(1, 1)
+				require.ErrorContains(t, err, "tx not found")
+			})
+		}
+	})
+
+	t.Run("reindex block", func(t *testing.T) {
+		_, idxer := newIndexer(t)
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+
+		require.NoError(t, idxer.IndexBlock(block, []*abci.ExecTxResult{{Code: 0, Events: []abci.Event{ethTxEvent, logsEvent}}}))
+		logs, err := idxer.GetLogs(1, 1, nil, nil, 10)
+		require.NoError(t, err)
+		require.Len(t, logs, len(ethLogs))
+
+		// indexing the block again replaces its entries
+		require.NoError(t, idxer.IndexBlock(block, []*abci.ExecTxResult{{Code: 15, Log: "nonce mismatch"}}))
+		_, err = idxer.GetByTxHash(txHash)
+		require.ErrorContains(t, err, "tx not found")
+		logs, err = idxer.GetLogs(1, 1, nil, nil, 10)
+		require.NoError(t, err)
+		require.Empty(t, logs)
+	})
+
+	t.Run("get logs", func(t *testing.T) {
+		_, idxer := newIndexer(t)
+		for height := int64(1); height <= 3; height++ {
+			block := &cmttypes.Block{Header: cmttypes.Header{Height: height}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+			require.NoError(t, idxer.IndexBlock(block, []*abci.ExecTxResult{{Code: 0, Events: []abci.Event{ethTxEvent, logsEvent}}}))
+		}
+
+		testCases := []struct {
+			name      string
+			from, to  int64
+			addresses []common.Address
+			topics    [][]common.Hash
+			limit     int
+			expLogs   []uint // indices of the expected logs within each block
+			expBlocks []uint64
+		}{
+			{"all", 1, 3, nil, nil, 100, []uint{0, 1, 2, 0, 1, 2, 0, 1, 2}, []uint64{1, 1, 1, 2, 2, 2, 3, 3, 3}},
+			{"block range", 2, 2, nil, nil, 100, []uint{0, 1, 2}, []uint64{2, 2, 2}},
+			{"limit", 1, 3, nil, nil, 4, []uint{0, 1, 2, 0}, []uint64{1, 1, 1, 2}},
+			{"address", 1, 1, []common.Address{contractA}, nil, 100, []uint{0, 2}, []uint64{1, 1}},
+			{"addresses", 1, 1, []common.Address{contractA, contractB}, nil, 100, []uint{0, 1, 2}, []uint64{1, 1, 1}},
+			{"topic", 1, 1, nil, [][]common.Hash{{topicA}}, 100, []uint{0, 1}, []uint64{1, 1}},
+			{"wildcard topic", 1, 1, nil, [][]common.Hash{{}, {topicB}}, 100, []uint{1}, []uint64{1}},
+			{"address and topic", 1, 1, []common.Address{contractA}, [][]common.Hash{{topicA, topicB}}, 100, []uint{0}, []uint64{1}},
+			{"no match", 1, 1, []common.Address{contractB}, [][]common.Hash{{topicB}}, 100, []uint{}, []uint64{}},
+			{"out of range", 4, 10, nil, nil, 100, []uint{}, []uint64{}},
+			{"too many topics", 1, 3, nil, make([][]common.Hash, 5), 100, []uint{}, []uint64{}},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				logs, err := idxer.GetLogs(tc.from, tc.to, tc.addresses, tc.topics, tc.limit)
+				require.NoError(t, err)
+				require.Len(t, logs, len(tc.expLogs))
+				for i, ethLog := range logs {
+					expLog := ethLogs[tc.expLogs[i]]
+					require.Equal(t, tc.expBlocks[i], ethLog.BlockNumber)
+					require.Equal(t, expLog.Address, ethLog.Address)
+					require.Equal(t, expLog.Topics, ethLog.Topics)
+					require.Equal(t, expLog.Index, ethLog.Index)
+					require.Equal(t, txHash, ethLog.TxHash)
+					require.Equal(t, blockHash, ethLog.BlockHash)
+					if expLog.Data == nil {
+						require.Empty(t, ethLog.Data)
+					} else {
+						require.Equal(t, expLog.Data, ethLog.Data)
+					}
+				}
+			})
+		}
+	})
+
+	t.Run("header hash", func(t *testing.T) {
+		_, idxer := newIndexer(t)
+
+		hash, err := idxer.GetHeaderHash(1)
+		require.NoError(t, err)
+		require.Equal(t, common.Hash{}, hash)
+		height, err := idxer.GetHeightByHeaderHash(common.HexToHash("0x1"))
+		require.NoError(t, err)
+		require.Equal(t, int64(-1), height)
+
+		require.NoError(t, idxer.IndexHeaderHash(1, common.HexToHash("0x1")))
+		// archiving a height again replaces its hash
+		require.NoError(t, idxer.IndexHeaderHash(1, common.HexToHash("0x2")))
+		hash, err = idxer.GetHeaderHash(1)
+		require.NoError(t, err)
+		require.Equal(t, common.HexToHash("0x2"), hash)
+		height, err = idxer.GetHeightByHeaderHash(common.HexToHash("0x2"))
+		require.NoError(t, err)
+		require.Equal(t, int64(1), height)
+
+		// the archived hashes don't count as indexed blocks
+		last, err := idxer.LastIndexedBlock()
+		require.NoError(t, err)
+		require.Equal(t, int64(-1), last)
+	})
+
+	t.Run("database errors", func(t *testing.T) {
+		db, idxer := newIndexer(t)
+		require.NoError(t, db.Close())
+
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+		require.ErrorContains(t, idxer.IndexBlock(block, []*abci.ExecTxResult{{Code: 0, Events: []abci.Event{ethTxEvent}}}), "IndexBlock 1")
+		_, err := idxer.LastIndexedBlock()
+		require.ErrorContains(t, err, "load indexed block")
+		_, err = idxer.GetByTxHash(txHash)
+		require.ErrorContains(t, err, "GetByTxHash")
+		_, err = idxer.GetByBlockAndIndex(1, 0)
+		require.ErrorContains(t, err, "GetByBlockAndIndex 1 0")
+		_, err = idxer.GetLogs(1, 1, nil, nil, 10)
+		require.ErrorContains(t, err, "GetLogs 1 1")
+		require.ErrorContains(t, idxer.IndexHeaderHash(1, common.HexToHash("0x1")), "IndexHeaderHash 1")
+		_, err = idxer.GetHeaderHash(1)
+		require.ErrorContains(t, err, "GetHeaderHash 1")
+	})
+
+	t.Run("schema conflict", func(t *testing.T) {
+		db, idxer := newIndexer(t)
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+		require.NoError(t, idxer.IndexBlock(block, []*abci.ExecTxResult{{Code: 0, Events: []abci.Event{ethTxEvent}}}))
+
+		// the same eth tx in another block violates the tx hash key, so the
+		// whole block is rolled back
+		block2 := &cmttypes.Block{Header: cmttypes.Header{Height: 2}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+		err := idxer.IndexBlock(block2, []*abci.ExecTxResult{{Code: 0, Events: []abci.Event{ethTxEvent}}})
+		require.ErrorContains(t, err, "insert tx")
+
+		var count int
+		require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM evm_blocks WHERE height = 2").Scan(&count))
+		require.Zero(t, count)
+		last, err := idxer.LastIndexedBlock()
+		require.NoError(t, err)
+		require.Equal(t, int64(1), last)
+	})
+}
@@ -0,0 +1,226 @@
+package authz
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+
+	"github.com/cosmos/evm/precompiles/authz"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/statedb"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (s *PrecompileTestSuite) TestGrantEvent() {
+	var (
+		stateDB    *statedb.StateDB
+		ctx        sdk.Context
+		method     = s.precompile.Methods[authz.GrantMethod]
+		expiration = time.Now().Add(time.Hour).Unix()
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"success - the correct event is emitted",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), voteMsgTypeURL, expiration}
+			},
+			func() {
+				log := stateDB.Logs()[0]
+				s.Require().Equal(log.Address, s.precompile.Address())
+
+				// Check event signature matches the one emitted
+				event := s.precompile.Events[authz.EventTypeGrant]
+				s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+				s.Require().Equal(log.BlockNumber, uint64(ctx.BlockHeight())) //nolint:gosec // G115
+
+				// Check the fully unpacked event matches the one emitted
+				var grantEvent authz.EventGrant
+				err := cmn.UnpackLog(s.precompile.ABI, &grantEvent, authz.EventTypeGrant, *log)
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(0), grantEvent.Granter)
+				s.Require().Equal(s.keyring.GetAddr(1), grantEvent.Grantee)
+				s.Require().Equal(voteMsgTypeURL, grantEvent.MsgTypeUrl)
+				s.Require().Equal(expiration, grantEvent.Expiration)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB = s.network.GetStateDB()
+			ctx = s.network.GetContext()
+
+			contract := vm.NewContract(s.keyring.GetAddr(0), s.precompile.Address(), uint256.NewInt(0), tc.gas, nil)
+			ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			initialGas := ctx.GasMeter().GasConsumed()
+			s.Require().Zero(initialGas)
+
+			_, err := s.precompile.Grant(ctx, contract, stateDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevokeEvent() {
+	var (
+		stateDB *statedb.StateDB
+		ctx     sdk.Context
+		method  = s.precompile.Methods[authz.RevokeMethod]
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"success - the correct event is emitted",
+			func() []interface{} {
+				s.saveGrant(ctx, 0, 1, sdkauthz.NewGenericAuthorization(voteMsgTypeURL))
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), voteMsgTypeURL}
+			},
+			func() {
+				log := stateDB.Logs()[0]
+				s.Require().Equal(log.Address, s.precompile.Address())
+
+				// Check event signature matches the one emitted
+				event := s.precompile.Events[authz.EventTypeRevoke]
+				s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+				s.Require().Equal(log.BlockNumber, uint64(ctx.BlockHeight())) //nolint:gosec // G115
+
+				// Check the fully unpacked event matches the one emitted
+				var revokeEvent authz.EventRevoke
+				err := cmn.UnpackLog(s.precompile.ABI, &revokeEvent, authz.EventTypeRevoke, *log)
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(0), revokeEvent.Granter)
+				s.Require().Equal(s.keyring.GetAddr(1), revokeEvent.Grantee)
+				s.Require().Equal(voteMsgTypeURL, revokeEvent.MsgTypeUrl)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB = s.network.GetStateDB()
+			ctx = s.network.GetContext()
+
+			contract := vm.NewContract(s.keyring.GetAddr(0), s.precompile.Address(), uint256.NewInt(0), tc.gas, nil)
+			ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			initialGas := ctx.GasMeter().GasConsumed()
+			s.Require().Zero(initialGas)
+
+			_, err := s.precompile.Revoke(ctx, contract, stateDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestExecEvent() {
+	var (
+		stateDB  *statedb.StateDB
+		ctx      sdk.Context
+		method   = s.precompile.Methods[authz.ExecMethod]
+		receiver = utiltx.GenerateAddress()
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"success - the correct event is emitted",
+			func() []interface{} {
+				s.saveGrant(ctx, 1, 0, banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 100)), nil))
+				return []interface{}{s.keyring.GetAddr(0), [][]byte{s.sendMsgJSON(1, receiver, 10)}}
+			},
+			func() {
+				log := stateDB.Logs()[0]
+				s.Require().Equal(log.Address, s.precompile.Address())
+
+				// Check event signature matches the one emitted
+				event := s.precompile.Events[authz.EventTypeExec]
+				s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+				s.Require().Equal(log.BlockNumber, uint64(ctx.BlockHeight())) //nolint:gosec // G115
+
+				// Check the fully unpacked event matches the one emitted
+				var execEvent authz.EventExec
+				err := cmn.UnpackLog(s.precompile.ABI, &execEvent, authz.EventTypeExec, *log)
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(0), execEvent.Grantee)
+				s.Require().Equal([]string{sendMsgTypeURL}, execEvent.MsgTypeUrls)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB = s.network.GetStateDB()
+			ctx = s.network.GetContext()
+
+			contract := vm.NewContract(s.keyring.GetAddr(0), s.precompile.Address(), uint256.NewInt(0), tc.gas, nil)
+			ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			initialGas := ctx.GasMeter().GasConsumed()
+			s.Require().Zero(initialGas)
+
+			_, err := s.precompile.Exec(ctx, contract, stateDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck()
+			}
+		})
+	}
+}
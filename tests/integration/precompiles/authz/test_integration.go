@@ -0,0 +1,279 @@
+package authz
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/ginkgo/v2"
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/gomega"
+
+	"github.com/cosmos/evm/precompiles/authz"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testutiltx "github.com/cosmos/evm/testutil/tx"
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// General variables used for integration tests
+var (
+	// differentAddr is an address generated for testing purposes that e.g. raises the different origin error
+	differentAddr = testutiltx.GenerateAddress()
+	// callArgs are the default arguments for calling the precompile
+	callArgs testutiltypes.CallArgs
+	// txArgs are the EVM transaction arguments to use in the transactions
+	txArgs evmtypes.EvmTxArgs
+	// defaultLogCheck instantiates a log check arguments struct with the precompile ABI events populated.
+	defaultLogCheck testutil.LogCheckArgs
+	// passCheck defines the arguments to check if the precompile returns no error
+	passCheck testutil.LogCheckArgs
+	// outOfGasCheck defines the arguments to check if the precompile returns out of gas error
+	outOfGasCheck testutil.LogCheckArgs
+)
+
+func TestPrecompileIntegrationTestSuite(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	_ = Describe("Calling authz precompile from EOA", func() {
+		var s *PrecompileTestSuite
+
+		BeforeEach(func() {
+			s = NewPrecompileTestSuite(create, options...)
+			s.SetupTest()
+
+			// set the default call arguments
+			callArgs = testutiltypes.CallArgs{
+				ContractABI: s.precompile.ABI,
+			}
+			defaultLogCheck = testutil.LogCheckArgs{
+				ABIEvents: s.precompile.Events,
+			}
+			passCheck = defaultLogCheck.WithExpPass(true)
+			outOfGasCheck = defaultLogCheck.WithErrContains(vm.ErrOutOfGas.Error())
+
+			// reset tx args each test to avoid keeping custom
+			// values of previous tests (e.g. gasLimit)
+			precompileAddr := s.precompile.Address()
+			txArgs = evmtypes.EvmTxArgs{
+				To: &precompileAddr,
+			}
+			txArgs.GasLimit = 300_000
+		})
+
+		// =====================================
+		// 				TRANSACTIONS
+		// =====================================
+		Describe("Execute Grant transaction", func() {
+			BeforeEach(func() { callArgs.MethodName = authz.GrantMethod })
+
+			It("fails with low gas", func() {
+				txArgs.GasLimit = 30_000
+				callArgs.Args = []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), voteMsgTypeURL, int64(0)}
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, outOfGasCheck)
+				Expect(err).To(BeNil())
+			})
+
+			It("fails if the granter is not the sender", func() {
+				callArgs.Args = []interface{}{differentAddr, s.keyring.GetAddr(1), voteMsgTypeURL, int64(0)}
+				errCheck := defaultLogCheck.WithErrContains(cmn.ErrRequesterIsNotMsgSender, s.keyring.GetAddr(0).String(), differentAddr.String())
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, errCheck)
+				Expect(err).To(BeNil())
+			})
+
+			It("grants a generic authorization and emits event", func() {
+				callArgs.Args = []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), voteMsgTypeURL, int64(0)}
+				eventCheck := passCheck.WithExpEvents(authz.EventTypeGrant)
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, eventCheck)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				authorization, _ := s.network.App.GetAuthzKeeper().GetAuthorization(
+					s.network.GetContext(), s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), voteMsgTypeURL,
+				)
+				Expect(authorization).To(Equal(sdkauthz.NewGenericAuthorization(voteMsgTypeURL)))
+			})
+		})
+
+		Describe("Execute Revoke transaction", func() {
+			BeforeEach(func() {
+				grantArgs := testutiltypes.CallArgs{
+					ContractABI: s.precompile.ABI,
+					MethodName:  authz.GrantMethod,
+					Args:        []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), voteMsgTypeURL, int64(0)},
+				}
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, grantArgs, passCheck.WithExpEvents(authz.EventTypeGrant))
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				callArgs.MethodName = authz.RevokeMethod
+			})
+
+			It("fails if the granter is not the sender", func() {
+				callArgs.Args = []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), voteMsgTypeURL}
+				errCheck := defaultLogCheck.WithErrContains(cmn.ErrRequesterIsNotMsgSender, s.keyring.GetAddr(1).String(), s.keyring.GetAddr(0).String())
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(1), txArgs, callArgs, errCheck)
+				Expect(err).To(BeNil())
+			})
+
+			It("revokes the authorization and emits event", func() {
+				callArgs.Args = []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), voteMsgTypeURL}
+				eventCheck := passCheck.WithExpEvents(authz.EventTypeRevoke)
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, eventCheck)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				authorization, _ := s.network.App.GetAuthzKeeper().GetAuthorization(
+					s.network.GetContext(), s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), voteMsgTypeURL,
+				)
+				Expect(authorization).To(BeNil())
+			})
+		})
+
+		Describe("Execute Exec transaction", func() {
+			var receiver common.Address
+
+			BeforeEach(func() {
+				receiver = testutiltx.GenerateAddress()
+
+				// the account 1 allows the account 0 to send up to 100 tokens on its behalf
+				grantArgs := testutiltypes.CallArgs{
+					ContractABI: s.precompile.ABI,
+					MethodName:  authz.GrantSendMethod,
+					Args: []interface{}{
+						s.keyring.GetAddr(1),
+						s.keyring.GetAddr(0),
+						[]cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(100)}},
+						[]common.Address{},
+						int64(0),
+					},
+				}
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(1), txArgs, grantArgs, passCheck.WithExpEvents(authz.EventTypeGrant))
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				callArgs.MethodName = authz.ExecMethod
+			})
+
+			It("fails if the grantee is not the sender", func() {
+				callArgs.Args = []interface{}{s.keyring.GetAddr(0), [][]byte{s.sendMsgJSON(1, receiver, 10)}}
+				errCheck := defaultLogCheck.WithErrContains(cmn.ErrRequesterIsNotMsgSender, s.keyring.GetAddr(2).String(), s.keyring.GetAddr(0).String())
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(2), txArgs, callArgs, errCheck)
+				Expect(err).To(BeNil())
+			})
+
+			It("fails above the spend limit", func() {
+				callArgs.Args = []interface{}{s.keyring.GetAddr(0), [][]byte{s.sendMsgJSON(1, receiver, 101)}}
+				errCheck := defaultLogCheck.WithErrContains("requested amount is more than spend limit")
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, errCheck)
+				Expect(err).To(BeNil())
+			})
+
+			It("executes the send on behalf of the granter and emits event", func() {
+				granterBalanceBefore := s.network.App.GetBankKeeper().GetBalance(s.network.GetContext(), s.keyring.GetAccAddr(1), s.network.GetBaseDenom())
+
+				callArgs.Args = []interface{}{s.keyring.GetAddr(0), [][]byte{s.sendMsgJSON(1, receiver, 10)}}
+				eventCheck := passCheck.WithExpEvents(authz.EventTypeExec)
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, eventCheck)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				ctx := s.network.GetContext()
+				receiverBalance := s.network.App.GetBankKeeper().GetBalance(ctx, receiver.Bytes(), s.network.GetBaseDenom())
+				Expect(receiverBalance.Amount).To(Equal(math.NewInt(10)))
+
+				granterBalanceAfter := s.network.App.GetBankKeeper().GetBalance(ctx, s.keyring.GetAccAddr(1), s.network.GetBaseDenom())
+				Expect(granterBalanceAfter.Amount).To(Equal(granterBalanceBefore.Amount.SubRaw(10)))
+
+				authorization, _ := s.network.App.GetAuthzKeeper().GetAuthorization(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), sendMsgTypeURL)
+				sendAuthorization, ok := authorization.(*banktypes.SendAuthorization)
+				Expect(ok).To(BeTrue())
+				Expect(sendAuthorization.SpendLimit.AmountOf(s.network.GetBaseDenom())).To(Equal(math.NewInt(90)))
+			})
+		})
+
+		// =====================================
+		// 				QUERIES
+		// =====================================
+		Describe("Execute queries", func() {
+			BeforeEach(func() {
+				for _, grantee := range []common.Address{s.keyring.GetAddr(1), s.keyring.GetAddr(2)} {
+					grantArgs := testutiltypes.CallArgs{
+						ContractABI: s.precompile.ABI,
+						MethodName:  authz.GrantMethod,
+						Args:        []interface{}{s.keyring.GetAddr(0), grantee, voteMsgTypeURL, int64(0)},
+					}
+					_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, grantArgs, passCheck.WithExpEvents(authz.EventTypeGrant))
+					Expect(err).To(BeNil())
+					Expect(s.network.NextBlock()).To(BeNil())
+				}
+			})
+
+			It("should return the grants of a granter to a grantee", func() {
+				callArgs.MethodName = authz.GetGrantsMethod
+				callArgs.Args = []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), "", query.PageRequest{}}
+
+				_, ethRes, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, passCheck)
+				Expect(err).To(BeNil())
+
+				var out authz.GrantsOutput
+				err = s.precompile.UnpackIntoInterface(&out, authz.GetGrantsMethod, ethRes.Ret)
+				Expect(err).To(BeNil())
+				Expect(out.Grants).To(HaveLen(1))
+				Expect(out.Grants[0].Granter).To(Equal(s.keyring.GetAddr(0)))
+				Expect(out.Grants[0].Grantee).To(Equal(s.keyring.GetAddr(1)))
+				Expect(out.Grants[0].MsgTypeUrl).To(Equal(voteMsgTypeURL))
+			})
+
+			It("should return the grants of a granter", func() {
+				callArgs.MethodName = authz.GetGranterGrantsMethod
+				callArgs.Args = []interface{}{s.keyring.GetAddr(0), query.PageRequest{CountTotal: true}}
+
+				_, ethRes, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, passCheck)
+				Expect(err).To(BeNil())
+
+				var out authz.GrantsOutput
+				err = s.precompile.UnpackIntoInterface(&out, authz.GetGranterGrantsMethod, ethRes.Ret)
+				Expect(err).To(BeNil())
+				Expect(out.Grants).To(HaveLen(2))
+				Expect(out.PageResponse.Total).To(Equal(uint64(2)))
+			})
+
+			It("should return the grants of a grantee", func() {
+				callArgs.MethodName = authz.GetGranteeGrantsMethod
+				callArgs.Args = []interface{}{s.keyring.GetAddr(2), query.PageRequest{CountTotal: true}}
+
+				_, ethRes, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, passCheck)
+				Expect(err).To(BeNil())
+
+				var out authz.GrantsOutput
+				err = s.precompile.UnpackIntoInterface(&out, authz.GetGranteeGrantsMethod, ethRes.Ret)
+				Expect(err).To(BeNil())
+				Expect(out.Grants).To(HaveLen(1))
+				Expect(out.Grants[0].Granter).To(Equal(s.keyring.GetAddr(0)))
+				Expect(out.Grants[0].Grantee).To(Equal(s.keyring.GetAddr(2)))
+			})
+		})
+	})
+
+	// Run Ginkgo integration tests
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Authz Precompile Suite")
+}
@@ -0,0 +1,281 @@
+package authz
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/cosmos/evm/precompiles/authz"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (s *PrecompileTestSuite) TestGetGrants() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[authz.GetGrantsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(out *authz.GrantsOutput)
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(_ *authz.GrantsOutput) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - invalid granter address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, s.keyring.GetAddr(1), "", query.PageRequest{}}
+			},
+			func(_ *authz.GrantsOutput) {},
+			200000,
+			true,
+			"invalid granter address",
+		},
+		{
+			"fail - grant for msg type url not found",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), voteMsgTypeURL, query.PageRequest{}}
+			},
+			func(_ *authz.GrantsOutput) {},
+			200000,
+			true,
+			"authorization not found",
+		},
+		{
+			"success - no grants",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), "", query.PageRequest{}}
+			},
+			func(out *authz.GrantsOutput) {
+				s.Require().Empty(out.Grants)
+			},
+			200000,
+			false,
+			"",
+		},
+		{
+			"success - grant filtered by msg type url",
+			func() []interface{} {
+				s.saveGrant(ctx, 0, 1, sdkauthz.NewGenericAuthorization(voteMsgTypeURL))
+				s.saveGrant(ctx, 0, 1, banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 100)), nil))
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), voteMsgTypeURL, query.PageRequest{}}
+			},
+			func(out *authz.GrantsOutput) {
+				s.Require().Len(out.Grants, 1)
+				s.Require().Equal(s.keyring.GetAddr(0), out.Grants[0].Granter)
+				s.Require().Equal(s.keyring.GetAddr(1), out.Grants[0].Grantee)
+				s.Require().Equal(sdk.MsgTypeURL(&sdkauthz.GenericAuthorization{}), out.Grants[0].AuthorizationType)
+				s.Require().Equal(voteMsgTypeURL, out.Grants[0].MsgTypeUrl)
+				s.Require().Contains(out.Grants[0].Authorization, voteMsgTypeURL)
+				s.Require().Zero(out.Grants[0].Expiration)
+			},
+			200000,
+			false,
+			"",
+		},
+		{
+			"success - all grants with pagination",
+			func() []interface{} {
+				s.saveGrant(ctx, 0, 1, sdkauthz.NewGenericAuthorization(voteMsgTypeURL))
+				s.saveGrant(ctx, 0, 1, banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 100)), nil))
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), "", query.PageRequest{Limit: 1, CountTotal: true}}
+			},
+			func(out *authz.GrantsOutput) {
+				s.Require().Len(out.Grants, 1)
+				s.Require().Equal(uint64(2), out.PageResponse.Total)
+				s.Require().NotEmpty(out.PageResponse.NextKey)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), tc.gas)
+
+			bz, err := s.precompile.GetGrants(ctx, &method, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				var out authz.GrantsOutput
+				err = s.precompile.UnpackIntoInterface(&out, authz.GetGrantsMethod, bz)
+				s.Require().NoError(err)
+				tc.postCheck(&out)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGetGranterGrants() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[authz.GetGranterGrantsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(out *authz.GrantsOutput)
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(_ *authz.GrantsOutput) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid granter address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, query.PageRequest{}}
+			},
+			func(_ *authz.GrantsOutput) {},
+			200000,
+			true,
+			"invalid granter address",
+		},
+		{
+			"success - grants to several grantees",
+			func() []interface{} {
+				s.saveGrant(ctx, 0, 1, sdkauthz.NewGenericAuthorization(voteMsgTypeURL))
+				s.saveGrant(ctx, 0, 2, sdkauthz.NewGenericAuthorization(voteMsgTypeURL))
+				s.saveGrant(ctx, 1, 2, sdkauthz.NewGenericAuthorization(voteMsgTypeURL))
+				return []interface{}{s.keyring.GetAddr(0), query.PageRequest{CountTotal: true}}
+			},
+			func(out *authz.GrantsOutput) {
+				s.Require().Len(out.Grants, 2)
+				s.Require().Equal(uint64(2), out.PageResponse.Total)
+				for _, grant := range out.Grants {
+					s.Require().Equal(s.keyring.GetAddr(0), grant.Granter)
+				}
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), tc.gas)
+
+			bz, err := s.precompile.GetGranterGrants(ctx, &method, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				var out authz.GrantsOutput
+				err = s.precompile.UnpackIntoInterface(&out, authz.GetGranterGrantsMethod, bz)
+				s.Require().NoError(err)
+				tc.postCheck(&out)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGetGranteeGrants() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[authz.GetGranteeGrantsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(out *authz.GrantsOutput)
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(_ *authz.GrantsOutput) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, query.PageRequest{}}
+			},
+			func(_ *authz.GrantsOutput) {},
+			200000,
+			true,
+			"invalid grantee address",
+		},
+		{
+			"success - grants from several granters",
+			func() []interface{} {
+				s.saveGrant(ctx, 0, 2, sdkauthz.NewGenericAuthorization(voteMsgTypeURL))
+				s.saveGrant(ctx, 1, 2, sdkauthz.NewGenericAuthorization(voteMsgTypeURL))
+				s.saveGrant(ctx, 1, 0, sdkauthz.NewGenericAuthorization(voteMsgTypeURL))
+				return []interface{}{s.keyring.GetAddr(2), query.PageRequest{CountTotal: true}}
+			},
+			func(out *authz.GrantsOutput) {
+				s.Require().Len(out.Grants, 2)
+				s.Require().Equal(uint64(2), out.PageResponse.Total)
+				for _, grant := range out.Grants {
+					s.Require().Equal(s.keyring.GetAddr(2), grant.Grantee)
+				}
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), tc.gas)
+
+			bz, err := s.precompile.GetGranteeGrants(ctx, &method, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				var out authz.GrantsOutput
+				err = s.precompile.UnpackIntoInterface(&out, authz.GetGranteeGrantsMethod, bz)
+				s.Require().NoError(err)
+				tc.postCheck(&out)
+			}
+		})
+	}
+}
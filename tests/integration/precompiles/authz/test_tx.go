@@ -0,0 +1,577 @@
+package authz
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/cosmos/evm/precompiles/authz"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	sendMsgTypeURL     = sdk.MsgTypeURL(&banktypes.MsgSend{})
+	voteMsgTypeURL     = sdk.MsgTypeURL(&govv1.MsgVote{})
+	delegateMsgTypeURL = sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+)
+
+func (s *PrecompileTestSuite) TestGrant() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[authz.GrantMethod]
+	expiration := time.Now().Add(time.Hour).Unix()
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - invalid granter address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, s.keyring.GetAddr(1), voteMsgTypeURL, expiration}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid granter address",
+		},
+		{
+			"fail - granter is not the msg.sender",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), s.keyring.GetAddr(2), voteMsgTypeURL, expiration}
+			},
+			func() {},
+			200000,
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - granter is the grantee",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(0), voteMsgTypeURL, expiration}
+			},
+			func() {},
+			200000,
+			true,
+			sdkauthz.ErrGranteeIsGranter.Error(),
+		},
+		{
+			"fail - unknown msg type url",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), "/cosmos.unknown.MsgUnknown", expiration}
+			},
+			func() {},
+			200000,
+			true,
+			"doesn't exist",
+		},
+		{
+			"fail - disabled msg type url",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}), expiration}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(authz.ErrDisabledMsgType, sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})),
+		},
+		{
+			"fail - expiration in the past",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), voteMsgTypeURL, int64(1)}
+			},
+			func() {},
+			200000,
+			true,
+			"expiration must be after the current block time",
+		},
+		{
+			"success - generic authorization granted",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), voteMsgTypeURL, expiration}
+			},
+			func() {
+				authorization, exp := s.network.App.GetAuthzKeeper().GetAuthorization(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), voteMsgTypeURL)
+				s.Require().NotNil(authorization)
+				s.Require().Equal(sdkauthz.NewGenericAuthorization(voteMsgTypeURL), authorization)
+				s.Require().NotNil(exp)
+				s.Require().Equal(expiration, exp.Unix())
+			},
+			200000,
+			false,
+			"",
+		},
+		{
+			"success - generic authorization granted to a new account without expiration",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), common.Address{1}, voteMsgTypeURL, int64(0)}
+			},
+			func() {
+				authorization, exp := s.network.App.GetAuthzKeeper().GetAuthorization(ctx, common.Address{1}.Bytes(), s.keyring.GetAccAddr(0), voteMsgTypeURL)
+				s.Require().NotNil(authorization)
+				s.Require().Nil(exp)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), tc.gas)
+
+			res, err := s.precompile.Grant(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrantSend() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[authz.GrantSendMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 5, 0),
+		},
+		{
+			"fail - empty spend limit",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), []cmn.Coin{}, []common.Address{}, int64(0)}
+			},
+			func() {},
+			200000,
+			true,
+			"spend limit",
+		},
+		{
+			"fail - granter is not the msg.sender",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(1), s.keyring.GetAddr(2),
+					[]cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(100)}},
+					[]common.Address{}, int64(0),
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"does not match the requester address",
+		},
+		{
+			"success - send authorization granted",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1),
+					[]cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(100)}},
+					[]common.Address{s.keyring.GetAddr(2)}, int64(0),
+				}
+			},
+			func() {
+				authorization, _ := s.network.App.GetAuthzKeeper().GetAuthorization(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), sendMsgTypeURL)
+				s.Require().NotNil(authorization)
+
+				sendAuthorization, ok := authorization.(*banktypes.SendAuthorization)
+				s.Require().True(ok)
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 100)), sendAuthorization.SpendLimit)
+				s.Require().Equal([]string{s.keyring.GetAccAddr(2).String()}, sendAuthorization.AllowList)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), tc.gas)
+
+			res, err := s.precompile.GrantSend(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrantStake() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[authz.GrantStakeMethod]
+	delegate := uint8(stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE)
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 7, 0),
+		},
+		{
+			"fail - both allow and deny lists",
+			func() []interface{} {
+				validator := s.network.GetValidators()[0].OperatorAddress
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), delegate, []string{validator}, []string{validator}, big.NewInt(0), int64(0)}
+			},
+			func() {},
+			200000,
+			true,
+			"cannot set both allowed & deny list",
+		},
+		{
+			"fail - granter is not the msg.sender",
+			func() []interface{} {
+				validator := s.network.GetValidators()[0].OperatorAddress
+				return []interface{}{s.keyring.GetAddr(1), s.keyring.GetAddr(2), delegate, []string{validator}, []string{}, big.NewInt(0), int64(0)}
+			},
+			func() {},
+			200000,
+			true,
+			"does not match the requester address",
+		},
+		{
+			"success - stake authorization granted",
+			func() []interface{} {
+				validator := s.network.GetValidators()[0].OperatorAddress
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), delegate, []string{validator}, []string{}, big.NewInt(1e18), int64(0)}
+			},
+			func() {
+				authorization, _ := s.network.App.GetAuthzKeeper().GetAuthorization(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), delegateMsgTypeURL)
+				s.Require().NotNil(authorization)
+
+				stakeAuthorization, ok := authorization.(*stakingtypes.StakeAuthorization)
+				s.Require().True(ok)
+				bondDenom, err := s.network.App.GetStakingKeeper().BondDenom(ctx)
+				s.Require().NoError(err)
+				s.Require().Equal(sdk.NewCoin(bondDenom, math.NewInt(1e18)), *stakeAuthorization.MaxTokens)
+				s.Require().Equal([]string{s.network.GetValidators()[0].OperatorAddress}, stakeAuthorization.GetAllowList().Address)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), tc.gas)
+
+			res, err := s.precompile.GrantStake(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevoke() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[authz.RevokeMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - granter is not the msg.sender",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), s.keyring.GetAddr(2), voteMsgTypeURL}
+			},
+			func() {},
+			200000,
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - grant not found",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), voteMsgTypeURL}
+			},
+			func() {},
+			200000,
+			true,
+			sdkauthz.ErrNoAuthorizationFound.Error(),
+		},
+		{
+			"success - authorization revoked",
+			func() []interface{} {
+				s.saveGrant(ctx, 0, 1, sdkauthz.NewGenericAuthorization(voteMsgTypeURL))
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), voteMsgTypeURL}
+			},
+			func() {
+				authorization, _ := s.network.App.GetAuthzKeeper().GetAuthorization(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), voteMsgTypeURL)
+				s.Require().Nil(authorization)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), tc.gas)
+
+			res, err := s.precompile.Revoke(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestExec() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[authz.ExecMethod]
+	receiver := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - empty messages",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), [][]byte{}}
+			},
+			func() {},
+			200000,
+			true,
+			"messages cannot be empty",
+		},
+		{
+			"fail - invalid message JSON",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), [][]byte{[]byte("{invalid}")}}
+			},
+			func() {},
+			200000,
+			true,
+			"message 0",
+		},
+		{
+			"fail - grantee is not the msg.sender",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), [][]byte{s.sendMsgJSON(2, receiver, 10)}}
+			},
+			func() {},
+			200000,
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - message signed by the grantee",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), [][]byte{s.sendMsgJSON(0, receiver, 10)}}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(authz.ErrSelfExec, 0),
+		},
+		{
+			"fail - IBC transfer cannot be executed",
+			func() []interface{} {
+				s.saveGrant(ctx, 1, 0, sdkauthz.NewGenericAuthorization(sdk.MsgTypeURL(&transfertypes.MsgTransfer{})))
+				return []interface{}{s.keyring.GetAddr(0), [][]byte{s.transferMsgJSON(1, receiver, 10)}}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(authz.ErrExecNotAllowedMsgType, sdk.MsgTypeURL(&transfertypes.MsgTransfer{})),
+		},
+		{
+			"fail - nested exec of an IBC transfer cannot be executed",
+			func() []interface{} {
+				s.saveGrant(ctx, 1, 0, sdkauthz.NewGenericAuthorization(sdk.MsgTypeURL(&sdkauthz.MsgExec{})))
+				s.saveGrant(ctx, 2, 1, sdkauthz.NewGenericAuthorization(sdk.MsgTypeURL(&transfertypes.MsgTransfer{})))
+				nested := s.nestedExecMsgJSON(1, s.transferMsgJSON(2, receiver, 10))
+				return []interface{}{s.keyring.GetAddr(0), [][]byte{nested}}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(authz.ErrExecNotAllowedMsgType, sdk.MsgTypeURL(&sdkauthz.MsgExec{})),
+		},
+		{
+			"fail - no authorization",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), [][]byte{s.sendMsgJSON(1, receiver, 10)}}
+			},
+			func() {},
+			200000,
+			true,
+			sdkauthz.ErrNoAuthorizationFound.Error(),
+		},
+		{
+			"fail - amount above the spend limit",
+			func() []interface{} {
+				s.saveGrant(ctx, 1, 0, banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 5)), nil))
+				return []interface{}{s.keyring.GetAddr(0), [][]byte{s.sendMsgJSON(1, receiver, 10)}}
+			},
+			func() {},
+			200000,
+			true,
+			"requested amount is more than spend limit",
+		},
+		{
+			"success - send executed on behalf of the granter",
+			func() []interface{} {
+				s.saveGrant(ctx, 1, 0, banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 100)), nil))
+				return []interface{}{s.keyring.GetAddr(0), [][]byte{s.sendMsgJSON(1, receiver, 10)}}
+			},
+			func() {
+				balance := s.network.App.GetBankKeeper().GetBalance(ctx, receiver.Bytes(), s.network.GetBaseDenom())
+				s.Require().Equal(math.NewInt(10), balance.Amount)
+
+				// the spend limit of the grant is updated
+				authorization, _ := s.network.App.GetAuthzKeeper().GetAuthorization(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), sendMsgTypeURL)
+				s.Require().NotNil(authorization)
+				sendAuthorization, ok := authorization.(*banktypes.SendAuthorization)
+				s.Require().True(ok)
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 90)), sendAuthorization.SpendLimit)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), tc.gas)
+
+			res, err := s.precompile.Exec(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				var results [][]byte
+				err = s.precompile.UnpackIntoInterface(&results, authz.ExecMethod, res)
+				s.Require().NoError(err)
+				s.Require().Len(results, 1)
+				tc.postCheck()
+			}
+		})
+	}
+}
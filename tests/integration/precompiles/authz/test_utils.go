@@ -0,0 +1,80 @@
+package authz
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// saveGrant is a helper function to store an authorization from the granter
+// to the grantee of the given keyring indexes, without expiration.
+func (s *PrecompileTestSuite) saveGrant(ctx sdk.Context, granterIdx, granteeIdx int, authorization sdkauthz.Authorization) {
+	err := s.network.App.GetAuthzKeeper().SaveGrant(
+		ctx,
+		s.keyring.GetAccAddr(granteeIdx),
+		s.keyring.GetAccAddr(granterIdx),
+		authorization,
+		nil,
+	)
+	s.Require().NoError(err)
+}
+
+// sendMsgJSON is a helper function to return the JSON encoding of a bank MsgSend
+// from the account of the given keyring index, as expected by the exec method.
+func (s *PrecompileTestSuite) sendMsgJSON(fromIdx int, to common.Address, amount int64) []byte {
+	msg := banktypes.NewMsgSend(
+		s.keyring.GetAccAddr(fromIdx),
+		to.Bytes(),
+		sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), amount)),
+	)
+	bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// transferMsgJSON is a helper function to return the JSON encoding of an IBC
+// MsgTransfer from the account of the given keyring index.
+func (s *PrecompileTestSuite) transferMsgJSON(fromIdx int, to common.Address, amount int64) []byte {
+	msg := &transfertypes.MsgTransfer{
+		SourcePort:       transfertypes.PortID,
+		SourceChannel:    "channel-0",
+		Token:            sdk.NewInt64Coin(s.network.GetBaseDenom(), amount),
+		Sender:           s.keyring.GetAccAddr(fromIdx).String(),
+		Receiver:         sdk.AccAddress(to.Bytes()).String(),
+		TimeoutTimestamp: 1,
+	}
+	bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// nestedExecMsgJSON is a helper function to return the JSON encoding of an authz
+// MsgExec from the grantee of the given keyring index, wrapping the given message.
+func (s *PrecompileTestSuite) nestedExecMsgJSON(granteeIdx int, msgJSON []byte) []byte {
+	var msg sdk.Msg
+	if err := s.network.App.AppCodec().UnmarshalInterfaceJSON(msgJSON, &msg); err != nil {
+		panic(err)
+	}
+	anyMsg, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		panic(err)
+	}
+	exec := &sdkauthz.MsgExec{
+		Grantee: s.keyring.GetAccAddr(granteeIdx).String(),
+		Msgs:    []*codectypes.Any{anyMsg},
+	}
+	bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(exec)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
@@ -0,0 +1,358 @@
+package erc20
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/precompiles/erc20"
+	"github.com/cosmos/evm/precompiles/testutil"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// exampleTokenName is the name registered in the bank metadata of the example token,
+// which is part of the EIP-712 domain of the permit signatures.
+const exampleTokenName = "Example Token"
+
+func (s *PrecompileTestSuite) TestPermit() {
+	method := s.precompile.Methods[erc20.PermitMethod]
+	amount := big.NewInt(100)
+
+	var ctx sdk.Context
+
+	// permitArgs returns the arguments of a permit of the given value from the
+	// owner (key 0) to the spender (key 1), signed by the key at signerIdx.
+	permitArgs := func(signerIdx int, value, nonce, deadline *big.Int) []interface{} {
+		v, r, sig := s.signPermit(ctx, signerIdx, s.keyring.GetAddr(0), s.keyring.GetAddr(1), value, nonce, deadline)
+		return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), value, deadline, v, r, sig}
+	}
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expPass     bool
+		errContains string
+	}{
+		{
+			name:        "fail - empty args",
+			malleate:    func() []interface{} { return nil },
+			errContains: "invalid number of arguments",
+		},
+		{
+			name: "fail - invalid signature v type",
+			malleate: func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), amount, big.NewInt(ctx.BlockTime().Unix()), "27", [32]byte{}, [32]byte{},
+				}
+			},
+			errContains: "invalid signature v",
+		},
+		{
+			name: "fail - expired deadline",
+			malleate: func() []interface{} {
+				return permitArgs(0, amount, common.Big0, big.NewInt(ctx.BlockTime().Unix()-1))
+			},
+			errContains: erc20.ErrPermitExpiredDeadline.Error(),
+		},
+		{
+			name: "fail - signed by another account",
+			malleate: func() []interface{} {
+				return permitArgs(1, amount, common.Big0, big.NewInt(ctx.BlockTime().Unix()+3600))
+			},
+			errContains: erc20.ErrPermitInvalidSignature.Error(),
+		},
+		{
+			name: "fail - signed with a wrong nonce",
+			malleate: func() []interface{} {
+				return permitArgs(0, amount, common.Big1, big.NewInt(ctx.BlockTime().Unix()+3600))
+			},
+			errContains: erc20.ErrPermitInvalidSignature.Error(),
+		},
+		{
+			name: "fail - signed value differs",
+			malleate: func() []interface{} {
+				args := permitArgs(0, amount, common.Big0, big.NewInt(ctx.BlockTime().Unix()+3600))
+				args[2] = big.NewInt(1000)
+				return args
+			},
+			errContains: erc20.ErrPermitInvalidSignature.Error(),
+		},
+		{
+			name: "fail - invalid v value",
+			malleate: func() []interface{} {
+				args := permitArgs(0, amount, common.Big0, big.NewInt(ctx.BlockTime().Unix()+3600))
+				args[4] = uint8(1)
+				return args
+			},
+			errContains: erc20.ErrPermitInvalidSignature.Error(),
+		},
+		{
+			name: "fail - malleable signature with high s value",
+			malleate: func() []interface{} {
+				args := permitArgs(0, amount, common.Big0, big.NewInt(ctx.BlockTime().Unix()+3600))
+				// (r, n - s, v ^ 1) is also a valid signature of the digest
+				sig := args[6].([32]byte)
+				highS := new(big.Int).Sub(crypto.S256().Params().N, new(big.Int).SetBytes(sig[:]))
+				args[6] = [32]byte(common.BigToHash(highS))
+				args[4] = args[4].(uint8) ^ 1
+				return args
+			},
+			errContains: erc20.ErrPermitInvalidSignature.Error(),
+		},
+		{
+			name: "pass - permit without existing allowance",
+			malleate: func() []interface{} {
+				return permitArgs(0, amount, common.Big0, big.NewInt(ctx.BlockTime().Unix()+3600))
+			},
+			expPass: true,
+			postCheck: func() {
+				s.requireAllowance(s.precompile.Address(), s.keyring.GetAddr(0), s.keyring.GetAddr(1), amount)
+				s.Require().Equal(uint64(1), s.network.App.GetErc20Keeper().GetPermitNonce(ctx, s.precompile.Address(), s.keyring.GetAddr(0)))
+				// the nonce of the other token is not affected
+				s.Require().Equal(uint64(0), s.network.App.GetErc20Keeper().GetPermitNonce(ctx, s.precompile2.Address(), s.keyring.GetAddr(0)))
+			},
+		},
+		{
+			name: "pass - permit of zero deletes an existing allowance",
+			malleate: func() []interface{} {
+				err := s.network.App.GetErc20Keeper().SetAllowance(ctx, s.precompile.Address(), s.keyring.GetAddr(0), s.keyring.GetAddr(1), amount)
+				s.Require().NoError(err)
+				return permitArgs(0, common.Big0, common.Big0, big.NewInt(ctx.BlockTime().Unix()))
+			},
+			expPass: true,
+			postCheck: func() {
+				s.requireAllowance(s.precompile.Address(), s.keyring.GetAddr(0), s.keyring.GetAddr(1), common.Big0)
+			},
+		},
+		{
+			name: "pass - permit with the next nonce",
+			malleate: func() []interface{} {
+				s.network.App.GetErc20Keeper().IncrementPermitNonce(ctx, s.precompile.Address(), s.keyring.GetAddr(0))
+				return permitArgs(0, amount, common.Big1, big.NewInt(ctx.BlockTime().Unix()+3600))
+			},
+			expPass: true,
+			postCheck: func() {
+				s.requireAllowance(s.precompile.Address(), s.keyring.GetAddr(0), s.keyring.GetAddr(1), amount)
+				s.Require().Equal(uint64(2), s.network.App.GetErc20Keeper().GetPermitNonce(ctx, s.precompile.Address(), s.keyring.GetAddr(0)))
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.setExampleTokenMetadata()
+
+			ctx = s.network.GetContext()
+
+			// the permit can be submitted by anyone
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(
+				s.T(),
+				ctx,
+				s.keyring.GetAddr(1),
+				s.precompile.Address(),
+				200_000,
+			)
+
+			stateDB := s.network.GetStateDB()
+			bz, err := s.precompile.Permit(
+				ctx,
+				contract,
+				stateDB,
+				&method,
+				tc.malleate(),
+			)
+
+			if tc.expPass {
+				s.Require().NoError(err, "expected no error")
+				s.Require().Empty(bz, "expected no return value")
+
+				logs := stateDB.Logs()
+				s.Require().Len(logs, 1, "expected an Approval event")
+				s.Require().Equal(s.precompile.Events[erc20.EventTypeApproval].ID, logs[0].Topics[0])
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+
+			if tc.postCheck != nil {
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestPermitReplay() {
+	s.SetupTest()
+	s.setExampleTokenMetadata()
+
+	method := s.precompile.Methods[erc20.PermitMethod]
+	ctx := s.network.GetContext()
+	deadline := big.NewInt(ctx.BlockTime().Unix() + 3600)
+
+	v, r, sig := s.signPermit(ctx, 0, s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(100), common.Big0, deadline)
+	args := []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(100), deadline, v, r, sig}
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(1), s.precompile.Address(), 200_000)
+	_, err := s.precompile.Permit(ctx, contract, s.network.GetStateDB(), &method, args)
+	s.Require().NoError(err)
+
+	// the allowance is spent and the signature is submitted again
+	err = s.network.App.GetErc20Keeper().DeleteAllowance(ctx, s.precompile.Address(), s.keyring.GetAddr(0), s.keyring.GetAddr(1))
+	s.Require().NoError(err)
+
+	_, err = s.precompile.Permit(ctx, contract, s.network.GetStateDB(), &method, args)
+	s.Require().ErrorContains(err, erc20.ErrPermitInvalidSignature.Error())
+	s.requireAllowance(s.precompile.Address(), s.keyring.GetAddr(0), s.keyring.GetAddr(1), common.Big0)
+}
+
+func (s *PrecompileTestSuite) TestNonces() {
+	s.SetupTest()
+
+	method := s.precompile.Methods[erc20.NoncesMethod]
+	ctx := s.network.GetContext()
+
+	bz, err := s.precompile.Nonces(ctx, nil, s.network.GetStateDB(), &method, []interface{}{s.keyring.GetAddr(0)})
+	s.requireOut(bz, err, method, true, "", common.Big0)
+
+	s.network.App.GetErc20Keeper().IncrementPermitNonce(ctx, s.precompile.Address(), s.keyring.GetAddr(0))
+	s.network.App.GetErc20Keeper().IncrementPermitNonce(ctx, s.precompile.Address(), s.keyring.GetAddr(0))
+
+	bz, err = s.precompile.Nonces(ctx, nil, s.network.GetStateDB(), &method, []interface{}{s.keyring.GetAddr(0)})
+	s.requireOut(bz, err, method, true, "", big.NewInt(2))
+
+	bz, err = s.precompile.Nonces(ctx, nil, s.network.GetStateDB(), &method, []interface{}{s.keyring.GetAddr(1)})
+	s.requireOut(bz, err, method, true, "", common.Big0)
+
+	_, err = s.precompile.Nonces(ctx, nil, s.network.GetStateDB(), &method, []interface{}{"invalid address"})
+	s.requireOut(nil, err, method, false, "invalid owner address", nil)
+}
+
+func (s *PrecompileTestSuite) TestDomainSeparator() {
+	s.SetupTest()
+
+	method := s.precompile.Methods[erc20.DomainSeparatorMethod]
+	ctx := s.network.GetContext()
+
+	// the domain separator requires the token name
+	_, err := s.precompile.DomainSeparator(ctx, nil, s.network.GetStateDB(), &method, nil)
+	s.requireOut(nil, err, method, false, vm.ErrExecutionReverted.Error(), nil)
+
+	s.setExampleTokenMetadata()
+
+	bz, err := s.precompile.DomainSeparator(ctx, nil, s.network.GetStateDB(), &method, nil)
+	expDomainSeparator := erc20.ComputeDomainSeparator(exampleTokenName, evmtypes.GetEthChainConfig().ChainID, s.precompile.Address())
+	s.requireOut(bz, err, method, true, "", [32]byte(expDomainSeparator))
+
+	// each token has its own domain
+	s.Require().NotEqual(
+		expDomainSeparator,
+		erc20.ComputeDomainSeparator(exampleTokenName, evmtypes.GetEthChainConfig().ChainID, s.precompile2.Address()),
+	)
+}
+
+func (s *PrecompileTestSuite) TestParsePermitArgs() {
+	deadline := big.NewInt(1_700_000_000)
+	r := [32]byte{1}
+	sig := [32]byte{2}
+
+	testcases := []struct {
+		name        string
+		args        []interface{}
+		expPass     bool
+		errContains string
+	}{
+		{
+			name:    "pass - correct arguments",
+			args:    []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(100), deadline, uint8(27), r, sig},
+			expPass: true,
+		},
+		{
+			name:        "fail - invalid number of arguments",
+			args:        []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)},
+			errContains: "invalid number of arguments; expected 7; got: 2",
+		},
+		{
+			name:        "fail - invalid spender address",
+			args:        []interface{}{s.keyring.GetAddr(0), "spender", big.NewInt(100), deadline, uint8(27), r, sig},
+			errContains: "invalid spender address",
+		},
+		{
+			name:        "fail - invalid deadline",
+			args:        []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(100), int64(1), uint8(27), r, sig},
+			errContains: "invalid deadline",
+		},
+		{
+			name:        "fail - invalid signature s",
+			args:        []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(100), deadline, uint8(27), r, sig[:]},
+			errContains: "invalid signature s",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			owner, spender, value, deadlineOut, v, rOut, sOut, err := erc20.ParsePermitArgs(tc.args)
+			if tc.expPass {
+				s.Require().NoError(err, "unexpected error parsing the permit arguments")
+				s.Require().Equal(s.keyring.GetAddr(0), owner, "expected different owner")
+				s.Require().Equal(s.keyring.GetAddr(1), spender, "expected different spender")
+				s.Require().Equal(big.NewInt(100), value, "expected different value")
+				s.Require().Equal(deadline, deadlineOut, "expected different deadline")
+				s.Require().Equal(uint8(27), v, "expected different v")
+				s.Require().Equal(r, rOut, "expected different r")
+				s.Require().Equal(sig, sOut, "expected different s")
+			} else {
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
+
+// setExampleTokenMetadata registers the bank metadata of the token of the
+// precompile, so that it has a name to build the EIP-712 domain.
+func (s *PrecompileTestSuite) setExampleTokenMetadata() {
+	s.network.App.GetBankKeeper().SetDenomMetaData(s.network.GetContext(), banktypes.Metadata{
+		Description: "An exemplary token",
+		Base:        s.tokenDenom,
+		Display:     s.tokenDenom,
+		Name:        exampleTokenName,
+		Symbol:      "XMPL",
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: s.tokenDenom, Exponent: 0}},
+	})
+}
+
+// signPermit signs a permit of the token of the precompile with the key at the
+// given keyring index and returns the signature values.
+func (s *PrecompileTestSuite) signPermit(
+	ctx sdk.Context,
+	signerIdx int,
+	owner, spender common.Address,
+	value, nonce, deadline *big.Int,
+) (uint8, [32]byte, [32]byte) {
+	method := s.precompile.Methods[erc20.DomainSeparatorMethod]
+	bz, err := s.precompile.DomainSeparator(ctx, nil, s.network.GetStateDB(), &method, nil)
+	s.Require().NoError(err, "failed to get the domain separator")
+
+	digest := erc20.ComputePermitDigest(common.BytesToHash(bz), owner, spender, value, nonce, deadline)
+
+	privKey, ok := s.keyring.GetPrivKey(signerIdx).(*ethsecp256k1.PrivKey)
+	s.Require().True(ok, "expected an ethsecp256k1 private key")
+	key, err := privKey.ToECDSA()
+	s.Require().NoError(err)
+
+	sig, err := crypto.Sign(digest.Bytes(), key)
+	s.Require().NoError(err, "failed to sign the permit")
+
+	var r, sValue [32]byte
+	copy(r[:], sig[:32])
+	copy(sValue[:], sig[32:64])
+
+	return sig[64] + 27, r, sValue
+}
@@ -0,0 +1,151 @@
+package feegrant
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/feegrant"
+	"github.com/cosmos/evm/x/vm/statedb"
+
+	storetypes "cosmossdk.io/store/types"
+	sdkfeegrant "cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *PrecompileTestSuite) TestGrantAllowanceEvent() {
+	var (
+		stateDB *statedb.StateDB
+		ctx     sdk.Context
+		method  = s.precompile.Methods[feegrant.GrantBasicAllowanceMethod]
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"success - the correct event is emitted",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), []cmn.Coin{}, int64(0)}
+			},
+			func() {
+				log := stateDB.Logs()[0]
+				s.Require().Equal(log.Address, s.precompile.Address())
+
+				// Check event signature matches the one emitted
+				event := s.precompile.Events[feegrant.EventTypeGrantAllowance]
+				s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+				s.Require().Equal(log.BlockNumber, uint64(ctx.BlockHeight())) //nolint:gosec // G115
+
+				// Check the fully unpacked event matches the one emitted
+				var grantEvent feegrant.EventGrantAllowance
+				err := cmn.UnpackLog(s.precompile.ABI, &grantEvent, feegrant.EventTypeGrantAllowance, *log)
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(0), grantEvent.Granter)
+				s.Require().Equal(s.keyring.GetAddr(1), grantEvent.Grantee)
+				s.Require().Equal(sdk.MsgTypeURL(&sdkfeegrant.BasicAllowance{}), grantEvent.AllowanceType)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB = s.network.GetStateDB()
+			ctx = s.network.GetContext()
+
+			contract := vm.NewContract(s.keyring.GetAddr(0), s.precompile.Address(), uint256.NewInt(0), tc.gas, nil)
+			ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			initialGas := ctx.GasMeter().GasConsumed()
+			s.Require().Zero(initialGas)
+
+			_, err := s.precompile.GrantBasicAllowance(ctx, contract, stateDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevokeAllowanceEvent() {
+	var (
+		stateDB *statedb.StateDB
+		ctx     sdk.Context
+		method  = s.precompile.Methods[feegrant.RevokeAllowanceMethod]
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"success - the correct event is emitted",
+			func() []interface{} {
+				s.saveAllowance(ctx, 0, 1, s.basicAllowance(100))
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			func() {
+				log := stateDB.Logs()[0]
+				s.Require().Equal(log.Address, s.precompile.Address())
+
+				// Check event signature matches the one emitted
+				event := s.precompile.Events[feegrant.EventTypeRevokeAllowance]
+				s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+				s.Require().Equal(log.BlockNumber, uint64(ctx.BlockHeight())) //nolint:gosec // G115
+
+				// Check the fully unpacked event matches the one emitted
+				var revokeEvent feegrant.EventRevokeAllowance
+				err := cmn.UnpackLog(s.precompile.ABI, &revokeEvent, feegrant.EventTypeRevokeAllowance, *log)
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(0), revokeEvent.Granter)
+				s.Require().Equal(s.keyring.GetAddr(1), revokeEvent.Grantee)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB = s.network.GetStateDB()
+			ctx = s.network.GetContext()
+
+			contract := vm.NewContract(s.keyring.GetAddr(0), s.precompile.Address(), uint256.NewInt(0), tc.gas, nil)
+			ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			initialGas := ctx.GasMeter().GasConsumed()
+			s.Require().Zero(initialGas)
+
+			_, err := s.precompile.RevokeAllowance(ctx, contract, stateDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck()
+			}
+		})
+	}
+}
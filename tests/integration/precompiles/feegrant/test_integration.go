@@ -0,0 +1,258 @@
+package feegrant
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/ginkgo/v2"
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/gomega"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/feegrant"
+	"github.com/cosmos/evm/precompiles/testutil"
+	commonfactory "github.com/cosmos/evm/testutil/integration/base/factory"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testutiltx "github.com/cosmos/evm/testutil/tx"
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkfeegrant "cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// General variables used for integration tests
+var (
+	// differentAddr is an address generated for testing purposes that e.g. raises the different origin error
+	differentAddr = testutiltx.GenerateAddress()
+	// callArgs are the default arguments for calling the precompile
+	callArgs testutiltypes.CallArgs
+	// txArgs are the EVM transaction arguments to use in the transactions
+	txArgs evmtypes.EvmTxArgs
+	// defaultLogCheck instantiates a log check arguments struct with the precompile ABI events populated.
+	defaultLogCheck testutil.LogCheckArgs
+	// passCheck defines the arguments to check if the precompile returns no error
+	passCheck testutil.LogCheckArgs
+	// outOfGasCheck defines the arguments to check if the precompile returns out of gas error
+	outOfGasCheck testutil.LogCheckArgs
+)
+
+func TestPrecompileIntegrationTestSuite(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	_ = Describe("Calling feegrant precompile from EOA", func() {
+		var s *PrecompileTestSuite
+
+		BeforeEach(func() {
+			s = NewPrecompileTestSuite(create, options...)
+			s.SetupTest()
+
+			// set the default call arguments
+			callArgs = testutiltypes.CallArgs{
+				ContractABI: s.precompile.ABI,
+			}
+			defaultLogCheck = testutil.LogCheckArgs{
+				ABIEvents: s.precompile.Events,
+			}
+			passCheck = defaultLogCheck.WithExpPass(true)
+			outOfGasCheck = defaultLogCheck.WithErrContains(vm.ErrOutOfGas.Error())
+
+			// reset tx args each test to avoid keeping custom
+			// values of previous tests (e.g. gasLimit)
+			precompileAddr := s.precompile.Address()
+			txArgs = evmtypes.EvmTxArgs{
+				To: &precompileAddr,
+			}
+			txArgs.GasLimit = 300_000
+		})
+
+		// =====================================
+		// 				TRANSACTIONS
+		// =====================================
+		Describe("Execute GrantBasicAllowance transaction", func() {
+			BeforeEach(func() { callArgs.MethodName = feegrant.GrantBasicAllowanceMethod })
+
+			It("fails with low gas", func() {
+				txArgs.GasLimit = 30_000
+				callArgs.Args = []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), []cmn.Coin{}, int64(0)}
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, outOfGasCheck)
+				Expect(err).To(BeNil())
+			})
+
+			It("fails if the granter is not the sender", func() {
+				callArgs.Args = []interface{}{differentAddr, s.keyring.GetAddr(1), []cmn.Coin{}, int64(0)}
+				errCheck := defaultLogCheck.WithErrContains(cmn.ErrRequesterIsNotMsgSender, s.keyring.GetAddr(0).String(), differentAddr.String())
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, errCheck)
+				Expect(err).To(BeNil())
+			})
+
+			It("grants an allowance that pays the fees of the grantee", func() {
+				callArgs.Args = []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), []cmn.Coin{}, int64(0)}
+				eventCheck := passCheck.WithExpEvents(feegrant.EventTypeGrantAllowance)
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, eventCheck)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				// the grantee sends a Cosmos transaction with the fees paid by the granter
+				granteeBalanceBefore := s.network.App.GetBankKeeper().GetBalance(s.network.GetContext(), s.keyring.GetAccAddr(1), s.network.GetBaseDenom())
+				granterBalanceBefore := s.network.App.GetBankKeeper().GetBalance(s.network.GetContext(), s.keyring.GetAccAddr(0), s.network.GetBaseDenom())
+				amount := sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 1000))
+
+				res, err := s.factory.CommitCosmosTx(s.keyring.GetPrivKey(1), commonfactory.CosmosTxArgs{
+					Msgs:       []sdk.Msg{banktypes.NewMsgSend(s.keyring.GetAccAddr(1), testutiltx.GenerateAddress().Bytes(), amount)},
+					FeeGranter: s.keyring.GetAccAddr(0),
+				})
+				Expect(err).To(BeNil())
+				Expect(res.IsOK()).To(BeTrue(), "transaction should have succeeded: %s", res.GetLog())
+
+				granteeBalanceAfter := s.network.App.GetBankKeeper().GetBalance(s.network.GetContext(), s.keyring.GetAccAddr(1), s.network.GetBaseDenom())
+				granterBalanceAfter := s.network.App.GetBankKeeper().GetBalance(s.network.GetContext(), s.keyring.GetAccAddr(0), s.network.GetBaseDenom())
+				Expect(granteeBalanceAfter.Amount).To(Equal(granteeBalanceBefore.Amount.Sub(amount[0].Amount)))
+				Expect(granterBalanceAfter.Amount.LT(granterBalanceBefore.Amount)).To(BeTrue(), "granter should have paid the fees")
+			})
+		})
+
+		Describe("Execute GrantAllowedMsgAllowance transaction", func() {
+			BeforeEach(func() { callArgs.MethodName = feegrant.GrantAllowedMsgAllowanceMethod })
+
+			It("grants an allowance that does not pay the fees of other messages", func() {
+				callArgs.Args = []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1),
+					[]cmn.Coin{}, int64(0),
+					int64(3600), []cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(1e18)}},
+					[]string{voteMsgTypeURL},
+				}
+				eventCheck := passCheck.WithExpEvents(feegrant.EventTypeGrantAllowance)
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, eventCheck)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				_, err = s.factory.CommitCosmosTx(s.keyring.GetPrivKey(1), commonfactory.CosmosTxArgs{
+					Msgs: []sdk.Msg{banktypes.NewMsgSend(
+						s.keyring.GetAccAddr(1),
+						testutiltx.GenerateAddress().Bytes(),
+						sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 1000)),
+					)},
+					FeeGranter: s.keyring.GetAccAddr(0),
+				})
+				Expect(err).NotTo(BeNil())
+				Expect(err.Error()).To(ContainSubstring(sdkfeegrant.ErrMessageNotAllowed.Error()))
+			})
+		})
+
+		Describe("Execute RevokeAllowance transaction", func() {
+			BeforeEach(func() {
+				grantArgs := testutiltypes.CallArgs{
+					ContractABI: s.precompile.ABI,
+					MethodName:  feegrant.GrantBasicAllowanceMethod,
+					Args:        []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), []cmn.Coin{}, int64(0)},
+				}
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, grantArgs, passCheck.WithExpEvents(feegrant.EventTypeGrantAllowance))
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				callArgs.MethodName = feegrant.RevokeAllowanceMethod
+			})
+
+			It("fails if the granter is not the sender", func() {
+				callArgs.Args = []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+				errCheck := defaultLogCheck.WithErrContains(cmn.ErrRequesterIsNotMsgSender, s.keyring.GetAddr(1).String(), s.keyring.GetAddr(0).String())
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(1), txArgs, callArgs, errCheck)
+				Expect(err).To(BeNil())
+			})
+
+			It("revokes the allowance and emits event", func() {
+				callArgs.Args = []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+				eventCheck := passCheck.WithExpEvents(feegrant.EventTypeRevokeAllowance)
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, eventCheck)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				_, err = s.network.App.GetFeeGrantKeeper().GetAllowance(s.network.GetContext(), s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1))
+				Expect(err).NotTo(BeNil())
+			})
+		})
+
+		// =====================================
+		// 				QUERIES
+		// =====================================
+		Describe("Execute queries", func() {
+			BeforeEach(func() {
+				for _, grantee := range []common.Address{s.keyring.GetAddr(1), s.keyring.GetAddr(2)} {
+					grantArgs := testutiltypes.CallArgs{
+						ContractABI: s.precompile.ABI,
+						MethodName:  feegrant.GrantBasicAllowanceMethod,
+						Args: []interface{}{
+							s.keyring.GetAddr(0), grantee,
+							[]cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(1e18)}},
+							int64(0),
+						},
+					}
+					_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, grantArgs, passCheck.WithExpEvents(feegrant.EventTypeGrantAllowance))
+					Expect(err).To(BeNil())
+					Expect(s.network.NextBlock()).To(BeNil())
+				}
+			})
+
+			It("should return the allowance of a granter to a grantee", func() {
+				callArgs.MethodName = feegrant.AllowanceMethod
+				callArgs.Args = []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+
+				_, ethRes, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, passCheck)
+				Expect(err).To(BeNil())
+
+				var out feegrant.AllowanceOutput
+				err = s.precompile.UnpackIntoInterface(&out, feegrant.AllowanceMethod, ethRes.Ret)
+				Expect(err).To(BeNil())
+				Expect(out.Allowance.Granter).To(Equal(s.keyring.GetAddr(0)))
+				Expect(out.Allowance.Grantee).To(Equal(s.keyring.GetAddr(1)))
+				Expect(out.Allowance.SpendLimit).To(HaveLen(1))
+				Expect(out.Allowance.SpendLimit[0].Amount).To(Equal(big.NewInt(1e18)))
+			})
+
+			It("should return the allowances of a grantee", func() {
+				callArgs.MethodName = feegrant.AllowancesMethod
+				callArgs.Args = []interface{}{s.keyring.GetAddr(2), query.PageRequest{CountTotal: true}}
+
+				_, ethRes, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, passCheck)
+				Expect(err).To(BeNil())
+
+				var out feegrant.AllowancesOutput
+				err = s.precompile.UnpackIntoInterface(&out, feegrant.AllowancesMethod, ethRes.Ret)
+				Expect(err).To(BeNil())
+				Expect(out.Allowances).To(HaveLen(1))
+				Expect(out.Allowances[0].Granter).To(Equal(s.keyring.GetAddr(0)))
+				Expect(out.PageResponse.Total).To(Equal(uint64(1)))
+			})
+
+			It("should return the allowances of a granter", func() {
+				callArgs.MethodName = feegrant.AllowancesByGranterMethod
+				callArgs.Args = []interface{}{s.keyring.GetAddr(0), query.PageRequest{CountTotal: true}}
+
+				_, ethRes, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, passCheck)
+				Expect(err).To(BeNil())
+
+				var out feegrant.AllowancesOutput
+				err = s.precompile.UnpackIntoInterface(&out, feegrant.AllowancesByGranterMethod, ethRes.Ret)
+				Expect(err).To(BeNil())
+				Expect(out.Allowances).To(HaveLen(2))
+				Expect(out.PageResponse.Total).To(Equal(uint64(2)))
+			})
+		})
+	})
+
+	// Run Ginkgo integration tests
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Feegrant Precompile Suite")
+}
@@ -0,0 +1,292 @@
+package feegrant
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/feegrant"
+	"github.com/cosmos/evm/precompiles/testutil"
+
+	sdkfeegrant "cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (s *PrecompileTestSuite) TestAllowance() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[feegrant.AllowanceMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(allowance *feegrant.AllowanceData)
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(_ *feegrant.AllowanceData) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid granter address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, s.keyring.GetAddr(1)}
+			},
+			func(_ *feegrant.AllowanceData) {},
+			200000,
+			true,
+			"invalid granter address",
+		},
+		{
+			"fail - allowance not found",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			func(_ *feegrant.AllowanceData) {},
+			200000,
+			true,
+			"fee-grant not found",
+		},
+		{
+			"success - basic allowance",
+			func() []interface{} {
+				s.saveAllowance(ctx, 0, 1, s.basicAllowance(100))
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			func(allowance *feegrant.AllowanceData) {
+				s.Require().Equal(s.keyring.GetAddr(0), allowance.Granter)
+				s.Require().Equal(s.keyring.GetAddr(1), allowance.Grantee)
+				s.Require().Equal(sdk.MsgTypeURL(&sdkfeegrant.BasicAllowance{}), allowance.AllowanceType)
+				s.Require().Equal(cmn.NewCoinsResponse(sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 100))), allowance.SpendLimit)
+				s.Require().Zero(allowance.Expiration)
+				s.Require().Zero(allowance.Period)
+				s.Require().Empty(allowance.AllowedMessages)
+			},
+			200000,
+			false,
+			"",
+		},
+		{
+			"success - allowed msg allowance wrapping a periodic allowance",
+			func() []interface{} {
+				periodLimit := sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 10))
+				allowance, err := sdkfeegrant.NewAllowedMsgAllowance(&sdkfeegrant.PeriodicAllowance{
+					Basic:            *s.basicAllowance(100),
+					Period:           time.Hour,
+					PeriodSpendLimit: periodLimit,
+					PeriodCanSpend:   periodLimit,
+					PeriodReset:      ctx.BlockTime().Add(time.Hour),
+				}, []string{voteMsgTypeURL})
+				s.Require().NoError(err)
+				s.saveAllowance(ctx, 0, 1, allowance)
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			func(allowance *feegrant.AllowanceData) {
+				s.Require().Equal(sdk.MsgTypeURL(&sdkfeegrant.AllowedMsgAllowance{}), allowance.AllowanceType)
+				s.Require().Equal([]string{voteMsgTypeURL}, allowance.AllowedMessages)
+				s.Require().Equal(cmn.NewCoinsResponse(sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 100))), allowance.SpendLimit)
+				s.Require().Equal(int64(3600), allowance.Period)
+				s.Require().Equal(cmn.NewCoinsResponse(sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 10))), allowance.PeriodSpendLimit)
+				s.Require().Equal(cmn.NewCoinsResponse(sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 10))), allowance.PeriodCanSpend)
+				s.Require().Equal(ctx.BlockTime().Add(time.Hour).Unix(), allowance.PeriodReset)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), tc.gas)
+
+			bz, err := s.precompile.Allowance(ctx, &method, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				var out feegrant.AllowanceOutput
+				err = s.precompile.UnpackIntoInterface(&out, feegrant.AllowanceMethod, bz)
+				s.Require().NoError(err)
+				tc.postCheck(&out.Allowance)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestAllowances() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[feegrant.AllowancesMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(out *feegrant.AllowancesOutput)
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(_ *feegrant.AllowancesOutput) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, query.PageRequest{}}
+			},
+			func(_ *feegrant.AllowancesOutput) {},
+			200000,
+			true,
+			"invalid grantee address",
+		},
+		{
+			"success - no allowances",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(2), query.PageRequest{}}
+			},
+			func(out *feegrant.AllowancesOutput) {
+				s.Require().Empty(out.Allowances)
+			},
+			200000,
+			false,
+			"",
+		},
+		{
+			"success - allowances from several granters with pagination",
+			func() []interface{} {
+				s.saveAllowance(ctx, 0, 2, s.basicAllowance(100))
+				s.saveAllowance(ctx, 1, 2, s.basicAllowance(200))
+				s.saveAllowance(ctx, 1, 0, s.basicAllowance(300))
+				return []interface{}{s.keyring.GetAddr(2), query.PageRequest{Limit: 1, CountTotal: true}}
+			},
+			func(out *feegrant.AllowancesOutput) {
+				s.Require().Len(out.Allowances, 1)
+				s.Require().Equal(s.keyring.GetAddr(2), out.Allowances[0].Grantee)
+				s.Require().Equal(uint64(2), out.PageResponse.Total)
+				s.Require().NotEmpty(out.PageResponse.NextKey)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), tc.gas)
+
+			bz, err := s.precompile.Allowances(ctx, &method, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				var out feegrant.AllowancesOutput
+				err = s.precompile.UnpackIntoInterface(&out, feegrant.AllowancesMethod, bz)
+				s.Require().NoError(err)
+				tc.postCheck(&out)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestAllowancesByGranter() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[feegrant.AllowancesByGranterMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(out *feegrant.AllowancesOutput)
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(_ *feegrant.AllowancesOutput) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid granter address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, query.PageRequest{}}
+			},
+			func(_ *feegrant.AllowancesOutput) {},
+			200000,
+			true,
+			"invalid granter address",
+		},
+		{
+			"success - allowances to several grantees",
+			func() []interface{} {
+				s.saveAllowance(ctx, 0, 1, s.basicAllowance(100))
+				s.saveAllowance(ctx, 0, 2, s.basicAllowance(200))
+				s.saveAllowance(ctx, 1, 2, s.basicAllowance(300))
+				return []interface{}{s.keyring.GetAddr(0), query.PageRequest{CountTotal: true}}
+			},
+			func(out *feegrant.AllowancesOutput) {
+				s.Require().Len(out.Allowances, 2)
+				s.Require().Equal(uint64(2), out.PageResponse.Total)
+				for _, allowance := range out.Allowances {
+					s.Require().Equal(s.keyring.GetAddr(0), allowance.Granter)
+				}
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), tc.gas)
+
+			bz, err := s.precompile.AllowancesByGranter(ctx, &method, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				var out feegrant.AllowancesOutput
+				err = s.precompile.UnpackIntoInterface(&out, feegrant.AllowancesByGranterMethod, bz)
+				s.Require().NoError(err)
+				tc.postCheck(&out)
+			}
+		})
+	}
+}
@@ -0,0 +1,416 @@
+package feegrant
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/feegrant"
+	"github.com/cosmos/evm/precompiles/testutil"
+
+	sdkfeegrant "cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+var voteMsgTypeURL = sdk.MsgTypeURL(&govv1.MsgVote{})
+
+func (s *PrecompileTestSuite) TestGrantBasicAllowance() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[feegrant.GrantBasicAllowanceMethod]
+	expiration := time.Now().Add(time.Hour).Unix()
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), common.Address{}, []cmn.Coin{}, int64(0)}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid grantee address",
+		},
+		{
+			"fail - granter is not the msg.sender",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), s.keyring.GetAddr(2), []cmn.Coin{}, int64(0)}
+			},
+			func() {},
+			200000,
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - granter is the grantee",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(0), []cmn.Coin{}, int64(0)}
+			},
+			func() {},
+			200000,
+			true,
+			"cannot self-grant fee authorization",
+		},
+		{
+			"fail - expiration in the past",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), []cmn.Coin{}, int64(1)}
+			},
+			func() {},
+			200000,
+			true,
+			"expiration is before current block time",
+		},
+		{
+			"fail - allowance already exists",
+			func() []interface{} {
+				s.saveAllowance(ctx, 0, 1, s.basicAllowance(100))
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), []cmn.Coin{}, int64(0)}
+			},
+			func() {},
+			200000,
+			true,
+			"fee allowance already exists",
+		},
+		{
+			"success - basic allowance granted",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1),
+					[]cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(100)}},
+					expiration,
+				}
+			},
+			func() {
+				allowance, err := s.network.App.GetFeeGrantKeeper().GetAllowance(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1))
+				s.Require().NoError(err)
+
+				basic, ok := allowance.(*sdkfeegrant.BasicAllowance)
+				s.Require().True(ok)
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 100)), basic.SpendLimit)
+				s.Require().NotNil(basic.Expiration)
+				s.Require().Equal(expiration, basic.Expiration.Unix())
+			},
+			200000,
+			false,
+			"",
+		},
+		{
+			"success - unlimited allowance granted to a new account",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), common.Address{1}, []cmn.Coin{}, int64(0)}
+			},
+			func() {
+				allowance, err := s.network.App.GetFeeGrantKeeper().GetAllowance(ctx, s.keyring.GetAccAddr(0), common.Address{1}.Bytes())
+				s.Require().NoError(err)
+				s.Require().Equal(&sdkfeegrant.BasicAllowance{}, allowance)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), tc.gas)
+
+			res, err := s.precompile.GrantBasicAllowance(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrantPeriodicAllowance() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[feegrant.GrantPeriodicAllowanceMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 6, 0),
+		},
+		{
+			"fail - empty period spend limit",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), []cmn.Coin{}, int64(0), int64(3600), []cmn.Coin{}}
+			},
+			func() {},
+			200000,
+			true,
+			"spend limit must be positive",
+		},
+		{
+			"fail - period spend limit in a different denomination",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1),
+					[]cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(100)}},
+					int64(0), int64(3600),
+					[]cmn.Coin{{Denom: "other", Amount: big.NewInt(10)}},
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"period spend limit has different currency than basic spend limit",
+		},
+		{
+			"success - periodic allowance granted",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1),
+					[]cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(100)}},
+					int64(0), int64(3600),
+					[]cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(10)}},
+				}
+			},
+			func() {
+				allowance, err := s.network.App.GetFeeGrantKeeper().GetAllowance(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1))
+				s.Require().NoError(err)
+
+				periodic, ok := allowance.(*sdkfeegrant.PeriodicAllowance)
+				s.Require().True(ok)
+				s.Require().Equal(time.Hour, periodic.Period)
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 10)), periodic.PeriodSpendLimit)
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), 10)), periodic.PeriodCanSpend)
+				s.Require().Equal(ctx.BlockTime().Add(time.Hour).Unix(), periodic.PeriodReset.Unix())
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), tc.gas)
+
+			res, err := s.precompile.GrantPeriodicAllowance(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrantAllowedMsgAllowance() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[feegrant.GrantAllowedMsgAllowanceMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 7, 0),
+		},
+		{
+			"fail - empty allowed messages",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), []cmn.Coin{}, int64(0), int64(0), []cmn.Coin{}, []string{}}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid allowed messages",
+		},
+		{
+			"fail - granter is not the msg.sender",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), s.keyring.GetAddr(2), []cmn.Coin{}, int64(0), int64(0), []cmn.Coin{}, []string{voteMsgTypeURL}}
+			},
+			func() {},
+			200000,
+			true,
+			"does not match the requester address",
+		},
+		{
+			"success - allowed msg allowance granted",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1),
+					[]cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(100)}},
+					int64(0), int64(0), []cmn.Coin{},
+					[]string{voteMsgTypeURL},
+				}
+			},
+			func() {
+				allowance, err := s.network.App.GetFeeGrantKeeper().GetAllowance(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1))
+				s.Require().NoError(err)
+
+				allowedMsg, ok := allowance.(*sdkfeegrant.AllowedMsgAllowance)
+				s.Require().True(ok)
+				s.Require().Equal([]string{voteMsgTypeURL}, allowedMsg.AllowedMessages)
+
+				inner, err := allowedMsg.GetAllowance()
+				s.Require().NoError(err)
+				s.Require().Equal(s.basicAllowance(100), inner)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), tc.gas)
+
+			res, err := s.precompile.GrantAllowedMsgAllowance(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevokeAllowance() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[feegrant.RevokeAllowanceMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - granter is not the msg.sender",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), s.keyring.GetAddr(2)}
+			},
+			func() {},
+			200000,
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - allowance not found",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			func() {},
+			200000,
+			true,
+			"fee-grant not found",
+		},
+		{
+			"success - allowance revoked",
+			func() []interface{} {
+				s.saveAllowance(ctx, 0, 1, s.basicAllowance(100))
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			func() {
+				_, err := s.network.App.GetFeeGrantKeeper().GetAllowance(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1))
+				s.Require().ErrorContains(err, "fee-grant not found")
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), tc.gas)
+
+			res, err := s.precompile.RevokeAllowance(ctx, contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
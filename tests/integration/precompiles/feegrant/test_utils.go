@@ -0,0 +1,27 @@
+package feegrant
+
+import (
+	sdkfeegrant "cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// saveAllowance is a helper function to store a fee allowance from the granter
+// to the grantee of the given keyring indexes.
+func (s *PrecompileTestSuite) saveAllowance(ctx sdk.Context, granterIdx, granteeIdx int, allowance sdkfeegrant.FeeAllowanceI) {
+	err := s.network.App.GetFeeGrantKeeper().GrantAllowance(
+		ctx,
+		s.keyring.GetAccAddr(granterIdx),
+		s.keyring.GetAccAddr(granteeIdx),
+		allowance,
+	)
+	s.Require().NoError(err)
+}
+
+// basicAllowance is a helper function to return a basic allowance with a
+// spend limit in the base denomination and no expiration.
+func (s *PrecompileTestSuite) basicAllowance(spendLimit int64) *sdkfeegrant.BasicAllowance {
+	return &sdkfeegrant.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin(s.network.GetBaseDenom(), spendLimit)),
+	}
+}
@@ -0,0 +1,264 @@
+package multicall
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/ginkgo/v2"
+	//nolint:revive // dot imports are fine for Ginkgo
+	. "github.com/onsi/gomega"
+
+	"github.com/cosmos/evm/precompiles/multicall"
+	"github.com/cosmos/evm/precompiles/staking"
+	"github.com/cosmos/evm/precompiles/testutil"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+)
+
+// General variables used for integration tests
+var (
+	// callArgs are the default arguments for calling the precompile
+	callArgs testutiltypes.CallArgs
+	// txArgs are the EVM transaction arguments to use in the transactions
+	txArgs evmtypes.EvmTxArgs
+	// defaultLogCheck instantiates a log check arguments struct with the staking ABI events populated.
+	defaultLogCheck testutil.LogCheckArgs
+	// passCheck defines the arguments to check if the precompile returns no error
+	passCheck testutil.LogCheckArgs
+	// gasPrice is the gas price used in the transactions, to compute the paid fees
+	gasPrice = math.NewInt(1e9)
+	// delegationAmount is the amount delegated in each staking call
+	delegationAmount = big.NewInt(1e18)
+)
+
+func TestPrecompileIntegrationTestSuite(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	_ = Describe("Calling multicall precompile from EOA", func() {
+		var (
+			s        *PrecompileTestSuite
+			valAddrs []string
+		)
+
+		BeforeEach(func() {
+			s = NewPrecompileTestSuite(create, options...)
+			s.SetupTest()
+
+			valAddrs = nil
+			for _, val := range s.network.GetValidators() {
+				valAddrs = append(valAddrs, val.OperatorAddress)
+			}
+
+			// set the default call arguments
+			callArgs = testutiltypes.CallArgs{
+				ContractABI: s.precompile.ABI,
+			}
+			defaultLogCheck = testutil.LogCheckArgs{
+				ABIEvents: s.stakingABI.Events,
+			}
+			passCheck = defaultLogCheck.WithExpPass(true)
+
+			// reset tx args each test to avoid keeping custom
+			// values of previous tests (e.g. gasLimit)
+			precompileAddr := s.precompile.Address()
+			txArgs = evmtypes.EvmTxArgs{
+				To:       &precompileAddr,
+				GasPrice: gasPrice.BigInt(),
+			}
+			txArgs.GasLimit = 1_000_000
+		})
+
+		// delegateCall returns a call to the staking precompile that delegates
+		// on behalf of the transaction sender.
+		delegateCall := func(valAddr string) multicall.Call {
+			input, err := s.stakingABI.Pack(staking.DelegateMethod, s.keyring.GetAddr(0), valAddr, delegationAmount)
+			if err != nil {
+				panic(err)
+			}
+
+			return multicall.Call{
+				Target:   common.HexToAddress(evmtypes.StakingPrecompileAddress),
+				CallData: input,
+			}
+		}
+
+		// delegation returns the amount delegated by the transaction sender to the validator.
+		delegation := func(valAddr string) math.Int {
+			res, err := s.grpcHandler.GetDelegatorDelegations(s.keyring.GetAccAddr(0).String())
+			if err != nil {
+				panic(err)
+			}
+
+			for _, delegation := range res.DelegationResponses {
+				if delegation.Delegation.ValidatorAddress == valAddr {
+					return delegation.Balance.Amount
+				}
+			}
+
+			return math.ZeroInt()
+		}
+
+		// balance returns the bank balance of the transaction sender.
+		balance := func() math.Int {
+			res, err := s.grpcHandler.GetBalanceFromBank(s.keyring.GetAccAddr(0), s.network.GetBaseDenom())
+			if err != nil {
+				panic(err)
+			}
+
+			return res.Balance.Amount
+		}
+
+		Describe("Execute aggregate transaction", func() {
+			BeforeEach(func() { callArgs.MethodName = multicall.AggregateMethod })
+
+			It("delegates to several validators in a single transaction", func() {
+				callArgs.Args = []interface{}{[]multicall.Call{delegateCall(valAddrs[0]), delegateCall(valAddrs[1])}}
+				eventCheck := passCheck.WithExpEvents(staking.EventTypeDelegate, staking.EventTypeDelegate)
+				balanceBefore := balance()
+				delegationsBefore := []math.Int{delegation(valAddrs[0]), delegation(valAddrs[1])}
+
+				res, ethRes, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, eventCheck)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				out, err := s.precompile.Unpack(multicall.AggregateMethod, ethRes.Ret)
+				Expect(err).To(BeNil())
+				returnData, ok := out[1].([][]byte)
+				Expect(ok).To(BeTrue())
+				Expect(returnData).To(HaveLen(2))
+
+				Expect(delegation(valAddrs[0])).To(Equal(delegationsBefore[0].Add(math.NewIntFromBigInt(delegationAmount))))
+				Expect(delegation(valAddrs[1])).To(Equal(delegationsBefore[1].Add(math.NewIntFromBigInt(delegationAmount))))
+
+				// the balance changes recorded by each staking call are applied exactly once
+				fees := gasPrice.MulRaw(res.GasUsed)
+				delegated := math.NewIntFromBigInt(delegationAmount).MulRaw(2)
+				Expect(balance()).To(Equal(balanceBefore.Sub(fees).Sub(delegated)))
+
+				evmRes, err := s.grpcHandler.GetBalanceFromEVM(s.keyring.GetAccAddr(0))
+				Expect(err).To(BeNil())
+				Expect(evmRes.Balance).To(Equal(balance().String()))
+			})
+
+			It("reverts all the calls if one of them fails", func() {
+				failingCall := delegateCall("invalid validator")
+				callArgs.Args = []interface{}{[]multicall.Call{delegateCall(valAddrs[0]), failingCall}}
+				errCheck := defaultLogCheck.WithErrContains("call 1 to %s failed", failingCall.Target.String())
+				delegationBefore := delegation(valAddrs[0])
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, errCheck)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				Expect(delegation(valAddrs[0])).To(Equal(delegationBefore))
+			})
+
+			It("fails to call the multicall precompile itself", func() {
+				callArgs.Args = []interface{}{[]multicall.Call{{Target: s.precompile.Address()}}}
+				errCheck := defaultLogCheck.WithErrContains(multicall.ErrSelfCall.Error())
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, errCheck)
+				Expect(err).To(BeNil())
+			})
+
+			It("fails if funds are sent to the precompile", func() {
+				txArgs.Amount = big.NewInt(1)
+				callArgs.Args = []interface{}{[]multicall.Call{delegateCall(valAddrs[0])}}
+				errCheck := defaultLogCheck.WithErrContains(multicall.ErrCannotReceiveFunds, "1")
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, errCheck)
+				Expect(err).To(BeNil())
+			})
+		})
+
+		Describe("Execute tryAggregate transaction", func() {
+			BeforeEach(func() { callArgs.MethodName = multicall.TryAggregateMethod })
+
+			It("reverts all the calls if success is required and one of them fails", func() {
+				callArgs.Args = []interface{}{true, []multicall.Call{delegateCall(valAddrs[0]), delegateCall("invalid validator")}}
+				errCheck := defaultLogCheck.WithErrContains("call 1 to")
+				delegationBefore := delegation(valAddrs[0])
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, errCheck)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				Expect(delegation(valAddrs[0])).To(Equal(delegationBefore))
+			})
+
+			It("keeps the successful calls if success is not required", func() {
+				callArgs.Args = []interface{}{false, []multicall.Call{delegateCall("invalid validator"), delegateCall(valAddrs[0])}}
+				eventCheck := passCheck.WithExpEvents(staking.EventTypeDelegate)
+				delegationBefore := delegation(valAddrs[0])
+
+				_, ethRes, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, eventCheck)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				var out struct{ ReturnData []multicall.Result }
+				Expect(s.precompile.UnpackIntoInterface(&out, multicall.TryAggregateMethod, ethRes.Ret)).To(BeNil())
+				Expect(out.ReturnData).To(HaveLen(2))
+				Expect(out.ReturnData[0].Success).To(BeFalse())
+				Expect(out.ReturnData[1].Success).To(BeTrue())
+
+				Expect(delegation(valAddrs[0])).To(Equal(delegationBefore.Add(math.NewIntFromBigInt(delegationAmount))))
+			})
+		})
+
+		Describe("Execute aggregate3 transaction", func() {
+			BeforeEach(func() { callArgs.MethodName = multicall.Aggregate3Method })
+
+			It("reverts the changes of a failed call and continues if it is allowed to fail", func() {
+				stakingAddr := common.HexToAddress(evmtypes.StakingPrecompileAddress)
+				calls := []multicall.Call3{
+					{Target: stakingAddr, AllowFailure: true, CallData: delegateCall("invalid validator").CallData},
+					{Target: stakingAddr, AllowFailure: false, CallData: delegateCall(valAddrs[1]).CallData},
+				}
+				callArgs.Args = []interface{}{calls}
+				eventCheck := passCheck.WithExpEvents(staking.EventTypeDelegate)
+				balanceBefore := balance()
+				delegationBefore := delegation(valAddrs[1])
+
+				res, ethRes, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, eventCheck)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				var out struct{ ReturnData []multicall.Result }
+				Expect(s.precompile.UnpackIntoInterface(&out, multicall.Aggregate3Method, ethRes.Ret)).To(BeNil())
+				Expect(out.ReturnData).To(HaveLen(2))
+				Expect(out.ReturnData[0].Success).To(BeFalse())
+				Expect(out.ReturnData[1].Success).To(BeTrue())
+
+				Expect(delegation(valAddrs[1])).To(Equal(delegationBefore.Add(math.NewIntFromBigInt(delegationAmount))))
+
+				fees := gasPrice.MulRaw(res.GasUsed)
+				Expect(balance()).To(Equal(balanceBefore.Sub(fees).Sub(math.NewIntFromBigInt(delegationAmount))))
+			})
+
+			It("reverts all the calls if a call that is not allowed to fail fails", func() {
+				stakingAddr := common.HexToAddress(evmtypes.StakingPrecompileAddress)
+				calls := []multicall.Call3{
+					{Target: stakingAddr, AllowFailure: true, CallData: delegateCall(valAddrs[0]).CallData},
+					{Target: stakingAddr, AllowFailure: false, CallData: delegateCall("invalid validator").CallData},
+				}
+				callArgs.Args = []interface{}{calls}
+				errCheck := defaultLogCheck.WithErrContains("call 1 to")
+				delegationBefore := delegation(valAddrs[0])
+
+				_, _, err := s.factory.CallContractAndCheckLogs(s.keyring.GetPrivKey(0), txArgs, callArgs, errCheck)
+				Expect(err).To(BeNil())
+				Expect(s.network.NextBlock()).To(BeNil())
+
+				Expect(delegation(valAddrs[0])).To(Equal(delegationBefore))
+			})
+		})
+	})
+
+	// Run Ginkgo integration tests
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Multicall Precompile Suite")
+}
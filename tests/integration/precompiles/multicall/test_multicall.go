@@ -0,0 +1,142 @@
+package multicall
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/precompiles/multicall"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+func (s *PrecompileTestSuite) TestNewPrecompile() {
+	testCases := []struct {
+		name        string
+		baseGas     uint64
+		expPass     bool
+		errContains string
+	}{
+		{
+			"fail - new precompile with baseGas == 0",
+			0,
+			false,
+			"baseGas cannot be zero",
+		},
+		{
+			"success - new precompile with baseGas > 0",
+			10,
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			p, err := multicall.NewPrecompile(tc.baseGas)
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().NotNil(p)
+				s.Require().Equal(tc.baseGas, p.RequiredGas([]byte{}))
+				s.Require().Equal(common.HexToAddress(evmtypes.MulticallPrecompileAddress), p.Address())
+			} else {
+				s.Require().Error(err)
+				s.Require().Nil(p)
+				s.Require().Contains(err.Error(), tc.errContains)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestParseArgs() {
+	target := s.keyring.GetAddr(1)
+	callData := []byte{0x01, 0x02, 0x03, 0x04}
+
+	testCases := []struct {
+		name        string
+		method      string
+		args        []interface{}
+		expCalls    []multicall.Call3
+		errContains string
+	}{
+		{
+			"fail - aggregate with invalid number of arguments",
+			multicall.AggregateMethod,
+			[]interface{}{},
+			nil,
+			"invalid number of arguments",
+		},
+		{
+			"success - aggregate calls do not allow failure",
+			multicall.AggregateMethod,
+			[]interface{}{[]multicall.Call{{Target: target, CallData: callData}}},
+			[]multicall.Call3{{Target: target, AllowFailure: false, CallData: callData}},
+			"",
+		},
+		{
+			"fail - tryAggregate with invalid number of arguments",
+			multicall.TryAggregateMethod,
+			[]interface{}{true},
+			nil,
+			"invalid number of arguments",
+		},
+		{
+			"success - tryAggregate calls allow failure if success is not required",
+			multicall.TryAggregateMethod,
+			[]interface{}{false, []multicall.Call{{Target: target, CallData: callData}}},
+			[]multicall.Call3{{Target: target, AllowFailure: true, CallData: callData}},
+			"",
+		},
+		{
+			"success - tryAggregate calls do not allow failure if success is required",
+			multicall.TryAggregateMethod,
+			[]interface{}{true, []multicall.Call{{Target: target, CallData: callData}}},
+			[]multicall.Call3{{Target: target, AllowFailure: false, CallData: callData}},
+			"",
+		},
+		{
+			"fail - aggregate3 with invalid number of arguments",
+			multicall.Aggregate3Method,
+			[]interface{}{},
+			nil,
+			"invalid number of arguments",
+		},
+		{
+			"success - aggregate3 calls keep their failure policy",
+			multicall.Aggregate3Method,
+			[]interface{}{[]multicall.Call3{
+				{Target: target, AllowFailure: true, CallData: callData},
+				{Target: target, AllowFailure: false, CallData: callData},
+			}},
+			[]multicall.Call3{
+				{Target: target, AllowFailure: true, CallData: callData},
+				{Target: target, AllowFailure: false, CallData: callData},
+			},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			method := s.precompile.Methods[tc.method]
+
+			var (
+				calls []multicall.Call3
+				err   error
+			)
+			switch tc.method {
+			case multicall.AggregateMethod:
+				calls, err = multicall.ParseAggregateArgs(&method, tc.args)
+			case multicall.TryAggregateMethod:
+				calls, err = multicall.ParseTryAggregateArgs(&method, tc.args)
+			case multicall.Aggregate3Method:
+				calls, err = multicall.ParseAggregate3Args(&method, tc.args)
+			}
+
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expCalls, calls)
+		})
+	}
+}
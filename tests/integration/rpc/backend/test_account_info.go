@@ -6,6 +6,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/cometbft/cometbft/libs/bytes"
@@ -14,9 +16,12 @@ import (
 	"github.com/cosmos/evm/rpc/backend/mocks"
 	rpctypes "github.com/cosmos/evm/rpc/types"
 	utiltx "github.com/cosmos/evm/testutil/tx"
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 func (s *TestSuite) TestGetCode() {
@@ -84,11 +89,20 @@ func (s *TestSuite) TestGetProof() {
 	blockNrZero := rpctypes.NewBlockNumber(big.NewInt(0))
 	address1 := utiltx.GenerateAddress()
 
+	balanceKey, err := rpctypes.BalanceStoreKey(address1, evmtypes.GetEVMCoinDenom())
+	s.Require().NoError(err)
+	var fractionalProof *rpctypes.CosmosStoreProof
+	if evmtypes.GetEVMCoinDecimals() != evmtypes.EighteenDecimals {
+		proof := rpctypes.NewCosmosStoreProof(precisebanktypes.StoreKey, rpctypes.FractionalBalanceStoreKey(address1), []byte{2}, nil)
+		fractionalProof = &proof
+	}
+
 	testCases := []struct {
 		name          string
 		addr          common.Address
 		storageKeys   []string
 		blockNrOrHash rpctypes.BlockNumberOrHash
+		config        *rpctypes.ProofConfig
 		registerMock  func(rpctypes.BlockNumber, common.Address)
 		expPass       bool
 		expAccRes     *rpctypes.AccountResult
@@ -98,6 +112,7 @@ func (s *TestSuite) TestGetProof() {
 			address1,
 			[]string{},
 			rpctypes.BlockNumberOrHash{BlockNumber: &blockNrInvalid},
+			nil,
 			func(bn rpctypes.BlockNumber, addr common.Address) {
 				client := s.backend.ClientCtx.Client.(*mocks.Client)
 				height := bn.Int64()
@@ -113,6 +128,7 @@ func (s *TestSuite) TestGetProof() {
 			address1,
 			[]string{},
 			rpctypes.BlockNumberOrHash{BlockNumber: &blockNrInvalid},
+			nil,
 			func(bn rpctypes.BlockNumber, _ common.Address) {
 				client := s.backend.ClientCtx.Client.(*mocks.Client)
 				height := bn.Int64()
@@ -126,6 +142,7 @@ func (s *TestSuite) TestGetProof() {
 			address1,
 			[]string{"0x0"},
 			rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+			nil,
 			func(bn rpctypes.BlockNumber, addr common.Address) {
 				height := bn.Int64()
 				s.backend.Ctx = rpctypes.ContextWithHeight(height)
@@ -173,6 +190,7 @@ func (s *TestSuite) TestGetProof() {
 			address1,
 			[]string{"0x0"},
 			rpctypes.BlockNumberOrHash{BlockNumber: &blockNrZero},
+			nil,
 			func(bn rpctypes.BlockNumber, addr common.Address) {
 				height := int64(4)
 				s.backend.Ctx = rpctypes.ContextWithHeight(height)
@@ -217,13 +235,108 @@ func (s *TestSuite) TestGetProof() {
 				},
 			},
 		},
+		{
+			"pass - with cosmos proof",
+			address1,
+			[]string{"0x0"},
+			rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+			&rpctypes.ProofConfig{CosmosProof: true},
+			func(bn rpctypes.BlockNumber, addr common.Address) {
+				height := bn.Int64()
+				s.backend.Ctx = rpctypes.ContextWithHeight(height)
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterHeader(client, &height, nil)
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterAccount(QueryClient, addr, height)
+
+				RegisterABCIQueryWithOptions(
+					client,
+					height,
+					"store/evm/key",
+					evmtypes.StateKey(address1, common.HexToHash("0x0").Bytes()),
+					cmtrpcclient.ABCIQueryOptions{Height: height, Prove: true},
+				)
+				RegisterABCIQueryWithOptions(
+					client,
+					height,
+					"store/acc/key",
+					bytes.HexBytes(append(authtypes.AddressStoreKeyPrefix, address1.Bytes()...)),
+					cmtrpcclient.ABCIQueryOptions{Height: height, Prove: true},
+				)
+				RegisterABCIQueryWithOptions(
+					client,
+					height,
+					"store/bank/key",
+					balanceKey,
+					cmtrpcclient.ABCIQueryOptions{Height: height, Prove: true},
+				)
+				RegisterABCIQueryWithOptions(
+					client,
+					height,
+					"store/evm/key",
+					rpctypes.CodeHashStoreKey(address1),
+					cmtrpcclient.ABCIQueryOptions{Height: height, Prove: true},
+				)
+				if fractionalProof != nil {
+					RegisterABCIQueryWithOptions(
+						client,
+						height,
+						"store/precisebank/key",
+						rpctypes.FractionalBalanceStoreKey(address1),
+						cmtrpcclient.ABCIQueryOptions{Height: height, Prove: true},
+					)
+				}
+			},
+			true,
+			&rpctypes.AccountResult{
+				Address:      address1,
+				AccountProof: []string{""},
+				Balance:      (*hexutil.Big)(big.NewInt(0)),
+				CodeHash:     common.HexToHash(""),
+				Nonce:        0x0,
+				StorageHash:  common.Hash{},
+				StorageProof: []rpctypes.StorageResult{
+					{
+						Key:   "0x0",
+						Value: (*hexutil.Big)(big.NewInt(2)),
+						Proof: []string{""},
+					},
+				},
+				CosmosProof: &rpctypes.CosmosProofEnvelope{
+					Height:        4,
+					AppHashHeight: 5,
+					Account: rpctypes.NewCosmosStoreProof(
+						authtypes.StoreKey,
+						append(authtypes.AddressStoreKeyPrefix, address1.Bytes()...),
+						[]byte{2},
+						nil,
+					),
+					Balance:           rpctypes.NewCosmosStoreProof(banktypes.StoreKey, balanceKey, []byte{2}, nil),
+					FractionalBalance: fractionalProof,
+					CodeHash: rpctypes.NewCosmosStoreProof(
+						evmtypes.StoreKey,
+						rpctypes.CodeHashStoreKey(address1),
+						[]byte{2},
+						nil,
+					),
+					Storage: []rpctypes.CosmosStoreProof{
+						rpctypes.NewCosmosStoreProof(
+							evmtypes.StoreKey,
+							evmtypes.StateKey(address1, common.HexToHash("0x0").Bytes()),
+							[]byte{2},
+							nil,
+						),
+					},
+				},
+			},
+		},
 	}
 	for _, tc := range testCases {
 		s.Run(fmt.Sprintf("Case %s", tc.name), func() {
 			s.SetupTest()
 			tc.registerMock(*tc.blockNrOrHash.BlockNumber, tc.addr)
 
-			accRes, err := s.backend.GetProof(tc.addr, tc.storageKeys, tc.blockNrOrHash)
+			accRes, err := s.backend.GetProof(tc.addr, tc.storageKeys, tc.blockNrOrHash, tc.config)
 
 			if tc.expPass {
 				s.Require().NoError(err)
@@ -297,6 +410,195 @@ func (s *TestSuite) TestGetStorageAt() {
 	}
 }
 
+func (s *TestSuite) TestStorageRangeAt() {
+	addr := utiltx.GenerateAddress()
+	blockNr := rpctypes.NewBlockNumber(big.NewInt(2))
+	key := common.BigToHash(big.NewInt(1))
+	nextKey := common.BigToHash(big.NewInt(2))
+	value := common.BigToHash(big.NewInt(10))
+	_, bz := s.buildEthereumTx()
+
+	storageRes := &evmtypes.QueryStorageRangeResponse{
+		Storage:    evmtypes.Storage{evmtypes.NewState(key, value)},
+		Pagination: &query.PageResponse{NextKey: nextKey.Bytes()},
+	}
+	expResult := rpctypes.StorageRangeResult{
+		Storage: rpctypes.StorageMap{
+			crypto.Keccak256Hash(key.Bytes()): {Key: &key, Value: value},
+		},
+		NextKey: &nextKey,
+	}
+
+	testCases := []struct {
+		name         string
+		txIndex      int
+		registerMock func()
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			0,
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			false,
+		},
+		{
+			"fail - intermediate state of the block",
+			2,
+			func() {
+				s.backend.Ctx = rpctypes.ContextWithHeight(2)
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 2, bz)
+				s.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 2)
+				s.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"pass - state at the beginning of the block",
+			0,
+			func() {
+				s.backend.Ctx = rpctypes.ContextWithHeight(2)
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 2, bz)
+				s.Require().NoError(err)
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterStorageRange(QueryClient, 1, &evmtypes.QueryStorageRangeRequest{
+					Address:    addr.String(),
+					Pagination: &query.PageRequest{Key: key.Bytes(), Limit: 1},
+				}, storageRes)
+			},
+			true,
+		},
+		{
+			"pass - state at the end of the block",
+			1,
+			func() {
+				s.backend.Ctx = rpctypes.ContextWithHeight(2)
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 2, bz)
+				s.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 2)
+				s.Require().NoError(err)
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterStorageRange(QueryClient, 2, &evmtypes.QueryStorageRangeRequest{
+					Address:    addr.String(),
+					Pagination: &query.PageRequest{Key: key.Bytes(), Limit: 1},
+				}, storageRes)
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			s.SetupTest()
+			tc.registerMock()
+
+			result, err := s.backend.StorageRangeAt(rpctypes.BlockNumberOrHash{BlockNumber: &blockNr}, tc.txIndex, addr, key.Bytes(), 1)
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(expResult, result)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestAccountRange() {
+	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
+	addr := utiltx.GenerateAddress()
+	next := utiltx.GenerateAddress()
+	code := []byte("code")
+	key := common.BigToHash(big.NewInt(1))
+	value := common.BigToHash(big.NewInt(10))
+
+	testCases := []struct {
+		name          string
+		blockNrOrHash rpctypes.BlockNumberOrHash
+		nostorage     bool
+		registerMock  func(*evmtypes.QueryAccountRangeRequest)
+		expPass       bool
+		expDump       state.Dump
+	}{
+		{
+			"fail - BlockHash and BlockNumber are both nil",
+			rpctypes.BlockNumberOrHash{},
+			true,
+			func(*evmtypes.QueryAccountRangeRequest) {},
+			false,
+			state.Dump{},
+		},
+		{
+			"fail - query client errors on getting the accounts",
+			rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+			true,
+			func(req *evmtypes.QueryAccountRangeRequest) {
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterAccountRangeError(QueryClient, 1, req)
+			},
+			false,
+			state.Dump{},
+		},
+		{
+			"pass - with storage",
+			rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+			false,
+			func(req *evmtypes.QueryAccountRangeRequest) {
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterAccountRange(QueryClient, 1, req, &evmtypes.QueryAccountRangeResponse{
+					Accounts: []evmtypes.DumpAccount{
+						{
+							Address:  addr.Hex(),
+							Balance:  "100",
+							Nonce:    1,
+							CodeHash: crypto.Keccak256Hash(code).Hex(),
+							Code:     code,
+							Storage:  evmtypes.Storage{evmtypes.NewState(key, value)},
+						},
+					},
+					Pagination: &query.PageResponse{NextKey: next.Bytes()},
+				})
+			},
+			true,
+			state.Dump{
+				Accounts: map[string]state.DumpAccount{
+					addr.String(): {
+						Balance:  "100",
+						Nonce:    1,
+						CodeHash: crypto.Keccak256(code),
+						Code:     code,
+						Storage:  map[common.Hash]string{key: "0a"},
+						Address:  &addr,
+					},
+				},
+				Next: next.Bytes(),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			s.SetupTest()
+			tc.registerMock(&evmtypes.QueryAccountRangeRequest{
+				Pagination:     &query.PageRequest{Key: addr.Bytes(), Limit: 256},
+				IncludeCode:    true,
+				IncludeStorage: !tc.nostorage,
+			})
+
+			dump, err := s.backend.AccountRange(tc.blockNrOrHash, addr.Bytes(), 0, false, tc.nostorage)
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expDump, dump)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (s *TestSuite) TestGetBalance() {
 	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
 
@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/trie"
 	"google.golang.org/grpc/metadata"
 
@@ -15,11 +16,14 @@ import (
 	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	cmttypes "github.com/cometbft/cometbft/types"
 
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/indexer"
 	"github.com/cosmos/evm/rpc/backend/mocks"
 	ethrpc "github.com/cosmos/evm/rpc/types"
 	utiltx "github.com/cosmos/evm/testutil/tx"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
+	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -806,6 +810,18 @@ func (s *TestSuite) TestBlockNumberFromCometByHash() {
 			},
 			true,
 		},
+		{
+			"pass - pruned block with archived header hash",
+			common.BytesToHash(block.Hash()),
+			func(hash common.Hash) {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterHeaderByHashError(client, hash, bz)
+				resHeader = &cmtrpctypes.ResultHeader{Header: &cmttypes.Header{Height: 5}}
+				err := s.backend.Indexer.(*indexer.KVIndexer).IndexHeaderHash(5, hash)
+				s.Require().NoError(err)
+			},
+			true,
+		},
 	}
 	for _, tc := range testCases {
 		s.Run(fmt.Sprintf("Case %s", tc.name), func() {
@@ -1635,3 +1651,194 @@ func (s *TestSuite) TestEthBlockFromCometBlock() {
 		})
 	}
 }
+
+func (s *TestSuite) TestGetRawHeader() {
+	var expResultHeader *cmtrpctypes.ResultHeader
+
+	blockNum := ethrpc.BlockNumber(1)
+	blockHash := common.Hash{}
+	baseFee := math.NewInt(1)
+
+	testCases := []struct {
+		name          string
+		blockNrOrHash ethrpc.BlockNumberOrHash
+		registerMock  func()
+		expPass       bool
+	}{
+		{
+			"fail - CometBFT client failed to get header",
+			ethrpc.BlockNumberOrHash{BlockNumber: &blockNum},
+			func() {
+				height := blockNum.Int64()
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterHeaderError(client, &height)
+			},
+			false,
+		},
+		{
+			"fail - CometBFT client failed to get header by hash",
+			ethrpc.BlockNumberOrHash{BlockHash: &blockHash},
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterHeaderByHashError(client, blockHash, nil)
+			},
+			false,
+		},
+		{
+			"pass - by number",
+			ethrpc.BlockNumberOrHash{BlockNumber: &blockNum},
+			func() {
+				height := blockNum.Int64()
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				expResultHeader = RegisterHeader(client, &height, nil)
+				_, err := RegisterBlockResults(client, height)
+				s.Require().NoError(err)
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBaseFee(QueryClient, baseFee)
+			},
+			true,
+		},
+		{
+			"pass - by hash",
+			ethrpc.BlockNumberOrHash{BlockHash: &blockHash},
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				expResultHeader, _ = RegisterHeaderByHash(client, blockHash, nil)
+				_, err := RegisterBlockResults(client, 1)
+				s.Require().NoError(err)
+				QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterBaseFee(QueryClient, baseFee)
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+
+			tc.registerMock()
+			rawHeader, err := s.backend.GetRawHeader(tc.blockNrOrHash)
+
+			if tc.expPass {
+				s.Require().NoError(err)
+
+				expHeader := ethrpc.EthHeaderFromComet(*expResultHeader.Header, ethtypes.Bloom{}, baseFee.BigInt())
+				expRawHeader, err := rlp.EncodeToBytes(expHeader)
+				s.Require().NoError(err)
+				s.Require().Equal(hexutil.Bytes(expRawHeader), rawHeader)
+
+				var header ethtypes.Header
+				s.Require().NoError(rlp.DecodeBytes(rawHeader, &header))
+				s.Require().Equal(expHeader.Hash(), header.Hash())
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestGetRawReceipts() {
+	msgEthereumTx, _ := s.buildEthereumTx()
+	msgEthereumTx2, _ := s.buildEthereumTx()
+	txBz := s.signAndEncodeEthTx(msgEthereumTx)
+	txBz2 := s.signAndEncodeEthTx(msgEthereumTx2)
+
+	blockNum := ethrpc.BlockNumber(1)
+	block := cmttypes.MakeBlock(1, []cmttypes.Tx{txBz, txBz2}, nil, nil)
+	blockResults := []*types.ExecTxResult{
+		{
+			Code:    0,
+			GasUsed: 21000,
+			Events: []types.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []types.EventAttribute{
+					{Key: "ethereumTxHash", Value: msgEthereumTx.Hash().Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "txGasUsed", Value: "21000"},
+				}},
+			},
+		},
+		{
+			Code:    0,
+			GasUsed: 30000,
+			Events: []types.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []types.EventAttribute{
+					{Key: "ethereumTxHash", Value: msgEthereumTx2.Hash().Hex()},
+					{Key: "txIndex", Value: "1"},
+					{Key: "txGasUsed", Value: "30000"},
+				}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expReceipts  []*ethtypes.Receipt
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, blockNum.Int64())
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - block result not found",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlockMultipleTxs(client, blockNum.Int64(), []cmttypes.Tx{txBz, txBz2})
+				s.Require().NoError(err)
+				RegisterBlockResultsError(client, blockNum.Int64())
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - cumulative gas used accounts for the preceding txs",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlockMultipleTxs(client, blockNum.Int64(), []cmttypes.Tx{txBz, txBz2})
+				s.Require().NoError(err)
+				_, err = RegisterBlockResultsWithTxs(client, blockNum.Int64(), blockResults)
+				s.Require().NoError(err)
+			},
+			[]*ethtypes.Receipt{
+				{Type: ethtypes.LegacyTxType, Status: ethtypes.ReceiptStatusSuccessful, CumulativeGasUsed: 21000},
+				{Type: ethtypes.LegacyTxType, Status: ethtypes.ReceiptStatusSuccessful, CumulativeGasUsed: 51000},
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			s.backend.Indexer = indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), s.backend.ClientCtx)
+			err := s.backend.Indexer.IndexBlock(block, blockResults)
+			s.Require().NoError(err)
+
+			rawReceipts, err := s.backend.GetRawReceipts(ethrpc.BlockNumberOrHash{BlockNumber: &blockNum})
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Len(rawReceipts, len(tc.expReceipts))
+				for i, expReceipt := range tc.expReceipts {
+					expReceipt.Bloom = ethtypes.CreateBloom(expReceipt)
+					expRawReceipt, err := expReceipt.MarshalBinary()
+					s.Require().NoError(err)
+					s.Require().Equal(hexutil.Bytes(expRawReceipt), rawReceipts[i])
+
+					var receipt ethtypes.Receipt
+					s.Require().NoError(receipt.UnmarshalBinary(rawReceipts[i]))
+					s.Require().Equal(expReceipt.CumulativeGasUsed, receipt.CumulativeGasUsed)
+				}
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/cosmos/evm/rpc/backend/mocks"
@@ -469,7 +470,7 @@ func (s *TestSuite) TestDoCall() {
 			s.SetupTest() // reset test and queries
 			tc.registerMock()
 
-			msgEthTx, err := s.backend.DoCall(tc.callArgs, tc.blockNum)
+			msgEthTx, err := s.backend.DoCall(tc.callArgs, tc.blockNum, nil, nil)
 
 			if tc.expPass {
 				s.Require().Equal(tc.expEthTx, msgEthTx)
@@ -480,6 +481,80 @@ func (s *TestSuite) TestDoCall() {
 	}
 }
 
+func (s *TestSuite) TestSimulateV1() {
+	_, bz := s.buildEthereumTx()
+	toAddr := utiltx.GenerateAddress()
+	opts := evmtypes.SimOpts{
+		BlockStateCalls: []evmtypes.SimBlock{
+			{Calls: []evmtypes.TransactionArgs{{To: &toAddr}}},
+		},
+	}
+
+	header := &ethtypes.Header{
+		Number:     big.NewInt(2),
+		Difficulty: big.NewInt(0),
+		BaseFee:    big.NewInt(1),
+		Time:       12,
+	}
+	resultsBz, err := json.Marshal([]*evmtypes.SimBlockResult{
+		{
+			Header: header,
+			Calls:  []evmtypes.SimCallResult{{Status: hexutil.Uint64(ethtypes.ReceiptStatusSuccessful)}},
+		},
+	})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expPass      bool
+	}{
+		{
+			"fail - query error",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				height := int64(1)
+				RegisterHeader(client, &height, bz)
+				queryClient.On("SimulateV1", mock.Anything, mock.AnythingOfType("*types.QuerySimulateV1Request")).
+					Return(nil, errortypes.ErrInvalidRequest)
+			},
+			false,
+		},
+		{
+			"pass - simulated blocks returned",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				height := int64(1)
+				RegisterHeader(client, &height, bz)
+				queryClient.On("SimulateV1", mock.Anything, mock.AnythingOfType("*types.QuerySimulateV1Request")).
+					Return(&evmtypes.QuerySimulateV1Response{Data: resultsBz}, nil)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			blocks, err := s.backend.SimulateV1(opts, rpctypes.BlockNumber(1))
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Len(blocks, 1)
+				s.Require().Equal(header.Hash(), blocks[0]["hash"])
+				s.Require().Equal((*hexutil.Big)(header.Number), blocks[0]["number"])
+				s.Require().Len(blocks[0]["calls"], 1)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (s *TestSuite) TestGasPrice() {
 	defaultGasPrice := (*hexutil.Big)(big.NewInt(1))
 	height := int64(1)
@@ -16,6 +16,7 @@ import (
 	rpc "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/testutil/constants"
 	utiltx "github.com/cosmos/evm/testutil/tx"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
@@ -270,6 +271,26 @@ func (s *TestSuite) TestSuggestGasTipCap() {
 			big.NewInt(0),
 			true,
 		},
+		{
+			"pass - max base fee increase of the EIP-1559 fee model",
+			func() {
+				fQueryClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketParams(fQueryClient, 1)
+			},
+			big.NewInt(1_000_000_000),
+			big.NewInt(125_000_000),
+			true,
+		},
+		{
+			"fail - can't get params",
+			func() {
+				fQueryClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketParamsError(fQueryClient, 1)
+			},
+			big.NewInt(1_000_000_000),
+			nil,
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -602,3 +623,87 @@ func (s *TestSuite) TestFeeHistory() {
 		})
 	}
 }
+
+func (s *TestSuite) TestFeeHistoryFromRecords() {
+	baseFee := sdkmath.LegacyNewDec(100_000_000_000)
+	blockFee := feemarkettypes.BlockFee{
+		Height:    1,
+		BaseFee:   baseFee,
+		GasWanted: 5_000_000,
+		GasUsed:   2_500_000,
+		GasLimit:  10_000_000,
+	}
+
+	testCases := []struct {
+		name          string
+		registerMock  func()
+		expFeeHistory *rpc.FeeHistoryResult
+		expPass       bool
+	}{
+		{
+			"fail - not recorded, falls back to the blocks",
+			func() {
+				var header metadata.MD
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				fQueryClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterParams(queryClient, &header, 1)
+				RegisterFeeMarketFeeHistoryError(fQueryClient, 1, 1, 2)
+				RegisterBlockError(client, 1)
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - next base fee calculated from the gas wanted",
+			func() {
+				var header metadata.MD
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				fQueryClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterParams(queryClient, &header, 1)
+				RegisterFeeMarketFeeHistory(fQueryClient, 1, 1, 2, []feemarkettypes.BlockFee{blockFee})
+				RegisterFeeMarketParams(fQueryClient, 1)
+			},
+			&rpc.FeeHistoryResult{
+				OldestBlock:  (*hexutil.Big)(big.NewInt(1)),
+				BaseFee:      []*hexutil.Big{(*hexutil.Big)(baseFee.TruncateInt().BigInt()), (*hexutil.Big)(baseFee.TruncateInt().BigInt())},
+				GasUsedRatio: []float64{0.25},
+			},
+			true,
+		},
+		{
+			"pass - next base fee recorded",
+			func() {
+				var header metadata.MD
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				fQueryClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterParams(queryClient, &header, 1)
+				nextBlockFee := blockFee
+				nextBlockFee.Height = 2
+				nextBlockFee.BaseFee = sdkmath.LegacyNewDec(90_000_000_000)
+				RegisterFeeMarketFeeHistory(fQueryClient, 1, 1, 2, []feemarkettypes.BlockFee{blockFee, nextBlockFee})
+			},
+			&rpc.FeeHistoryResult{
+				OldestBlock:  (*hexutil.Big)(big.NewInt(1)),
+				BaseFee:      []*hexutil.Big{(*hexutil.Big)(baseFee.TruncateInt().BigInt()), (*hexutil.Big)(big.NewInt(90_000_000_000))},
+				GasUsedRatio: []float64{0.25},
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			s.backend.Cfg.JSONRPC.FeeHistoryCap = 2
+			tc.registerMock()
+
+			feeHistory, err := s.backend.FeeHistory(1, 1, nil)
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expFeeHistory, feeHistory)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
@@ -240,6 +240,27 @@ func RegisterBlockResultsWithTxs(
 	return res, nil
 }
 
+// RegisterBlockResultsWithCosmosCall registers the results of a block with an
+// EVM call executed in the end block, with the given hex encoded input.
+func RegisterBlockResultsWithCosmosCall(client *mocks.Client, height int64, input string) {
+	res := &cmtrpctypes.ResultBlockResults{
+		Height: height,
+		FinalizeBlockEvents: []abci.Event{{
+			Type: evmtypes.EventTypeCosmosCall,
+			Attributes: []abci.EventAttribute{
+				{Key: evmtypes.AttributeKeySender, Value: "0x0000000000000000000000000000000000000001"},
+				{Key: evmtypes.AttributeKeyRecipient, Value: "0x0000000000000000000000000000000000000002"},
+				{Key: evmtypes.AttributeKeyNonce, Value: "0"},
+				{Key: evmtypes.AttributeKeyGasLimit, Value: "100000"},
+				{Key: evmtypes.AttributeKeyInput, Value: input},
+				{Key: "mode", Value: rpc.CosmosCallPhaseEndBlock},
+			},
+		}},
+	}
+	client.On("BlockResults", rpc.ContextWithHeight(height), mock.AnythingOfType("*int64")).
+		Return(res, nil)
+}
+
 func RegisterBlockResultsError(client *mocks.Client, height int64) {
 	client.On("BlockResults", rpc.ContextWithHeight(height), mock.AnythingOfType("*int64")).
 		Return(nil, errortypes.ErrInvalidRequest)
@@ -264,6 +264,21 @@ func RegisterStorageAtError(queryClient *mocks.EVMQueryClient, addr common.Addre
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+func RegisterStorageRange(queryClient *mocks.EVMQueryClient, height int64, req *evmtypes.QueryStorageRangeRequest, res *evmtypes.QueryStorageRangeResponse) {
+	queryClient.On("StorageRange", rpc.ContextWithHeight(height), req).
+		Return(res, nil)
+}
+
+func RegisterAccountRange(queryClient *mocks.EVMQueryClient, height int64, req *evmtypes.QueryAccountRangeRequest, res *evmtypes.QueryAccountRangeResponse) {
+	queryClient.On("AccountRange", rpc.ContextWithHeight(height), req).
+		Return(res, nil)
+}
+
+func RegisterAccountRangeError(queryClient *mocks.EVMQueryClient, height int64, req *evmtypes.QueryAccountRangeRequest) {
+	queryClient.On("AccountRange", rpc.ContextWithHeight(height), req).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 func RegisterAccount(queryClient *mocks.EVMQueryClient, addr common.Address, height int64) {
 	queryClient.On("Account", rpc.ContextWithHeight(height), &evmtypes.QueryAccountRequest{Address: addr.String()}).
 		Return(&evmtypes.QueryAccountResponse{
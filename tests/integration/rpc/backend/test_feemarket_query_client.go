@@ -20,3 +20,13 @@ func RegisterFeeMarketParamsError(feeMarketClient *mocks.FeeMarketQueryClient, h
 	feeMarketClient.On("Params", rpc.ContextWithHeight(height), &feemarkettypes.QueryParamsRequest{}).
 		Return(nil, sdkerrors.ErrInvalidRequest)
 }
+
+func RegisterFeeMarketFeeHistory(feeMarketClient *mocks.FeeMarketQueryClient, height, fromHeight, toHeight int64, blockFees []feemarkettypes.BlockFee) {
+	feeMarketClient.On("FeeHistory", rpc.ContextWithHeight(height), &feemarkettypes.QueryFeeHistoryRequest{FromHeight: fromHeight, ToHeight: toHeight}).
+		Return(&feemarkettypes.QueryFeeHistoryResponse{BlockFees: blockFees}, nil)
+}
+
+func RegisterFeeMarketFeeHistoryError(feeMarketClient *mocks.FeeMarketQueryClient, height, fromHeight, toHeight int64) {
+	feeMarketClient.On("FeeHistory", rpc.ContextWithHeight(height), &feemarkettypes.QueryFeeHistoryRequest{FromHeight: fromHeight, ToHeight: toHeight}).
+		Return(nil, sdkerrors.ErrInvalidRequest)
+}
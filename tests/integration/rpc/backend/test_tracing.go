@@ -1,9 +1,11 @@
 package backend
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	mock "github.com/stretchr/testify/mock"
 
@@ -218,6 +220,7 @@ func (s *TestSuite) TestTraceBlock() {
 	filledBlock.ChainID = ChainID.ChainID
 	resBlockEmpty := tmrpctypes.ResultBlock{Block: emptyBlock, BlockID: emptyBlock.LastBlockID}
 	resBlockFilled := tmrpctypes.ResultBlock{Block: filledBlock, BlockID: filledBlock.LastBlockID}
+	callInput := []byte{0x01, 0x02}
 
 	testCases := []struct {
 		name            string
@@ -281,6 +284,43 @@ func (s *TestSuite) TestTraceBlock() {
 			&rpctypes.TraceConfig{},
 			true,
 		},
+		{
+			"pass - end block cosmos call of an empty block",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterBlockResultsWithCosmosCall(client, 1, hexutil.Encode(callInput))
+				RegisterConsensusParams(client, 1)
+				traceResult := &evmtypes.QueryTraceBlockResponse{
+					Data: []byte(`[{"result": "trace1", "cosmosMsg": {"txIndex": 0, "msgIndex": 0, "phase": "EndBlock"}}]`),
+				}
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				queryClient.On("TraceBlock", mock.Anything, mock.MatchedBy(func(req *evmtypes.QueryTraceBlockRequest) bool {
+					return len(req.CosmosCalls) == 1 &&
+						bytes.Equal(req.CosmosCalls[0].Data, callInput) &&
+						req.CosmosCalls[0].Phase == rpctypes.CosmosCallPhaseEndBlock
+				})).
+					Return(traceResult, nil).
+					Once()
+			},
+			[]*evmtypes.TxTraceResult{{
+				Result:    "trace1",
+				CosmosMsg: &evmtypes.CosmosMsgRef{Phase: rpctypes.CosmosCallPhaseEndBlock},
+			}},
+			&resBlockEmpty,
+			&rpctypes.TraceConfig{TraceConfig: evmtypes.TraceConfig{IncludeCosmosCalls: true}},
+			true,
+		},
+		{
+			"fail - invalid cosmos call input",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterBlockResultsWithCosmosCall(client, 1, "0xzz")
+			},
+			nil,
+			&resBlockEmpty,
+			&rpctypes.TraceConfig{TraceConfig: evmtypes.TraceConfig{IncludeCosmosCalls: true}},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -299,3 +339,79 @@ func (s *TestSuite) TestTraceBlock() {
 		})
 	}
 }
+
+func (s *TestSuite) TestTraceCall() {
+	block := types.MakeBlock(1, []types.Tx{}, nil, nil)
+	block.ChainID = ChainID.ChainID
+	resBlock := tmrpctypes.ResultBlock{Block: block, BlockID: block.LastBlockID}
+	to := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	args := evmtypes.TransactionArgs{To: &to}
+
+	testCases := []struct {
+		name           string
+		registerMock   func()
+		config         *rpctypes.TraceCallConfig
+		expTraceResult interface{}
+		expPass        bool
+	}{
+		{
+			"fail - consensus params returns error",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterConsensusParamsError(client, 1)
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"fail - trace call query returns error",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterConsensusParams(client, 1)
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				queryClient.On("TraceCall", mock.Anything, mock.AnythingOfType("*types.QueryTraceCallRequest")).
+					Return(nil, fmt.Errorf("trace call failed"))
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"pass - with state overrides",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterConsensusParams(client, 1)
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				queryClient.On("TraceCall", mock.Anything, mock.MatchedBy(func(req *evmtypes.QueryTraceCallRequest) bool {
+					return req.BlockNumber == 1 && len(req.Overrides) > 0 && req.TraceConfig.Tracer == "callTracer"
+				})).
+					Return(&evmtypes.QueryTraceCallResponse{Data: []byte(`{"type":"CALL"}`)}, nil)
+			},
+			&rpctypes.TraceCallConfig{
+				TraceConfig: rpctypes.TraceConfig{
+					TraceConfig: evmtypes.TraceConfig{Tracer: "callTracer"},
+				},
+				StateOverrides: &rpctypes.StateOverride{to: {}},
+			},
+			map[string]interface{}{"type": "CALL"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("case %s", tc.name), func() {
+			s.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			traceResult, err := s.backend.TraceCall(args, tc.config, &resBlock)
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expTraceResult, traceResult)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/metadata"
 
@@ -709,6 +710,104 @@ func (s *TestSuite) TestGetTransactionReceipt() {
 	}
 }
 
+func (s *TestSuite) TestGetRawTransaction() {
+	msgEthereumTx, _ := s.buildEthereumTx()
+	txBz := s.signAndEncodeEthTx(msgEthereumTx)
+	expRawTx, err := msgEthereumTx.AsTransaction().MarshalBinary()
+	s.Require().NoError(err)
+
+	block := &types.Block{Header: types.Header{Height: 1}, Data: types.Data{Txs: []types.Tx{txBz}}}
+	blockResult := []*abci.ExecTxResult{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: msgEthereumTx.Hash().Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "txGasUsed", Value: "21000"},
+				}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		indexed      bool
+		expRawTx     hexutil.Bytes
+		expPass      bool
+	}{
+		{
+			"pass - tx not found returns nil",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil, nil)
+			},
+			false,
+			nil,
+			true,
+		},
+		{
+			"pass - pending tx",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil, types.Txs{txBz})
+			},
+			false,
+			expRawTx,
+			true,
+		},
+		{
+			"fail - block not found",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			true,
+			nil,
+			false,
+		},
+		{
+			"pass - indexed tx",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, txBz)
+				s.Require().NoError(err)
+			},
+			true,
+			expRawTx,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			tc.registerMock()
+
+			if tc.indexed {
+				s.backend.Indexer = indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), s.backend.ClientCtx)
+				err := s.backend.Indexer.IndexBlock(block, blockResult)
+				s.Require().NoError(err)
+			}
+
+			rawTx, err := s.backend.GetRawTransaction(msgEthereumTx.Hash())
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expRawTx, rawTx)
+				if rawTx != nil {
+					var tx ethtypes.Transaction
+					s.Require().NoError(tx.UnmarshalBinary(rawTx))
+					s.Require().Equal(msgEthereumTx.Hash(), tx.Hash())
+				}
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (s *TestSuite) TestGetGasUsed() {
 	origin := s.backend.Cfg.JSONRPC.FixRevertGasRefundHeight
 	testCases := []struct {
@@ -1,6 +1,7 @@
 package erc20
 
 import (
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/evm/testutil/integration/evm/network"
@@ -214,3 +215,37 @@ func (s *GenesisTestSuite) TestErc20ExportGenesis() {
 		})
 	}
 }
+
+func (s *GenesisTestSuite) TestErc20ExportImportPermitNonces() {
+	erc20Addr := common.HexToAddress(osmoERC20ContractAddr)
+	owner := utiltx.GenerateAddress()
+	otherOwner := utiltx.GenerateAddress()
+
+	ctx := s.network.GetContext()
+	erc20Keeper := s.network.App.GetErc20Keeper()
+	erc20Keeper.IncrementPermitNonce(ctx, erc20Addr, owner)
+	erc20Keeper.IncrementPermitNonce(ctx, erc20Addr, owner)
+	erc20Keeper.IncrementPermitNonce(ctx, erc20Addr, otherOwner)
+
+	genesisExported := erc20.ExportGenesis(ctx, *erc20Keeper)
+	s.Require().ElementsMatch([]types.PermitNonce{
+		types.NewPermitNonce(erc20Addr, owner, 2),
+		types.NewPermitNonce(erc20Addr, otherOwner, 1),
+	}, genesisExported.PermitNonces)
+	s.Require().NoError(genesisExported.Validate())
+
+	// import the exported nonces on a new chain, whose token pairs are
+	// already set on its own genesis
+	s.SetupTest()
+	ctx = s.network.GetContext()
+	erc20Keeper = s.network.App.GetErc20Keeper()
+	s.Require().Equal(uint64(0), erc20Keeper.GetPermitNonce(ctx, erc20Addr, owner))
+
+	erc20.InitGenesis(ctx, *erc20Keeper, s.network.App.GetAccountKeeper(), types.GenesisState{
+		Params:       genesisExported.Params,
+		PermitNonces: genesisExported.PermitNonces,
+	})
+	s.Require().Equal(uint64(2), erc20Keeper.GetPermitNonce(ctx, erc20Addr, owner))
+	s.Require().Equal(uint64(1), erc20Keeper.GetPermitNonce(ctx, erc20Addr, otherOwner))
+	s.Require().ElementsMatch(genesisExported.PermitNonces, erc20.ExportGenesis(ctx, *erc20Keeper).PermitNonces)
+}
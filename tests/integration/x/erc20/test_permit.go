@@ -0,0 +1,28 @@
+package erc20
+
+import (
+	utiltx "github.com/cosmos/evm/testutil/tx"
+)
+
+func (s *KeeperTestSuite) TestPermitNonce() {
+	s.SetupTest()
+
+	var (
+		ctx        = s.network.GetContext()
+		erc20Addr  = utiltx.GenerateAddress()
+		erc20Addr2 = utiltx.GenerateAddress()
+		owner      = utiltx.GenerateAddress()
+		owner2     = utiltx.GenerateAddress()
+	)
+
+	keeper := s.network.App.GetErc20Keeper()
+	s.Require().Zero(keeper.GetPermitNonce(ctx, erc20Addr, owner))
+
+	keeper.IncrementPermitNonce(ctx, erc20Addr, owner)
+	keeper.IncrementPermitNonce(ctx, erc20Addr, owner)
+	s.Require().Equal(uint64(2), keeper.GetPermitNonce(ctx, erc20Addr, owner))
+
+	// nonces are tracked per token and per owner
+	s.Require().Zero(keeper.GetPermitNonce(ctx, erc20Addr2, owner))
+	s.Require().Zero(keeper.GetPermitNonce(ctx, erc20Addr, owner2))
+}
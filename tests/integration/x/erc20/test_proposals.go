@@ -1,7 +1,9 @@
 package erc20
 
 import (
+	"bytes"
 	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/mock"
@@ -14,6 +16,8 @@ import (
 	erc20mocks "github.com/cosmos/evm/x/erc20/types/mocks"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -331,3 +335,161 @@ func (s *KeeperTestSuite) TestToggleConverision() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestDeregisterTokenPair() {
+	var (
+		ctx       sdk.Context
+		token     string
+		authority string
+	)
+	govAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	// a 32 bytes address, which coins can't be converted to ERC20 tokens
+	nonEVMHolder := sdk.AccAddress(bytes.Repeat([]byte{1}, 32))
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		postCheck func()
+		expPass   bool
+	}{
+		{
+			"fail - invalid authority",
+			func() {
+				pair, err := s.network.App.GetErc20Keeper().RegisterERC20Extension(ctx, ibcBase)
+				s.Require().NoError(err)
+				token = pair.Erc20Address
+				authority = s.keyring.GetAccAddr(0).String()
+			},
+			func() {},
+			false,
+		},
+		{
+			"fail - token not registered",
+			func() {
+				token = ibcBase
+			},
+			func() {},
+			false,
+		},
+		{
+			"pass - native coin pair, precompile and allowances removed",
+			func() {
+				pair, err := s.network.App.GetErc20Keeper().RegisterERC20Extension(ctx, ibcBase)
+				s.Require().NoError(err)
+				token = pair.Denom
+
+				err = s.network.App.GetErc20Keeper().SetAllowance(ctx, pair.GetERC20Contract(), s.keyring.GetAddr(0), s.keyring.GetAddr(1), big.NewInt(100))
+				s.Require().NoError(err)
+			},
+			func() {
+				pair, err := types.NewTokenPairSTRv2(ibcBase)
+				s.Require().NoError(err)
+				contract := pair.GetERC20Contract()
+
+				s.Require().False(s.network.App.GetErc20Keeper().IsDenomRegistered(ctx, ibcBase))
+				s.Require().False(s.network.App.GetErc20Keeper().IsDynamicPrecompileAvailable(ctx, contract))
+				s.Require().Empty(s.network.App.GetErc20Keeper().GetAllowances(ctx))
+
+				acc := s.network.App.GetEVMKeeper().GetAccountWithoutBalance(ctx, contract)
+				s.Require().NotNil(acc)
+				s.Require().False(acc.IsContract())
+			},
+			true,
+		},
+		{
+			"pass - native ERC20 pair, converted coins swept back",
+			func() {
+				contractAddr, err := s.setupRegisterERC20Pair(contractMinterBurner)
+				s.Require().NoError(err)
+				_, err = s.MintERC20Token(contractAddr, s.keyring.GetAddr(0), big.NewInt(100))
+				s.Require().NoError(err)
+
+				ctx = s.network.GetContext()
+				token = contractAddr.Hex()
+
+				_, err = s.network.App.GetErc20Keeper().ConvertERC20(ctx, types.NewMsgConvertERC20(
+					math.NewInt(40), s.keyring.GetAccAddr(0), contractAddr, s.keyring.GetAddr(0),
+				))
+				s.Require().NoError(err)
+			},
+			func() {
+				contractAddr := common.HexToAddress(token)
+				denom := types.CreateDenom(contractAddr.String())
+
+				s.Require().False(s.network.App.GetErc20Keeper().IsERC20Registered(ctx, contractAddr))
+				s.Require().True(s.network.App.GetBankKeeper().GetSupply(ctx, denom).IsZero())
+
+				erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+				balance := s.network.App.GetErc20Keeper().BalanceOf(ctx, erc20, contractAddr, s.keyring.GetAddr(0))
+				s.Require().Equal(big.NewInt(100), balance)
+				balance = s.network.App.GetErc20Keeper().BalanceOf(ctx, erc20, contractAddr, types.ModuleAddress)
+				s.Require().Equal(int64(0), balance.Int64())
+			},
+			true,
+		},
+		{
+			"pass - native ERC20 pair, coins held by non-EVM address skipped",
+			func() {
+				contractAddr, err := s.setupRegisterERC20Pair(contractMinterBurner)
+				s.Require().NoError(err)
+				_, err = s.MintERC20Token(contractAddr, s.keyring.GetAddr(0), big.NewInt(100))
+				s.Require().NoError(err)
+
+				ctx = s.network.GetContext()
+				token = contractAddr.Hex()
+
+				_, err = s.network.App.GetErc20Keeper().ConvertERC20(ctx, types.NewMsgConvertERC20(
+					math.NewInt(40), s.keyring.GetAccAddr(0), contractAddr, s.keyring.GetAddr(0),
+				))
+				s.Require().NoError(err)
+
+				denom := types.CreateDenom(contractAddr.String())
+				err = s.network.App.GetBankKeeper().SendCoins(
+					ctx, s.keyring.GetAccAddr(0), nonEVMHolder, sdk.NewCoins(sdk.NewInt64Coin(denom, 10)),
+				)
+				s.Require().NoError(err)
+			},
+			func() {
+				contractAddr := common.HexToAddress(token)
+				denom := types.CreateDenom(contractAddr.String())
+
+				s.Require().False(s.network.App.GetErc20Keeper().IsERC20Registered(ctx, contractAddr))
+				s.Require().Equal(int64(10), s.network.App.GetBankKeeper().GetSupply(ctx, denom).Amount.Int64())
+				s.Require().Equal(int64(10), s.network.App.GetBankKeeper().GetBalance(ctx, nonEVMHolder, denom).Amount.Int64())
+
+				erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+				balance := s.network.App.GetErc20Keeper().BalanceOf(ctx, erc20, contractAddr, s.keyring.GetAddr(0))
+				s.Require().Equal(big.NewInt(90), balance)
+				balance = s.network.App.GetErc20Keeper().BalanceOf(ctx, erc20, contractAddr, types.ModuleAddress)
+				s.Require().Equal(big.NewInt(10), balance)
+
+				skipped := false
+				for _, event := range ctx.EventManager().Events() {
+					if event.Type == types.EventTypeSkipTokenPairBalance {
+						skipped = true
+					}
+				}
+				s.Require().True(skipped, "expected the non-EVM holder to be skipped")
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			s.SetupTest() // reset
+			ctx = s.network.GetContext()
+			authority = govAuthority
+
+			tc.malleate()
+
+			res, err := s.network.App.GetErc20Keeper().DeregisterTokenPair(ctx, &types.MsgDeregisterTokenPair{Authority: authority, Token: token})
+			if tc.expPass {
+				s.Require().NoError(err, tc.name)
+				s.Require().NotNil(res)
+				tc.postCheck()
+			} else {
+				s.Require().Error(err, tc.name)
+			}
+		})
+	}
+}
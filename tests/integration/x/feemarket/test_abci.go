@@ -2,6 +2,7 @@ package feemarket
 
 import (
 	"github.com/cosmos/evm/testutil/integration/evm/network"
+	"github.com/cosmos/evm/x/feemarket/types"
 
 	storetypes "cosmossdk.io/store/types"
 
@@ -15,16 +16,20 @@ func (s *KeeperTestSuite) TestEndBlock() {
 	)
 
 	testCases := []struct {
-		name         string
-		NoBaseFee    bool
-		malleate     func()
-		expGasWanted uint64
+		name               string
+		NoBaseFee          bool
+		malleate           func()
+		expGasWanted       uint64
+		expEVMGasWanted    uint64
+		expCosmosGasWanted uint64
 	}{
 		{
 			"baseFee nil",
 			true,
 			func() {},
 			uint64(0),
+			uint64(0),
+			uint64(0),
 		},
 		{
 			"pass",
@@ -35,6 +40,39 @@ func (s *KeeperTestSuite) TestEndBlock() {
 				nw.App.GetFeeMarketKeeper().SetTransientBlockGasWanted(ctx, 5000000)
 			},
 			uint64(2500000),
+			uint64(0),
+			uint64(0),
+		},
+		{
+			"pass - gas wanted of the gas lanes",
+			false,
+			func() {
+				meter := storetypes.NewGasMeter(uint64(1000000000))
+				ctx = ctx.WithBlockGasMeter(meter)
+				_, err := nw.App.GetFeeMarketKeeper().AddTransientGasWanted(ctx, 3000000, types.GasLaneEVM)
+				s.Require().NoError(err)
+				_, err = nw.App.GetFeeMarketKeeper().AddTransientGasWanted(ctx, 2000000, types.GasLaneCosmos)
+				s.Require().NoError(err)
+			},
+			uint64(2500000),
+			uint64(1500000),
+			uint64(1000000),
+		},
+		{
+			"pass - gas used apportioned to the gas lanes",
+			false,
+			func() {
+				meter := storetypes.NewGasMeter(uint64(1000000000))
+				meter.ConsumeGas(4000000, "test")
+				ctx = ctx.WithBlockGasMeter(meter)
+				_, err := nw.App.GetFeeMarketKeeper().AddTransientGasWanted(ctx, 3000000, types.GasLaneEVM)
+				s.Require().NoError(err)
+				_, err = nw.App.GetFeeMarketKeeper().AddTransientGasWanted(ctx, 2000000, types.GasLaneCosmos)
+				s.Require().NoError(err)
+			},
+			uint64(4000000),
+			uint64(2400000),
+			uint64(1600000),
 		},
 	}
 	for _, tc := range testCases {
@@ -56,6 +94,20 @@ func (s *KeeperTestSuite) TestEndBlock() {
 
 			gasWanted := nw.App.GetFeeMarketKeeper().GetBlockGasWanted(ctx)
 			s.Equal(tc.expGasWanted, gasWanted, tc.name)
+			s.Equal(tc.expEVMGasWanted, nw.App.GetFeeMarketKeeper().GetBlockLaneGasWanted(ctx, types.GasLaneEVM), tc.name)
+			s.Equal(tc.expCosmosGasWanted, nw.App.GetFeeMarketKeeper().GetBlockLaneGasWanted(ctx, types.GasLaneCosmos), tc.name)
+
+			blockFee, found := nw.App.GetFeeMarketKeeper().GetBlockFee(ctx, ctx.BlockHeight())
+			s.Require().True(found)
+			s.Equal(ctx.BlockHeight(), blockFee.Height)
+			s.Equal(tc.expGasWanted, blockFee.GasWanted)
+			s.Equal(tc.expEVMGasWanted, blockFee.EvmGasWanted)
+			s.Equal(tc.expCosmosGasWanted, blockFee.CosmosGasWanted)
+			if tc.NoBaseFee {
+				s.True(blockFee.BaseFee.IsZero())
+			} else {
+				s.Equal(params.BaseFee, blockFee.BaseFee)
+			}
 		})
 	}
 }
@@ -136,6 +136,173 @@ func (s *KeeperTestSuite) TestCalculateBaseFee() {
 	}
 }
 
+func (s *KeeperTestSuite) TestCalculateBaseFeeAIMD() {
+	var (
+		nw  *network.UnitTestNetwork
+		ctx sdk.Context
+	)
+
+	testCases := []struct {
+		name                 string
+		parentBlockGasWanted uint64
+		minGasPrice          math.LegacyDec
+		expFee               func(params feemarkettypes.Params) math.LegacyDec
+	}{
+		{
+			"parent block wanted the same gas as its target",
+			50,
+			math.LegacyZeroDec(),
+			func(params feemarkettypes.Params) math.LegacyDec { return params.BaseFee },
+		},
+		{
+			"parent block wanted more gas than its target",
+			100,
+			math.LegacyZeroDec(),
+			func(params feemarkettypes.Params) math.LegacyDec { return params.BaseFee.Add(params.AdditiveIncrease) },
+		},
+		{
+			"parent block wanted less gas than its target",
+			25,
+			math.LegacyZeroDec(),
+			func(params feemarkettypes.Params) math.LegacyDec {
+				return params.BaseFee.Mul(params.MultiplicativeDecrease)
+			},
+		},
+		{
+			"parent block wanted less gas than its target, with higher min gas price",
+			25,
+			math.LegacyNewDec(950000000),
+			func(feemarkettypes.Params) math.LegacyDec { return math.LegacyNewDec(950000000) },
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// reset network and context
+			nw = network.NewUnitTestNetwork(s.create, s.options...)
+			ctx = nw.GetContext()
+
+			params := nw.App.GetFeeMarketKeeper().GetParams(ctx)
+			params.FeeModel = feemarkettypes.FeeModelAIMD
+			params.MinGasPrice = tc.minGasPrice
+			err := nw.App.GetFeeMarketKeeper().SetParams(ctx, params)
+			s.NoError(err)
+
+			ctx = ctx.WithBlockHeight(1)
+			nw.App.GetFeeMarketKeeper().SetBlockGasWanted(ctx, tc.parentBlockGasWanted)
+			ctx = ctx.WithConsensusParams(tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: 100, MaxBytes: 10}})
+
+			fee := nw.App.GetFeeMarketKeeper().CalculateBaseFee(ctx)
+			s.Equal(tc.expFee(params), fee)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCalculateBaseFeeGasLanes() {
+	var (
+		nw  *network.UnitTestNetwork
+		ctx sdk.Context
+	)
+
+	// the block gas limit is 100, the EVM lane target is 50 and the Cosmos lane
+	// target is 100
+	testCases := []struct {
+		name                  string
+		parentEVMGasWanted    uint64
+		parentCosmosGasWanted uint64
+		expFee                func(params feemarkettypes.Params) math.LegacyDec
+		expCosmosFee          func(params feemarkettypes.Params) math.LegacyDec
+	}{
+		{
+			"both lanes wanted less gas than their target",
+			10,
+			90,
+			func(params feemarkettypes.Params) math.LegacyDec {
+				return params.BaseFee.Mul(params.MultiplicativeDecrease)
+			},
+			func(params feemarkettypes.Params) math.LegacyDec {
+				return params.BaseFee.Mul(params.MultiplicativeDecrease)
+			},
+		},
+		{
+			"evm lane wanted more gas than its target",
+			60,
+			0,
+			func(params feemarkettypes.Params) math.LegacyDec { return params.BaseFee.Add(params.AdditiveIncrease) },
+			func(params feemarkettypes.Params) math.LegacyDec {
+				return params.BaseFee.Mul(params.MultiplicativeDecrease)
+			},
+		},
+		{
+			"cosmos lane wanted the same gas as its target",
+			10,
+			100,
+			func(params feemarkettypes.Params) math.LegacyDec {
+				return params.BaseFee.Mul(params.MultiplicativeDecrease)
+			},
+			func(params feemarkettypes.Params) math.LegacyDec { return params.BaseFee },
+		},
+		{
+			"cosmos lane congestion doesn't raise the base fee",
+			10,
+			150,
+			func(params feemarkettypes.Params) math.LegacyDec {
+				return params.BaseFee.Mul(params.MultiplicativeDecrease)
+			},
+			func(params feemarkettypes.Params) math.LegacyDec { return params.BaseFee.Add(params.AdditiveIncrease) },
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// reset network and context
+			nw = network.NewUnitTestNetwork(s.create, s.options...)
+			ctx = nw.GetContext()
+
+			params := nw.App.GetFeeMarketKeeper().GetParams(ctx)
+			params.FeeModel = feemarkettypes.FeeModelAIMD
+			params.MinGasPrice = math.LegacyZeroDec()
+			params.EvmElasticityMultiplier = 2
+			params.CosmosElasticityMultiplier = 1
+			err := nw.App.GetFeeMarketKeeper().SetParams(ctx, params)
+			s.NoError(err)
+
+			ctx = ctx.WithBlockHeight(1)
+			nw.App.GetFeeMarketKeeper().SetBlockGasWanted(ctx, tc.parentEVMGasWanted+tc.parentCosmosGasWanted)
+			nw.App.GetFeeMarketKeeper().SetBlockLaneGasWanted(ctx, feemarkettypes.GasLaneEVM, tc.parentEVMGasWanted)
+			nw.App.GetFeeMarketKeeper().SetBlockLaneGasWanted(ctx, feemarkettypes.GasLaneCosmos, tc.parentCosmosGasWanted)
+			ctx = ctx.WithConsensusParams(tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: 100, MaxBytes: 10}})
+
+			fee := nw.App.GetFeeMarketKeeper().CalculateBaseFee(ctx)
+			s.Equal(tc.expFee(params), fee)
+			cosmosFee := nw.App.GetFeeMarketKeeper().CalculateCosmosBaseFee(ctx)
+			s.Equal(tc.expCosmosFee(params), cosmosFee)
+
+			// the begin block prices each lane from its own base fee
+			s.Require().NoError(nw.App.GetFeeMarketKeeper().BeginBlock(ctx))
+			s.Equal(tc.expFee(params), nw.App.GetFeeMarketKeeper().GetBaseFee(ctx))
+			s.Equal(tc.expCosmosFee(params), nw.App.GetFeeMarketKeeper().GetCosmosBaseFee(ctx))
+		})
+	}
+
+	s.Run("cosmos base fee is the base fee without separate gas lanes", func() {
+		nw = network.NewUnitTestNetwork(s.create, s.options...)
+		ctx = nw.GetContext()
+
+		params := nw.App.GetFeeMarketKeeper().GetParams(ctx)
+		s.False(params.HasSeparateGasLanes())
+		s.True(nw.App.GetFeeMarketKeeper().CalculateCosmosBaseFee(ctx).IsNil())
+
+		// a stale Cosmos base fee is ignored and removed by the begin block
+		nw.App.GetFeeMarketKeeper().SetCosmosBaseFee(ctx, params.BaseFee.MulInt64(2))
+		s.Equal(params.BaseFee, nw.App.GetFeeMarketKeeper().GetCosmosBaseFee(ctx))
+		s.Require().NoError(nw.App.GetFeeMarketKeeper().BeginBlock(ctx))
+
+		params.EvmElasticityMultiplier = 2
+		params.CosmosElasticityMultiplier = 1
+		s.Require().NoError(nw.App.GetFeeMarketKeeper().SetParams(ctx, params))
+		s.Equal(nw.App.GetFeeMarketKeeper().GetBaseFee(ctx), nw.App.GetFeeMarketKeeper().GetCosmosBaseFee(ctx))
+	})
+}
+
 func (s *KeeperTestSuite) TestCalculateBaseFeeEdgeCases() {
 	var (
 		nw  *network.UnitTestNetwork
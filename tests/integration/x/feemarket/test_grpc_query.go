@@ -61,7 +61,7 @@ func (s *KeeperTestSuite) TestQueryBaseFee() {
 		{
 			"pass - default Base Fee",
 			func() {
-				expRes = &types.QueryBaseFeeResponse{BaseFee: &initialBaseFee}
+				expRes = &types.QueryBaseFeeResponse{BaseFee: &initialBaseFee, CosmosBaseFee: &initialBaseFee}
 			},
 			true,
 		},
@@ -71,7 +71,21 @@ func (s *KeeperTestSuite) TestQueryBaseFee() {
 				baseFee := sdkmath.LegacyNewDec(1)
 				nw.App.GetFeeMarketKeeper().SetBaseFee(ctx, baseFee)
 
-				expRes = &types.QueryBaseFeeResponse{BaseFee: &baseFee}
+				expRes = &types.QueryBaseFeeResponse{BaseFee: &baseFee, CosmosBaseFee: &baseFee}
+			},
+			true,
+		},
+		{
+			"pass - separate Cosmos Base Fee",
+			func() {
+				params := nw.App.GetFeeMarketKeeper().GetParams(ctx)
+				params.EvmElasticityMultiplier = 2
+				params.CosmosElasticityMultiplier = 1
+				s.Require().NoError(nw.App.GetFeeMarketKeeper().SetParams(ctx, params))
+				cosmosBaseFee := sdkmath.LegacyNewDec(3)
+				nw.App.GetFeeMarketKeeper().SetCosmosBaseFee(ctx, cosmosBaseFee)
+
+				expRes = &types.QueryBaseFeeResponse{BaseFee: &initialBaseFee, CosmosBaseFee: &cosmosBaseFee}
 			},
 			true,
 		},
@@ -119,8 +133,11 @@ func (s *KeeperTestSuite) TestQueryBlockGas() {
 			ctx = nw.GetContext()
 			qc := nw.GetFeeMarketClient()
 
+			nw.App.GetFeeMarketKeeper().SetBlockLaneGasWanted(ctx, types.GasLaneEVM, 3000)
+			nw.App.GetFeeMarketKeeper().SetBlockLaneGasWanted(ctx, types.GasLaneCosmos, 2000)
+
 			gas := nw.App.GetFeeMarketKeeper().GetBlockGasWanted(ctx)
-			exp := &types.QueryBlockGasResponse{Gas: int64(gas)} //#nosec G115
+			exp := &types.QueryBlockGasResponse{Gas: int64(gas), EvmGas: 3000, CosmosGas: 2000} //#nosec G115
 
 			res, err := qc.BlockGas(ctx.Context(), &types.QueryBlockGasRequest{})
 			if tc.expPass {
@@ -132,3 +149,151 @@ func (s *KeeperTestSuite) TestQueryBlockGas() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestQueryBaseFeeAt() {
+	var (
+		nw  *network.UnitTestNetwork
+		ctx sdk.Context
+		req *types.QueryBaseFeeAtRequest
+	)
+	baseFee := sdkmath.LegacyNewDec(7)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - invalid height",
+			func() {
+				req = &types.QueryBaseFeeAtRequest{Height: 0}
+			},
+			false,
+		},
+		{
+			"fail - height not recorded",
+			func() {
+				req = &types.QueryBaseFeeAtRequest{Height: ctx.BlockHeight() + 10}
+			},
+			false,
+		},
+		{
+			"pass",
+			func() {
+				nw.App.GetFeeMarketKeeper().SetBlockFee(ctx, types.BlockFee{Height: ctx.BlockHeight() + 10, BaseFee: baseFee})
+				req = &types.QueryBaseFeeAtRequest{Height: ctx.BlockHeight() + 10}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// reset network and context
+			nw = network.NewUnitTestNetwork(s.create, s.options...)
+			ctx = nw.GetContext()
+			qc := nw.GetFeeMarketClient()
+
+			tc.malleate()
+
+			res, err := qc.BaseFeeAt(ctx.Context(), req)
+			if tc.expPass {
+				s.NoError(err)
+				s.Equal(&types.QueryBaseFeeAtResponse{BaseFee: baseFee}, res, tc.name)
+			} else {
+				s.Error(err)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryFeeHistory() {
+	var (
+		nw  *network.UnitTestNetwork
+		ctx sdk.Context
+		req *types.QueryFeeHistoryRequest
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expHeight []int64
+		expPass   bool
+	}{
+		{
+			"fail - invalid from height",
+			func() {
+				req = &types.QueryFeeHistoryRequest{FromHeight: 0, ToHeight: 1}
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - from height after to height",
+			func() {
+				req = &types.QueryFeeHistoryRequest{FromHeight: 2, ToHeight: 1}
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - range exceeds the fee history window",
+			func() {
+				req = &types.QueryFeeHistoryRequest{FromHeight: 1, ToHeight: 1 + types.FeeHistoryWindow}
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - blocks out of the fee history are omitted",
+			func() {
+				height := ctx.BlockHeight()
+				for _, h := range []int64{height + 10, height + 12} {
+					nw.App.GetFeeMarketKeeper().SetBlockFee(ctx, types.BlockFee{Height: h, BaseFee: sdkmath.LegacyNewDec(h)})
+				}
+				req = &types.QueryFeeHistoryRequest{FromHeight: height + 10, ToHeight: height + 13}
+			},
+			[]int64{10, 12},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// reset network and context
+			nw = network.NewUnitTestNetwork(s.create, s.options...)
+			ctx = nw.GetContext()
+			qc := nw.GetFeeMarketClient()
+
+			tc.malleate()
+
+			res, err := qc.FeeHistory(ctx.Context(), req)
+			if tc.expPass {
+				s.NoError(err)
+				s.Require().Len(res.BlockFees, len(tc.expHeight))
+				for i, blockFee := range res.BlockFees {
+					height := ctx.BlockHeight() + tc.expHeight[i]
+					s.Equal(height, blockFee.Height)
+					s.Equal(sdkmath.LegacyNewDec(height), blockFee.BaseFee)
+				}
+			} else {
+				s.Error(err)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryBurnedBaseFee() {
+	nw := network.NewUnitTestNetwork(s.create, s.options...)
+	ctx := nw.GetContext()
+	qc := nw.GetFeeMarketClient()
+
+	res, err := qc.BurnedBaseFee(ctx.Context(), &types.QueryBurnedBaseFeeRequest{})
+	s.Require().NoError(err)
+	s.Require().True(res.Burned.IsZero())
+
+	nw.App.GetFeeMarketKeeper().AddBurnedBaseFee(ctx, sdkmath.LegacyNewDec(42))
+
+	qc = nw.GetFeeMarketClient()
+	res, err = qc.BurnedBaseFee(ctx.Context(), &types.QueryBurnedBaseFeeRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(&types.QueryBurnedBaseFeeResponse{Burned: sdkmath.LegacyNewDec(42)}, res)
+}
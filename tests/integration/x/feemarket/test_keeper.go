@@ -2,6 +2,7 @@ package feemarket
 
 import (
 	"github.com/cosmos/evm/testutil/integration/evm/network"
+	"github.com/cosmos/evm/x/feemarket/types"
 
 	"cosmossdk.io/math"
 
@@ -72,3 +73,107 @@ func (s *KeeperTestSuite) TestSetGetGasFee() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestSetGetBlockFee() {
+	var (
+		nw  *network.UnitTestNetwork
+		ctx sdk.Context
+	)
+	blockFee := types.BlockFee{
+		Height:    100,
+		BaseFee:   math.LegacyNewDec(10),
+		GasWanted: 1000,
+		GasUsed:   800,
+		GasLimit:  10000,
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		height   int64
+		expFound bool
+	}{
+		{
+			"not recorded",
+			func() {},
+			100,
+			false,
+		},
+		{
+			"recorded",
+			func() {
+				nw.App.GetFeeMarketKeeper().SetBlockFee(ctx, blockFee)
+			},
+			100,
+			true,
+		},
+		{
+			"same ring buffer entry of an older block",
+			func() {
+				nw.App.GetFeeMarketKeeper().SetBlockFee(ctx, blockFee)
+			},
+			100 - types.FeeHistoryWindow,
+			false,
+		},
+		{
+			"replaced by a later block",
+			func() {
+				nw.App.GetFeeMarketKeeper().SetBlockFee(ctx, blockFee)
+				later := blockFee
+				later.Height += types.FeeHistoryWindow
+				nw.App.GetFeeMarketKeeper().SetBlockFee(ctx, later)
+			},
+			100,
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// reset network and context
+			nw = network.NewUnitTestNetwork(s.create, s.options...)
+			ctx = nw.GetContext()
+
+			tc.malleate()
+
+			res, found := nw.App.GetFeeMarketKeeper().GetBlockFee(ctx, tc.height)
+			s.Require().Equal(tc.expFound, found, tc.name)
+			if tc.expFound {
+				s.Equal(blockFee, res, tc.name)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestAddBurnedBaseFee() {
+	nw := network.NewUnitTestNetwork(s.create, s.options...)
+	ctx := nw.GetContext()
+	k := nw.App.GetFeeMarketKeeper()
+
+	s.Require().True(k.GetBurnedBaseFee(ctx).IsZero())
+
+	k.AddBurnedBaseFee(ctx, math.LegacyNewDec(10))
+	k.AddBurnedBaseFee(ctx, math.LegacyNewDecWithPrec(5, 1))
+	s.Require().Equal(math.LegacyNewDecWithPrec(105, 1), k.GetBurnedBaseFee(ctx))
+}
+
+func (s *KeeperTestSuite) TestAddTransientGasWanted() {
+	nw := network.NewUnitTestNetwork(s.create, s.options...)
+	ctx := nw.GetContext()
+	k := nw.App.GetFeeMarketKeeper()
+
+	total, err := k.AddTransientGasWanted(ctx, 1000, types.GasLaneEVM)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1000), total)
+
+	total, err = k.AddTransientGasWanted(ctx, 400, types.GasLaneCosmos)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1400), total)
+
+	total, err = k.AddTransientGasWanted(ctx, 100, types.GasLaneEVM)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1500), total)
+
+	s.Require().Equal(uint64(1500), k.GetTransientGasWanted(ctx))
+	s.Require().Equal(uint64(1100), k.GetTransientLaneGasWanted(ctx, types.GasLaneEVM))
+	s.Require().Equal(uint64(400), k.GetTransientLaneGasWanted(ctx, types.GasLaneCosmos))
+}
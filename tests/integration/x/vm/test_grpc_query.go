@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethlogger "github.com/ethereum/go-ethereum/eth/tracers/logger"
@@ -30,8 +32,10 @@ import (
 	"github.com/cosmos/evm/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
@@ -380,6 +384,237 @@ func (s *KeeperTestSuite) TestQueryCode() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryStorageRange() {
+	keys := []common.Hash{
+		common.BigToHash(big.NewInt(1)),
+		common.BigToHash(big.NewInt(2)),
+		common.BigToHash(big.NewInt(3)),
+	}
+	value := common.BytesToHash([]byte("value"))
+
+	newIndex := s.Keyring.AddKey()
+	addr := s.Keyring.GetAddr(newIndex)
+	for _, key := range keys {
+		s.Network.App.GetEVMKeeper().SetState(s.Network.GetContext(), addr, key, value.Bytes())
+	}
+
+	testCases := []struct {
+		msg           string
+		getReqAndResp func() (*types.QueryStorageRangeRequest, *types.QueryStorageRangeResponse)
+		expPass       bool
+	}{
+		{
+			"invalid address",
+			func() (*types.QueryStorageRangeRequest, *types.QueryStorageRangeResponse) {
+				return &types.QueryStorageRangeRequest{
+					Address: invalidAddress,
+				}, nil
+			},
+			false,
+		},
+		{
+			"success - account without storage",
+			func() (*types.QueryStorageRangeRequest, *types.QueryStorageRangeResponse) {
+				return &types.QueryStorageRangeRequest{
+					Address: s.Keyring.GetAddr(s.Keyring.AddKey()).String(),
+				}, &types.QueryStorageRangeResponse{
+					Pagination: &query.PageResponse{},
+				}
+			},
+			true,
+		},
+		{
+			"success - first page",
+			func() (*types.QueryStorageRangeRequest, *types.QueryStorageRangeResponse) {
+				return &types.QueryStorageRangeRequest{
+					Address:    addr.String(),
+					Pagination: &query.PageRequest{Limit: 2},
+				}, &types.QueryStorageRangeResponse{
+					Storage: types.Storage{
+						types.NewState(keys[0], value),
+						types.NewState(keys[1], value),
+					},
+					Pagination: &query.PageResponse{NextKey: keys[2].Bytes()},
+				}
+			},
+			true,
+		},
+		{
+			"success - last page from start key",
+			func() (*types.QueryStorageRangeRequest, *types.QueryStorageRangeResponse) {
+				return &types.QueryStorageRangeRequest{
+					Address:    addr.String(),
+					Pagination: &query.PageRequest{Key: keys[2].Bytes(), Limit: 2},
+				}, &types.QueryStorageRangeResponse{
+					Storage: types.Storage{
+						types.NewState(keys[2], value),
+					},
+					Pagination: &query.PageResponse{},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			req, expectedResp := tc.getReqAndResp()
+
+			ctx := s.Network.GetContext()
+			res, err := s.Network.GetEvmClient().StorageRange(ctx, req)
+
+			s.Require().Equal(expectedResp, res)
+			if tc.expPass {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryAccountRange() {
+	code := []byte("code")
+	codeHash := crypto.Keccak256Hash(code)
+	key := common.BigToHash(big.NewInt(1))
+	value := common.BytesToHash([]byte("value"))
+
+	contracts := []common.Address{
+		s.Keyring.GetAddr(s.Keyring.AddKey()),
+		s.Keyring.GetAddr(s.Keyring.AddKey()),
+	}
+	stateDB := s.Network.GetStateDB()
+	for _, contract := range contracts {
+		stateDB.SetCode(contract, code)
+		stateDB.SetState(contract, key, value)
+	}
+	s.Require().NoError(stateDB.Commit())
+
+	testCases := []struct {
+		msg            string
+		req            *types.QueryAccountRangeRequest
+		expCode        []byte
+		expStorage     types.Storage
+		expMaxAccounts int
+	}{
+		{
+			"success - without code and storage",
+			&types.QueryAccountRangeRequest{},
+			nil,
+			nil,
+			0,
+		},
+		{
+			"success - with code and storage",
+			&types.QueryAccountRangeRequest{IncludeCode: true, IncludeStorage: true},
+			code,
+			types.Storage{types.NewState(key, value)},
+			0,
+		},
+		{
+			"success - paginated",
+			&types.QueryAccountRangeRequest{Pagination: &query.PageRequest{Limit: 1}},
+			nil,
+			nil,
+			1,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			ctx := s.Network.GetContext()
+			res, err := s.Network.GetEvmClient().AccountRange(ctx, tc.req)
+			s.Require().NoError(err)
+
+			if tc.expMaxAccounts > 0 {
+				s.Require().Len(res.Accounts, tc.expMaxAccounts)
+				s.Require().NotEmpty(res.Pagination.NextKey)
+				return
+			}
+
+			found := 0
+			for _, account := range res.Accounts {
+				s.Require().NotEqual(crypto.Keccak256Hash(nil).Hex(), account.CodeHash)
+				for _, contract := range contracts {
+					if account.Address != contract.Hex() {
+						continue
+					}
+					found++
+					s.Require().Equal(codeHash.Hex(), account.CodeHash)
+					s.Require().Equal(tc.expCode, account.Code)
+					s.Require().Equal(tc.expStorage, types.Storage(account.Storage))
+				}
+			}
+			s.Require().Equal(len(contracts), found)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryRangeLimits() {
+	contract := s.Keyring.GetAddr(s.Keyring.AddKey())
+	value := common.BytesToHash([]byte("value"))
+
+	stateDB := s.Network.GetStateDB()
+	stateDB.SetCode(contract, []byte("code"))
+	for i := 0; i <= types.MaxRangeQueryLimit; i++ {
+		stateDB.SetState(contract, common.BigToHash(big.NewInt(int64(i+1))), value)
+	}
+	s.Require().NoError(stateDB.Commit())
+
+	ctx := s.Network.GetContext()
+	storageRes, err := s.Network.GetEvmClient().StorageRange(ctx, &types.QueryStorageRangeRequest{
+		Address:    contract.String(),
+		Pagination: &query.PageRequest{Limit: 10 * types.MaxRangeQueryLimit},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(storageRes.Storage, types.MaxRangeQueryLimit)
+	s.Require().NotEmpty(storageRes.Pagination.NextKey)
+
+	accountRes, err := s.Network.GetEvmClient().AccountRange(ctx, &types.QueryAccountRangeRequest{
+		Pagination:     &query.PageRequest{Key: contract.Bytes(), Limit: 10 * types.MaxRangeQueryLimit},
+		IncludeStorage: true,
+	})
+	s.Require().NoError(err)
+	s.Require().LessOrEqual(len(accountRes.Accounts), types.MaxRangeQueryLimit)
+	s.Require().Equal(contract.Hex(), accountRes.Accounts[0].Address)
+	s.Require().Len(accountRes.Accounts[0].Storage, types.MaxDumpStorageEntries)
+
+	// a missing limit defaults to the cap and the total is never counted
+	storageRes, err = s.Network.GetEvmClient().StorageRange(ctx, &types.QueryStorageRangeRequest{
+		Address:    contract.String(),
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(storageRes.Storage, types.MaxRangeQueryLimit)
+	s.Require().NotEmpty(storageRes.Pagination.NextKey)
+	s.Require().Zero(storageRes.Pagination.Total)
+
+	storageRes, err = s.Network.GetEvmClient().StorageRange(ctx, &types.QueryStorageRangeRequest{
+		Address: contract.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(storageRes.Storage, types.MaxRangeQueryLimit)
+
+	accountRes, err = s.Network.GetEvmClient().AccountRange(ctx, &types.QueryAccountRangeRequest{
+		Pagination: &query.PageRequest{Key: contract.Bytes(), CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(contract.Hex(), accountRes.Accounts[0].Address)
+	s.Require().Zero(accountRes.Pagination.Total)
+
+	// offset pagination is rejected
+	_, err = s.Network.GetEvmClient().StorageRange(ctx, &types.QueryStorageRangeRequest{
+		Address:    contract.String(),
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+	})
+	s.Require().ErrorContains(err, "offset pagination is not supported")
+
+	_, err = s.Network.GetEvmClient().AccountRange(ctx, &types.QueryAccountRangeRequest{
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+	})
+	s.Require().ErrorContains(err, "offset pagination is not supported")
+}
+
 // TODO: Fix this one
 func (s *KeeperTestSuite) TestQueryTxLogs() {
 	expLogs := []*types.Log{}
@@ -1503,6 +1738,250 @@ func (s *KeeperTestSuite) TestTraceBlock() {
 	}
 }
 
+func (s *KeeperTestSuite) TestTraceBlockCosmosCalls() {
+	s.EnableFeemarket = true
+	defer func() { s.EnableFeemarket = false }()
+	s.SetupTest()
+
+	senderKey := s.Keyring.GetKey(0)
+	recipient := common.HexToAddress("0xC6Fe5D33615a1C52c08018c47E8Bc53646A0E101")
+	contractAddr, err := deployErc20Contract(senderKey, s.Factory)
+	s.Require().NoError(err)
+	s.Require().NoError(s.Network.NextBlock())
+
+	// transfer executed by a Cosmos message, which records the call in an event.
+	// It is not committed, the trace replays it on top of the block state.
+	erc20Contract, err := testdata.LoadERC20Contract()
+	s.Require().NoError(err)
+	data, err := erc20Contract.ABI.Pack("transfer", recipient, big.NewInt(1000))
+	s.Require().NoError(err)
+
+	ctx, _ := s.Network.GetContext().CacheContext()
+	_, err = s.Network.App.GetEVMKeeper().CallEVMWithData(ctx, senderKey.Addr, &contractAddr, data, true, nil)
+	s.Require().NoError(err)
+
+	var call *types.CosmosEVMCall
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeCosmosCall {
+			continue
+		}
+		call = &types.CosmosEVMCall{TxHash: "0x01", TxIndex: 3, MsgType: "/cosmos.evm.erc20.v1.MsgConvertCoin"}
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case types.AttributeKeySender:
+				call.From = attr.Value
+			case types.AttributeKeyRecipient:
+				call.To = attr.Value
+			case types.AttributeKeyGasLimit:
+				call.GasLimit = config.DefaultGasCap
+				s.Require().Equal(fmt.Sprint(config.DefaultGasCap), attr.Value)
+			case types.AttributeKeyInput:
+				call.Data, err = hexutil.Decode(attr.Value)
+				s.Require().NoError(err)
+				s.Require().Equal(data, call.Data)
+			}
+		}
+	}
+	s.Require().NotNil(call, "expected the call to be recorded")
+	s.Require().Equal(senderKey.Addr.Hex(), call.From)
+	s.Require().Equal(contractAddr.Hex(), call.To)
+
+	msgToTrace, err := executeTransferCall(
+		transferParams{
+			senderKey:     senderKey,
+			contractAddr:  contractAddr,
+			recipientAddr: recipient,
+		},
+		s.Factory,
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.Network.NextBlock())
+
+	traceReq := getDefaultTraceBlockRequest(s.Network)
+	traceReq.TraceConfig = &types.TraceConfig{Tracer: "callTracer", IncludeCosmosCalls: true}
+	traceReq.Txs = []*types.MsgEthereumTx{msgToTrace}
+	traceReq.CosmosCalls = []*types.CosmosEVMCall{call}
+
+	res, err := s.Network.GetEvmClient().TraceBlock(s.Network.GetContext(), &traceReq)
+	s.Require().NoError(err)
+
+	var results []*types.TxTraceResult
+	s.Require().NoError(json.Unmarshal(res.Data, &results))
+	s.Require().Len(results, 2)
+
+	// the call executed by the Cosmos message is traced before the ethereum tx
+	s.Require().Empty(results[0].Error)
+	s.Require().Equal(&types.CosmosMsgRef{TxHash: "0x01", TxIndex: 3, MsgType: "/cosmos.evm.erc20.v1.MsgConvertCoin"}, results[0].CosmosMsg)
+	callTrace, ok := results[0].Result.(map[string]interface{})
+	s.Require().True(ok)
+	s.Require().Equal("CALL", callTrace["type"])
+	s.Require().Equal(strings.ToLower(contractAddr.Hex()), callTrace["to"])
+	s.Require().Equal(hexutil.Encode(data), callTrace["input"])
+
+	s.Require().Empty(results[1].Error)
+	s.Require().Nil(results[1].CosmosMsg)
+}
+
+func (s *KeeperTestSuite) TestCosmosCallEvents() {
+	s.SetupTest()
+
+	senderKey := s.Keyring.GetKey(0)
+	recipient := s.Keyring.GetAddr(1)
+	evmKeeper := s.Network.App.GetEVMKeeper()
+
+	testCases := []struct {
+		name      string
+		input     []byte
+		commit    bool
+		expRecord bool
+	}{
+		{"committed call", []byte{0x01, 0x02}, true, true},
+		{"committed call - empty input", nil, true, true},
+		{"committed call - large input", make([]byte, 64*1024), true, true},
+		{"call not committed", []byte{0x01, 0x02}, false, false},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx, _ := s.Network.GetContext().CacheContext()
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			storeKey := s.Network.App.GetKey(types.StoreKey)
+			storeBefore := iteratorKeys(ctx.KVStore(storeKey))
+
+			_, err := evmKeeper.CallEVMWithData(ctx, senderKey.Addr, &recipient, tc.input, tc.commit, nil)
+			s.Require().NoError(err)
+
+			// the call is recorded in an event, out of the consensus state
+			s.Require().Equal(storeBefore, iteratorKeys(ctx.KVStore(storeKey)))
+
+			var inputs []string
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != types.EventTypeCosmosCall {
+					continue
+				}
+				for _, attr := range event.Attributes {
+					if attr.Key == types.AttributeKeyInput {
+						inputs = append(inputs, attr.Value)
+					}
+				}
+			}
+			if !tc.expRecord {
+				s.Require().Empty(inputs)
+				return
+			}
+			s.Require().Equal([]string{hexutil.Encode(tc.input)}, inputs)
+		})
+	}
+}
+
+// iteratorKeys returns the keys of the given store.
+func iteratorKeys(store storetypes.KVStore) [][]byte {
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	return keys
+}
+
+func (s *KeeperTestSuite) TestTraceCall() {
+	s.SetupTest()
+
+	sender := s.Keyring.GetAddr(0)
+	contract := tx.GenerateAddress()
+	// runtime code returning SLOAD(0) as a single 32 bytes word
+	code := hexutil.Bytes{0x60, 0x00, 0x54, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+	overrides := types.StateOverride{
+		contract: {
+			Code:  &code,
+			State: &map[common.Hash]common.Hash{{}: common.BigToHash(big.NewInt(42))},
+		},
+	}
+
+	testCases := []struct {
+		msg           string
+		getRequest    func() *types.QueryTraceCallRequest
+		expPass       bool
+		traceResponse string
+	}{
+		{
+			msg: "default trace with state overrides",
+			getRequest: func() *types.QueryTraceCallRequest {
+				return getDefaultTraceCallRequest(s.Network, sender, contract, overrides)
+			},
+			expPass: true,
+			traceResponse: "\"failed\":false," +
+				"\"returnValue\":\"0x000000000000000000000000000000000000000000000000000000000000002a\"," +
+				"\"structLogs\":[{\"pc\":0,\"op\":\"PUSH1\"",
+		},
+		{
+			msg: "call tracer",
+			getRequest: func() *types.QueryTraceCallRequest {
+				req := getDefaultTraceCallRequest(s.Network, sender, contract, overrides)
+				req.TraceConfig = &types.TraceConfig{Tracer: "callTracer"}
+				return req
+			},
+			expPass:       true,
+			traceResponse: "{\"from\":\"" + strings.ToLower(sender.Hex()) + "\"",
+		},
+		{
+			msg: "block overrides",
+			getRequest: func() *types.QueryTraceCallRequest {
+				// runtime code returning NUMBER as a single 32 bytes word
+				numberCode := hexutil.Bytes{0x43, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+				req := getDefaultTraceCallRequest(s.Network, sender, contract, types.StateOverride{
+					contract: {Code: &numberCode},
+				})
+				blockOverrides, err := json.Marshal(types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(1_000_000))})
+				s.Require().NoError(err)
+				req.BlockOverrides = blockOverrides
+				return req
+			},
+			expPass: true,
+			traceResponse: "\"failed\":false," +
+				"\"returnValue\":\"0x00000000000000000000000000000000000000000000000000000000000f4240\"",
+		},
+		{
+			msg: "invalid trace config - Negative Limit",
+			getRequest: func() *types.QueryTraceCallRequest {
+				req := getDefaultTraceCallRequest(s.Network, sender, contract, overrides)
+				req.TraceConfig = &types.TraceConfig{Limit: -1}
+				return req
+			},
+			expPass: false,
+		},
+		{
+			msg: "invalid overrides - both state and stateDiff",
+			getRequest: func() *types.QueryTraceCallRequest {
+				return getDefaultTraceCallRequest(s.Network, sender, contract, types.StateOverride{
+					contract: {
+						State:     &map[common.Hash]common.Hash{},
+						StateDiff: &map[common.Hash]common.Hash{},
+					},
+				})
+			},
+			expPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			res, err := s.Network.GetEvmClient().TraceCall(s.Network.GetContext(), tc.getRequest())
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Contains(string(res.Data), tc.traceResponse)
+
+			// overrides must never be persisted
+			s.Require().Empty(s.Network.App.GetEVMKeeper().GetCode(s.Network.GetContext(), s.Network.App.GetEVMKeeper().GetCodeHash(s.Network.GetContext(), contract)))
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestNonceInQuery() {
 	s.EnableFeemarket = true
 	defer func() { s.EnableFeemarket = false }()
@@ -1779,6 +2258,271 @@ func (s *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (s *KeeperTestSuite) TestEthCallWithOverrides() {
+	s.SetupTest()
+
+	sender := s.Keyring.GetAddr(0)
+	contract := tx.GenerateAddress()
+
+	// runtime code returning a single 32 bytes word computed by the given opcodes
+	returnWord := func(opcodes ...byte) hexutil.Bytes {
+		return append(opcodes, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3)
+	}
+
+	testCases := []struct {
+		name           string
+		overrides      types.StateOverride
+		blockOverrides *types.BlockOverrides
+		expRet         *big.Int
+		expPass        bool
+	}{
+		{
+			"code and state override",
+			types.StateOverride{
+				contract: {
+					Code:  ptr(returnWord(0x60, 0x00, 0x54)), // SLOAD(0)
+					State: &map[common.Hash]common.Hash{{}: common.BigToHash(big.NewInt(42))},
+				},
+			},
+			nil,
+			big.NewInt(42),
+			true,
+		},
+		{
+			"balance override",
+			types.StateOverride{
+				contract: {Code: ptr(returnWord(0x33, 0x31))}, // BALANCE(CALLER)
+				sender:   {Balance: ptr(ptr(hexutil.Big(*big.NewInt(1234))))},
+			},
+			nil,
+			big.NewInt(1234),
+			true,
+		},
+		{
+			"block number override",
+			types.StateOverride{
+				contract: {Code: ptr(returnWord(0x43))}, // NUMBER
+			},
+			&types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(1_000_000))},
+			big.NewInt(1_000_000),
+			true,
+		},
+		{
+			"fail - both state and stateDiff",
+			types.StateOverride{
+				contract: {
+					State:     &map[common.Hash]common.Hash{},
+					StateDiff: &map[common.Hash]common.Hash{},
+				},
+			},
+			nil,
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			args, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &contract})
+			s.Require().NoError(err)
+			overrides, err := json.Marshal(tc.overrides)
+			s.Require().NoError(err)
+			req := &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap, Overrides: overrides}
+			if tc.blockOverrides != nil {
+				req.BlockOverrides, err = json.Marshal(tc.blockOverrides)
+				s.Require().NoError(err)
+			}
+
+			res, err := s.Network.GetEvmClient().EthCall(s.Network.GetContext(), req)
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Empty(res.VmError)
+			s.Require().Equal(tc.expRet, new(big.Int).SetBytes(res.Ret))
+
+			// overrides must never be persisted
+			s.Require().Empty(s.Network.App.GetEVMKeeper().GetCode(s.Network.GetContext(), s.Network.App.GetEVMKeeper().GetCodeHash(s.Network.GetContext(), contract)))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSimulateV1() {
+	s.SetupTest()
+
+	sender := tx.GenerateAddress()
+	recipient := tx.GenerateAddress()
+	third := tx.GenerateAddress()
+	reverter := tx.GenerateAddress()
+	baseHeight := s.Network.GetContext().BlockHeight()
+
+	value := big.NewInt(1000)
+	transfer := func(from, to common.Address) types.TransactionArgs {
+		return types.TransactionArgs{From: &from, To: &to, Value: (*hexutil.Big)(value)}
+	}
+
+	testCases := []struct {
+		name     string
+		opts     types.SimOpts
+		expPass  bool
+		validate func(results []*types.SimBlockResult)
+	}{
+		{
+			"dependent transfers across blocks with transfer logs",
+			types.SimOpts{
+				BlockStateCalls: []types.SimBlock{
+					{
+						StateOverrides: types.StateOverride{
+							sender: {Balance: ptr(ptr(hexutil.Big(*value)))},
+						},
+						Calls: []types.TransactionArgs{transfer(sender, recipient)},
+					},
+					{
+						// the number gap is filled with an empty block
+						BlockOverrides: &types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(baseHeight + 3))},
+						Calls:          []types.TransactionArgs{transfer(recipient, third)},
+					},
+				},
+				TraceTransfers: true,
+			},
+			true,
+			func(results []*types.SimBlockResult) {
+				s.Require().Len(results, 3)
+				for i, result := range results {
+					s.Require().Equal(baseHeight+int64(i)+1, result.Header.Number.Int64())
+				}
+				s.Require().Equal(results[0].Header.Hash(), results[1].Header.ParentHash)
+				s.Require().Empty(results[1].Calls)
+
+				for _, result := range []*types.SimBlockResult{results[0], results[2]} {
+					s.Require().Len(result.Calls, 1)
+					call := result.Calls[0]
+					s.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusSuccessful), call.Status)
+					s.Require().Len(call.Logs, 1)
+					s.Require().Equal(common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE"), call.Logs[0].Address)
+					s.Require().Equal(result.Header.Hash(), call.Logs[0].BlockHash)
+					s.Require().Equal(result.Transactions[0].Hash(), call.Logs[0].TxHash)
+				}
+				s.Require().Equal(common.BytesToHash(recipient.Bytes()), results[2].Calls[0].Logs[0].Topics[1])
+			},
+		},
+		{
+			"reverted call",
+			types.SimOpts{
+				BlockStateCalls: []types.SimBlock{
+					{
+						StateOverrides: types.StateOverride{
+							// REVERT(0, 0)
+							reverter: {Code: ptr(hexutil.Bytes{0x60, 0x00, 0x60, 0x00, 0xfd})},
+						},
+						Calls: []types.TransactionArgs{{From: &sender, To: &reverter}},
+					},
+				},
+			},
+			true,
+			func(results []*types.SimBlockResult) {
+				s.Require().Len(results, 1)
+				s.Require().Len(results[0].Calls, 1)
+				call := results[0].Calls[0]
+				s.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusFailed), call.Status)
+				s.Require().NotNil(call.Error)
+				s.Require().Equal(types.SimErrCodeReverted, call.Error.Code)
+				s.Require().Empty(call.Logs)
+			},
+		},
+		{
+			"validation with fees charged to the sender",
+			types.SimOpts{
+				BlockStateCalls: []types.SimBlock{
+					{
+						StateOverrides: types.StateOverride{
+							sender: {Balance: ptr(ptr(hexutil.Big(*big.NewInt(1e18))))},
+						},
+						Calls: []types.TransactionArgs{
+							{From: &sender, To: &recipient, Gas: ptr(hexutil.Uint64(21000)), GasPrice: (*hexutil.Big)(big.NewInt(1e12))},
+							{From: &sender, To: &recipient, Gas: ptr(hexutil.Uint64(21000)), GasPrice: (*hexutil.Big)(big.NewInt(1e12))},
+						},
+					},
+				},
+				Validation: true,
+			},
+			true,
+			func(results []*types.SimBlockResult) {
+				s.Require().Len(results, 1)
+				s.Require().Len(results[0].Calls, 2)
+				for i, call := range results[0].Calls {
+					s.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusSuccessful), call.Status)
+					// the nonce is incremented after each call
+					s.Require().Equal(uint64(i), results[0].Transactions[i].Nonce())
+				}
+				s.Require().Equal(uint64(42000), results[0].Header.GasUsed)
+			},
+		},
+		{
+			"fail - validation with nonce too high",
+			types.SimOpts{
+				BlockStateCalls: []types.SimBlock{
+					{
+						StateOverrides: types.StateOverride{
+							sender: {Balance: ptr(ptr(hexutil.Big(*big.NewInt(1e18))))},
+						},
+						Calls: []types.TransactionArgs{{From: &sender, To: &recipient, Nonce: ptr(hexutil.Uint64(5))}},
+					},
+				},
+				Validation: true,
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - block numbers out of order",
+			types.SimOpts{
+				BlockStateCalls: []types.SimBlock{
+					{BlockOverrides: &types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(baseHeight + 2))}},
+					{BlockOverrides: &types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(baseHeight + 1))}},
+				},
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - empty input",
+			types.SimOpts{},
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			opts, err := json.Marshal(tc.opts)
+			s.Require().NoError(err)
+
+			res, err := s.Network.GetEvmClient().SimulateV1(s.Network.GetContext(), &types.QuerySimulateV1Request{
+				Opts:   opts,
+				GasCap: config.DefaultGasCap,
+			})
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var results []*types.SimBlockResult
+			s.Require().NoError(json.Unmarshal(res.Data, &results))
+			tc.validate(results)
+
+			// the simulation must never be persisted
+			s.Require().Zero(s.Network.App.GetEVMKeeper().GetAccountOrEmpty(s.Network.GetContext(), recipient).Balance.Sign())
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
 func (s *KeeperTestSuite) TestBalance() {
 	testCases := []struct {
 		name        string
@@ -1988,6 +2732,18 @@ func (s *KeeperTestSuite) TestEmptyRequest() {
 				return k.TraceBlock(s.Network.GetContext(), nil)
 			},
 		},
+		{
+			"TraceCall method",
+			func() (interface{}, error) {
+				return k.TraceCall(s.Network.GetContext(), nil)
+			},
+		},
+		{
+			"SimulateV1 method",
+			func() (interface{}, error) {
+				return k.SimulateV1(s.Network.GetContext(), nil)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2008,6 +2764,27 @@ func getDefaultTraceBlockRequest(unitNetwork network.Network) types.QueryTraceBl
 	}
 }
 
+func getDefaultTraceCallRequest(unitNetwork network.Network, from, to common.Address, overrides types.StateOverride) *types.QueryTraceCallRequest {
+	ctx := unitNetwork.GetContext()
+	args, err := json.Marshal(&types.TransactionArgs{From: &from, To: &to})
+	if err != nil {
+		panic(err)
+	}
+	overridesBz, err := json.Marshal(overrides)
+	if err != nil {
+		panic(err)
+	}
+	return &types.QueryTraceCallRequest{
+		Args:        args,
+		GasCap:      config.DefaultGasCap,
+		BlockNumber: ctx.BlockHeight(),
+		BlockMaxGas: ctx.ConsensusParams().Block.MaxGas,
+		ChainId:     unitNetwork.GetEIP155ChainID().Int64(),
+		BlockTime:   ctx.BlockTime(),
+		Overrides:   overridesBz,
+	}
+}
+
 func deployErc20Contract(from keyring.Key, txFactory factory.TxFactory) (common.Address, error) {
 	erc20Contract, err := testdata.LoadERC20Contract()
 	if err != nil {
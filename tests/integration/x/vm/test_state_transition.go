@@ -1,12 +1,14 @@
 package vm
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -143,6 +145,165 @@ func (s *KeeperTestSuite) TestGetHashFn() {
 	}
 }
 
+// TestBlockHashOpcode checks that the BLOCKHASH opcode is served by the EIP-2935
+// history storage within the last 256 blocks, and that the older hashes of the
+// history serve window are only served by the history storage contract.
+func (s *KeeperTestSuite) TestBlockHashOpcode() {
+	s.SetupTest()
+	s.Require().NoError(s.Network.NextBlock())
+	prevHeight := s.Network.GetContext().BlockHeight()
+	s.Require().NoError(s.Network.NextBlock())
+
+	ctx := s.Network.GetContext()
+	k := s.Network.App.GetEVMKeeper()
+	prevHash := k.GetHeaderHash(ctx, uint64(prevHeight)) //nolint:gosec // G115
+	s.Require().NotEqual(common.Hash{}, prevHash)
+
+	// returns BLOCKHASH(calldata[0:32])
+	blockHashContract := utiltx.GenerateAddress()
+	stateDB := s.Network.GetStateDB()
+	stateDB.SetCode(blockHashContract, common.FromHex("0x6000354060005260206000f3"))
+	s.Require().NoError(stateDB.Commit())
+
+	testCases := []struct {
+		msg         string
+		contract    common.Address
+		blockHeight int64
+		expHash     common.Hash
+	}{
+		{
+			"BLOCKHASH of the previous block",
+			blockHashContract,
+			ctx.BlockHeight(),
+			prevHash,
+		},
+		{
+			"BLOCKHASH beyond the last 256 blocks is empty",
+			blockHashContract,
+			prevHeight + 257,
+			common.Hash{},
+		},
+		{
+			"history storage contract beyond the last 256 blocks",
+			params.HistoryStorageAddress,
+			prevHeight + 257,
+			prevHash,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.msg, func() {
+			res, err := k.CallEVMWithData(
+				ctx.WithBlockHeight(tc.blockHeight),
+				s.Keyring.GetAddr(0),
+				&tc.contract,
+				common.BigToHash(big.NewInt(prevHeight)).Bytes(),
+				false,
+				nil,
+			)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expHash, common.BytesToHash(res.Ret))
+		})
+	}
+}
+
+// headerHashStore is an archival store of the block header hashes, by height.
+type headerHashStore map[int64]common.Hash
+
+func (hs headerHashStore) GetHeaderHash(height int64) (common.Hash, error) {
+	return hs[height], nil
+}
+
+// TestBlockHashArchive checks that the BLOCKHASH opcode is served by the
+// archived header hashes beyond the history serve window in the queries only.
+func (s *KeeperTestSuite) TestBlockHashArchive() {
+	s.SetupTest()
+	s.Require().NoError(s.Network.NextBlock())
+	prevHeight := s.Network.GetContext().BlockHeight()
+	s.Require().NoError(s.Network.NextBlock())
+
+	ctx := s.Network.GetContext()
+	k := s.Network.App.GetEVMKeeper()
+
+	// keep only the hash of the current block in the history storage
+	evmParams := k.GetParams(ctx)
+	evmParams.HistoryServeWindow = 1
+	s.Require().NoError(k.SetParams(ctx, evmParams))
+
+	archivedHash := common.BytesToHash(tmhash.Sum([]byte("archived")))
+	k.SetHeaderHashStore(headerHashStore{prevHeight: archivedHash})
+	defer k.SetHeaderHashStore(nil)
+
+	// returns BLOCKHASH(calldata[0:32])
+	blockHashContract := utiltx.GenerateAddress()
+	stateDB := s.Network.GetStateDB()
+	stateDB.SetCode(blockHashContract, common.FromHex("0x6000354060005260206000f3"))
+	s.Require().NoError(stateDB.Commit())
+
+	input := common.BigToHash(big.NewInt(prevHeight)).Bytes()
+	args, err := json.Marshal(&types.TransactionArgs{
+		To:   &blockHashContract,
+		Data: (*hexutil.Bytes)(&input),
+	})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		msg      string
+		call     func() ([]byte, error)
+		expHash  common.Hash
+		expEqual bool
+	}{
+		{
+			"eth_call is served by the archive",
+			func() ([]byte, error) {
+				res, err := k.EthCall(ctx, &types.EthCallRequest{Args: args, GasCap: 25_000_000})
+				if err != nil {
+					return nil, err
+				}
+				return res.Ret, nil
+			},
+			archivedHash,
+			true,
+		},
+		{
+			"eth_call during block execution is not served by the archive",
+			func() ([]byte, error) {
+				res, err := k.EthCall(ctx.WithExecMode(sdk.ExecModeFinalize), &types.EthCallRequest{Args: args, GasCap: 25_000_000})
+				if err != nil {
+					return nil, err
+				}
+				return res.Ret, nil
+			},
+			archivedHash,
+			false,
+		},
+		{
+			"transaction is not served by the archive",
+			func() ([]byte, error) {
+				res, err := k.CallEVMWithData(ctx, s.Keyring.GetAddr(0), &blockHashContract, input, false, nil)
+				if err != nil {
+					return nil, err
+				}
+				return res.Ret, nil
+			},
+			archivedHash,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.msg, func() {
+			ret, err := tc.call()
+			s.Require().NoError(err)
+			if tc.expEqual {
+				s.Require().Equal(tc.expHash, common.BytesToHash(ret))
+			} else {
+				s.Require().NotEqual(tc.expHash, common.BytesToHash(ret))
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestGetCoinbaseAddress() {
 	s.SetupTest()
 	validators := s.Network.GetValidators()
@@ -498,6 +659,107 @@ func (s *KeeperTestSuite) TestRefundGas() {
 	}
 }
 
+func (s *KeeperTestSuite) TestSplitBaseFee() {
+	baseDenom := types.GetEVMCoinDenom()
+	conversionFactor := types.GetEVMCoinDecimals().ConversionFactor()
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	// 1 token of the EVM denom, in 18 decimals
+	baseFeeAmount := big.NewInt(1e18)
+
+	testCases := []struct {
+		name             string
+		split            feemarkettypes.BaseFeeSplit
+		expBurned        *big.Int
+		expCommunityPool *big.Int
+	}{
+		{
+			name:             "unset split - all to the validators",
+			split:            feemarkettypes.BaseFeeSplit{},
+			expBurned:        big.NewInt(0),
+			expCommunityPool: big.NewInt(0),
+		},
+		{
+			name:             "default split - all to the validators",
+			split:            feemarkettypes.DefaultBaseFeeSplit,
+			expBurned:        big.NewInt(0),
+			expCommunityPool: big.NewInt(0),
+		},
+		{
+			name: "burn all",
+			split: feemarkettypes.BaseFeeSplit{
+				Burn:          sdkmath.LegacyOneDec(),
+				CommunityPool: sdkmath.LegacyZeroDec(),
+				Validators:    sdkmath.LegacyZeroDec(),
+			},
+			expBurned:        big.NewInt(1e18),
+			expCommunityPool: big.NewInt(0),
+		},
+		{
+			name: "burn, community pool and validators",
+			split: feemarkettypes.BaseFeeSplit{
+				Burn:          sdkmath.LegacyNewDecWithPrec(5, 1),
+				CommunityPool: sdkmath.LegacyNewDecWithPrec(25, 2),
+				Validators:    sdkmath.LegacyNewDecWithPrec(25, 2),
+			},
+			expBurned:        big.NewInt(5e17),
+			expCommunityPool: big.NewInt(25e16),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			bankGenesis := banktypes.DefaultGenesisState()
+			bankGenesis.Balances = []banktypes.Balance{
+				{
+					Address: feeCollector.String(),
+					Coins:   sdk.NewCoins(sdk.NewCoin(baseDenom, sdkmath.NewInt(6e18))),
+				},
+			}
+			customGenesis := network.CustomGenesisState{}
+			customGenesis[banktypes.ModuleName] = bankGenesis
+
+			unitNetwork := network.NewUnitTestNetwork(
+				s.Create,
+				network.WithCustomGenesis(customGenesis),
+			)
+			ctx := unitNetwork.GetContext()
+			app := unitNetwork.App
+
+			fmParams := app.GetFeeMarketKeeper().GetParams(ctx)
+			fmParams.BaseFeeSplit = tc.split
+			s.Require().NoError(app.GetFeeMarketKeeper().SetParams(ctx, fmParams))
+
+			supplyBefore := app.GetBankKeeper().GetSupply(ctx, baseDenom).Amount
+			feeCollectorBefore := app.GetBankKeeper().GetBalance(ctx, feeCollector, baseDenom).Amount
+			feePoolBefore, err := app.GetDistrKeeper().FeePool.Get(ctx)
+			s.Require().NoError(err)
+
+			err = app.GetEVMKeeper().SplitBaseFee(ctx, baseFeeAmount)
+			s.Require().NoError(err)
+
+			expBurned := sdkmath.NewIntFromBigInt(tc.expBurned).Quo(conversionFactor)
+			expCommunityPool := sdkmath.NewIntFromBigInt(tc.expCommunityPool).Quo(conversionFactor)
+
+			supplyAfter := app.GetBankKeeper().GetSupply(ctx, baseDenom).Amount
+			s.Require().Equal(expBurned.String(), supplyBefore.Sub(supplyAfter).String())
+
+			feeCollectorAfter := app.GetBankKeeper().GetBalance(ctx, feeCollector, baseDenom).Amount
+			s.Require().Equal(expBurned.Add(expCommunityPool).String(), feeCollectorBefore.Sub(feeCollectorAfter).String())
+
+			feePoolAfter, err := app.GetDistrKeeper().FeePool.Get(ctx)
+			s.Require().NoError(err)
+			communityPoolIncrease := feePoolAfter.CommunityPool.AmountOf(baseDenom).Sub(feePoolBefore.CommunityPool.AmountOf(baseDenom))
+			s.Require().Equal(sdkmath.LegacyNewDecFromInt(expCommunityPool).String(), communityPoolIncrease.String())
+
+			s.Require().Equal(
+				sdkmath.LegacyNewDecFromInt(expBurned).String(),
+				app.GetFeeMarketKeeper().GetBurnedBaseFee(ctx).String(),
+			)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestResetGasMeterAndConsumeGas() {
 	s.SetupTest()
 	testCases := []struct {
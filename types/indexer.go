@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -18,3 +19,28 @@ type EVMTxIndexer interface {
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
 }
+
+// EVMLogIndexer defines the interface of an eth tx indexer that also indexes
+// the logs by address and topics, so that log queries can be answered
+// without scanning the blocks.
+type EVMLogIndexer interface {
+	EVMTxIndexer
+
+	// FirstIndexedBlock returns -1 if indexer db is empty
+	FirstIndexedBlock() (int64, error)
+	// GetLogs returns at most limit logs emitted within the [from, to] block
+	// range that match the addresses and topics, ordered as in the blocks.
+	GetLogs(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, error)
+}
+
+// HeaderHashIndexer defines the interface of an archival store of the block
+// header hashes, which keeps the hashes of the whole chain history, unlike the
+// EIP-2935 history storage that only keeps the latest blocks.
+type HeaderHashIndexer interface {
+	// IndexHeaderHash records the header hash of the block at the given height.
+	IndexHeaderHash(height int64, hash common.Hash) error
+	// GetHeaderHash returns the empty hash if the height is not indexed.
+	GetHeaderHash(height int64) (common.Hash, error)
+	// GetHeightByHeaderHash returns -1 if the hash is not indexed.
+	GetHeightByHeaderHash(hash common.Hash) (int64, error)
+}
@@ -207,50 +207,54 @@ func Uint256FromBigInt(i *big.Int) (*uint256.Int, error) {
 	return result, nil
 }
 
-// CalcBaseFee calculates the basefee of the header.
+// CalcBaseFee calculates the basefee of the header, using the base fee model
+// selected by the fee market params. If the gas lanes are separate, the gas
+// used of the parent header must be the gas of the EVM lane, which is compared
+// to the gas target of the EVM lane.
 func CalcBaseFee(config *params.ChainConfig, parent *ethtypes.Header, p feemarkettypes.Params) (*big.Int, error) {
 	// If the current block is the first EIP-1559 block, return the InitialBaseFee.
 	if !config.IsLondon(parent.Number) {
 		return new(big.Int).SetUint64(params.InitialBaseFee), nil
 	}
-	if p.ElasticityMultiplier == 0 {
+	elasticityMultiplier := p.LaneElasticityMultiplier(feemarkettypes.GasLaneEVM)
+	if elasticityMultiplier == 0 {
 		return nil, errors.New("ElasticityMultiplier cannot be 0 as it's checked in the params validation")
 	}
-	parentGasTarget := parent.GasLimit / uint64(p.ElasticityMultiplier)
+	model, err := feemarkettypes.NewBaseFeeModel(p.FeeModel)
+	if err != nil {
+		return nil, err
+	}
+	parentGasTarget := parent.GasLimit / uint64(elasticityMultiplier)
 
-	factor := evmtypes.GetEVMCoinDecimals().ConversionFactor()
-	minGasPrice := p.MinGasPrice.Mul(sdkmath.LegacyNewDecFromInt(factor))
-	return CalcGasBaseFee(
-		parent.GasUsed, parentGasTarget, uint64(p.BaseFeeChangeDenominator),
-		sdkmath.LegacyNewDecFromBigInt(parent.BaseFee), sdkmath.LegacyOneDec(), minGasPrice,
+	return model.CalcBaseFee(
+		paramsTo18Decimals(p), sdkmath.LegacyNewDecFromBigInt(parent.BaseFee),
+		parent.GasUsed, parentGasTarget, sdkmath.LegacyOneDec(),
 	).TruncateInt().BigInt(), nil
 }
 
-func CalcGasBaseFee(gasUsed, gasTarget, baseFeeChangeDenom uint64, baseFee, minUnitGas, minGasPrice sdkmath.LegacyDec) sdkmath.LegacyDec {
-	// If the parent gasUsed is the same as the target, the baseFee remains unchanged.
-	if gasUsed == gasTarget {
-		return baseFee
-	}
-
-	if gasTarget == 0 {
-		return sdkmath.LegacyZeroDec()
+// MaxBaseFeeIncrease returns the maximum increase of the given base fee from a
+// block to the next one, using the base fee model selected by the fee market
+// params and the gas target of the EVM lane.
+func MaxBaseFeeIncrease(baseFee *big.Int, p feemarkettypes.Params) (*big.Int, error) {
+	model, err := feemarkettypes.NewBaseFeeModel(p.FeeModel)
+	if err != nil {
+		return nil, err
 	}
 
-	num := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(gasUsed).Sub(sdkmath.NewIntFromUint64(gasTarget)).Abs())
-	num = num.Mul(baseFee)
-	num = num.QuoInt(sdkmath.NewIntFromUint64(gasTarget))
-	num = num.QuoInt(sdkmath.NewIntFromUint64(baseFeeChangeDenom))
+	return model.MaxBaseFeeIncrease(
+		paramsTo18Decimals(p), sdkmath.LegacyNewDecFromBigInt(baseFee), sdkmath.LegacyOneDec(),
+	).TruncateInt().BigInt(), nil
+}
 
-	if gasUsed > gasTarget {
-		// If the parent block used more gas than its target, the baseFee should increase.
-		// max(1, parentBaseFee * gasUsedDelta / parentGasTarget / baseFeeChangeDenominator)
-		baseFeeDelta := sdkmath.LegacyMaxDec(num, minUnitGas)
-		return baseFee.Add(baseFeeDelta)
+// paramsTo18Decimals converts the amounts of the fee market params into 18
+// decimals, the decimals of the base fees of the headers.
+func paramsTo18Decimals(p feemarkettypes.Params) feemarkettypes.Params {
+	factor := sdkmath.LegacyNewDecFromInt(evmtypes.GetEVMCoinDecimals().ConversionFactor())
+	p.MinGasPrice = p.MinGasPrice.Mul(factor)
+	if !p.AdditiveIncrease.IsNil() {
+		p.AdditiveIncrease = p.AdditiveIncrease.Mul(factor)
 	}
-
-	// Otherwise if the parent block used less gas than its target, the baseFee should decrease.
-	// max(minGasPrice, parentBaseFee * gasUsedDelta / parentGasTarget / baseFeeChangeDenominator)
-	return sdkmath.LegacyMaxDec(baseFee.Sub(num), minGasPrice)
+	return p
 }
 
 // Bytes32ToString converts a bytes32 value to string by trimming null bytes
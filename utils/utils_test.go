@@ -584,6 +584,30 @@ func TestCalcBaseFee(t *testing.T) {
 					}(),
 					expectedError: "",
 				},
+				{
+					name:   "separate gas lanes - evm lane target",
+					config: config,
+					parent: &ethtypes.Header{
+						Number:   big.NewInt(10),
+						BaseFee:  big.NewInt(1000000000),
+						GasLimit: 10000000,
+						GasUsed:  5000000, // EVM lane gas, target = 2500000
+					},
+					params: feemarkettypes.Params{
+						ElasticityMultiplier:       2,
+						EvmElasticityMultiplier:    4,
+						CosmosElasticityMultiplier: 2,
+						BaseFeeChangeDenominator:   8,
+						MinGasPrice:                sdkmath.LegacyZeroDec(),
+					},
+					expectedResult: func() *big.Int {
+						// gasUsedDelta = 5000000 - 2500000 = 2500000
+						// baseFeeDelta = 1000000000 * 2500000 / 2500000 / 8 = 125000000
+						// result = 1000000000 + 125000000 = 1125000000
+						return big.NewInt(1125000000)
+					}(),
+					expectedError: "",
+				},
 				{
 					name:   "gas used < target - base fee decreases",
 					config: config,
@@ -651,6 +675,50 @@ func TestCalcBaseFee(t *testing.T) {
 					}(),
 					expectedError: "",
 				},
+				{
+					name:   "AIMD base fee increase",
+					config: config,
+					parent: &ethtypes.Header{
+						Number:   big.NewInt(10),
+						BaseFee:  big.NewInt(1000000000),
+						GasLimit: 10000000,
+						GasUsed:  6000000,
+					},
+					params: feemarkettypes.Params{
+						ElasticityMultiplier:     2,
+						BaseFeeChangeDenominator: 8,
+						MinGasPrice:              sdkmath.LegacyZeroDec(),
+						FeeModel:                 feemarkettypes.FeeModelAIMD,
+						AdditiveIncrease:         sdkmath.LegacyOneDec(),
+						MultiplicativeDecrease:   sdkmath.LegacyNewDecWithPrec(5, 1),
+					},
+					expectedResult: func() *big.Int {
+						// result = 1000000000 + additiveIncrease (converted to 18 decimals)
+						factor := evmtypes.GetEVMCoinDecimals().ConversionFactor()
+						return new(big.Int).Add(big.NewInt(1000000000), factor.BigInt())
+					}(),
+					expectedError: "",
+				},
+				{
+					name:   "AIMD base fee decrease",
+					config: config,
+					parent: &ethtypes.Header{
+						Number:   big.NewInt(10),
+						BaseFee:  big.NewInt(1000000000),
+						GasLimit: 10000000,
+						GasUsed:  4000000,
+					},
+					params: feemarkettypes.Params{
+						ElasticityMultiplier:     2,
+						BaseFeeChangeDenominator: 8,
+						MinGasPrice:              sdkmath.LegacyZeroDec(),
+						FeeModel:                 feemarkettypes.FeeModelAIMD,
+						AdditiveIncrease:         sdkmath.LegacyOneDec(),
+						MultiplicativeDecrease:   sdkmath.LegacyNewDecWithPrec(5, 1),
+					},
+					expectedResult: big.NewInt(500000000),
+					expectedError:  "",
+				},
 				{
 					name:   "very high gas usage",
 					config: config,
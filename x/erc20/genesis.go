@@ -58,6 +58,12 @@ func InitGenesis(
 			panic(fmt.Errorf("error setting allowance %s", err))
 		}
 	}
+
+	for _, nonce := range data.PermitNonces {
+		erc20 := common.HexToAddress(nonce.Erc20Address)
+		owner := common.HexToAddress(nonce.Owner)
+		k.SetPermitNonce(ctx, erc20, owner, nonce.Nonce)
+	}
 }
 
 // ExportGenesis export module status
@@ -68,5 +74,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		Allowances:         k.GetAllowances(ctx),
 		NativePrecompiles:  k.GetNativePrecompiles(ctx),
 		DynamicPrecompiles: k.GetDynamicPrecompiles(ctx),
+		PermitNonces:       k.GetPermitNonces(ctx),
 	}
 }
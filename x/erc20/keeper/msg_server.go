@@ -392,6 +392,37 @@ func (k *Keeper) ToggleConversion(goCtx context.Context, req *types.MsgToggleCon
 	return &types.MsgToggleConversionResponse{}, nil
 }
 
+// DeregisterTokenPair implements the gRPC MsgServer interface.
+//
+// After a successful governance vote it removes a token pair, migrating the outstanding
+// balances of the pair back to their native representation.
+func (k *Keeper) DeregisterTokenPair(goCtx context.Context, req *types.MsgDeregisterTokenPair) (*types.MsgDeregisterTokenPairResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Check if the conversion is globally enabled
+	if !k.IsERC20Enabled(ctx) {
+		return nil, types.ErrERC20Disabled.Wrap("token pair deregistration is currently disabled by governance")
+	}
+
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	pair, err := k.deregisterTokenPair(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeregisterTokenPair,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return &types.MsgDeregisterTokenPairResponse{}, nil
+}
+
 // validateAuthority is a helper function to validate that the provided authority
 // is the keeper's authority address
 func (k *Keeper) validateAuthority(authority string) error {
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/erc20/types"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetPermitNonce returns the EIP-2612 permit nonce of the given owner
+// on the given erc20 precompile address.
+func (k Keeper) GetPermitNonce(
+	ctx sdk.Context,
+	erc20 common.Address,
+	owner common.Address,
+) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPermitNonce)
+	bz := store.Get(types.PermitNonceKey(erc20, owner))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// IncrementPermitNonce increments the EIP-2612 permit nonce of the given owner
+// on the given erc20 precompile address. Nonces are never reset, so that a
+// permit signature can only be used once.
+func (k Keeper) IncrementPermitNonce(
+	ctx sdk.Context,
+	erc20 common.Address,
+	owner common.Address,
+) {
+	nonce := k.GetPermitNonce(ctx, erc20, owner)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPermitNonce)
+	store.Set(types.PermitNonceKey(erc20, owner), sdk.Uint64ToBigEndian(nonce+1))
+}
+
+// SetPermitNonce sets the EIP-2612 permit nonce of the given owner on the given
+// erc20 precompile address. It must only be used to import the nonces on genesis.
+func (k Keeper) SetPermitNonce(
+	ctx sdk.Context,
+	erc20 common.Address,
+	owner common.Address,
+	nonce uint64,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPermitNonce)
+	store.Set(types.PermitNonceKey(erc20, owner), sdk.Uint64ToBigEndian(nonce))
+}
+
+// GetPermitNonces returns all the EIP-2612 permit nonces. The nonces are kept
+// after the token pair of an erc20 precompile is deregistered, so that the
+// permits can never be replayed if the pair is registered again.
+func (k Keeper) GetPermitNonces(ctx sdk.Context) []types.PermitNonce {
+	nonces := []types.PermitNonce{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPermitNonce)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		erc20 := common.BytesToAddress(key[:common.AddressLength])
+		owner := common.BytesToAddress(key[common.AddressLength:])
+		nonces = append(nonces, types.NewPermitNonce(erc20, owner, sdk.BigEndianToUint64(iterator.Value())))
+	}
+
+	return nonces
+}
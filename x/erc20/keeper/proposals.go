@@ -8,8 +8,11 @@ import (
 	"github.com/cosmos/evm/x/erc20/types"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -136,3 +139,141 @@ func (k Keeper) toggleConversion(
 	k.SetTokenPair(ctx, pair)
 	return pair, nil
 }
+
+// deregisterTokenPair removes the token pair registered for the given token.
+//
+// For native ERC20 pairs, all the outstanding Cosmos coins are converted back
+// into ERC20 tokens for their holders before the pair is removed. The
+// deregistration fails if any of the coins are escrowed in IBC channels, as
+// those cannot be swept. For native coin pairs, the ERC20 precompile is
+// removed and its code hash is unregistered, leaving the balances in the bank
+// module. The allowances of the pair are deleted in both cases.
+func (k Keeper) deregisterTokenPair(
+	ctx sdk.Context,
+	token string,
+) (types.TokenPair, error) {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered by id", token,
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	contract := pair.GetERC20Contract()
+
+	switch {
+	case pair.IsNativeERC20():
+		if err := k.sweepTokenPairBalances(ctx, pair); err != nil {
+			return types.TokenPair{}, err
+		}
+	case pair.IsNativeCoin():
+		if err := k.UnRegisterERC20CodeHash(ctx, contract); err != nil {
+			return types.TokenPair{}, err
+		}
+	}
+
+	if k.IsNativePrecompileAvailable(ctx, contract) {
+		k.DeleteNativePrecompile(ctx, contract)
+	}
+	if k.IsDynamicPrecompileAvailable(ctx, contract) {
+		k.DeleteDynamicPrecompile(ctx, contract)
+	}
+
+	k.DeleteTokenPair(ctx, pair)
+	return pair, nil
+}
+
+// sweepTokenPairBalances converts the Cosmos coins of a native ERC20 token pair
+// held by every account back into ERC20 tokens, so that no coins of the pair
+// remain once it is deregistered. The coins held by non-EVM addresses can't be
+// converted, they are skipped and left in supply.
+func (k Keeper) sweepTokenPairBalances(ctx sdk.Context, pair types.TokenPair) error {
+	if k.transferKeeper != nil {
+		if escrow := k.transferKeeper.GetTotalEscrowForDenom(ctx, pair.Denom); escrow.IsPositive() {
+			return errorsmod.Wrapf(
+				types.ErrTokenPairEscrowed, "%s escrowed for token pair %s", escrow, pair.Erc20Address,
+			)
+		}
+	}
+
+	type holding struct {
+		holder sdk.AccAddress
+		amount math.Int
+	}
+
+	// collect the holders first, as the conversions modify the balances store
+	var holdings []holding
+	pageReq := &query.PageRequest{Limit: query.DefaultLimit}
+	for {
+		res, err := k.bankKeeper.DenomOwners(ctx, &banktypes.QueryDenomOwnersRequest{
+			Denom:      pair.Denom,
+			Pagination: pageReq,
+		})
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to get the holders of %s", pair.Denom)
+		}
+
+		for _, owner := range res.DenomOwners {
+			holder, err := sdk.AccAddressFromBech32(owner.Address)
+			if err != nil {
+				return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid holder address %s: %s", owner.Address, err)
+			}
+			if owner.Balance.IsPositive() {
+				holdings = append(holdings, holding{holder: holder, amount: owner.Balance.Amount})
+			}
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: query.DefaultLimit}
+	}
+
+	skipped := math.ZeroInt()
+	for _, h := range holdings {
+		if len(h.holder) != common.AddressLength {
+			skipped = skipped.Add(h.amount)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeSkipTokenPairBalance,
+					sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+					sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+					sdk.NewAttribute(types.AttributeKeyHolder, h.holder.String()),
+					sdk.NewAttribute(types.AttributeKeySkippedAmount, h.amount.String()),
+				),
+			)
+			continue
+		}
+
+		receiver := common.BytesToAddress(h.holder)
+		if err := k.ConvertCoinNativeERC20(ctx, pair, h.amount, receiver, h.holder); err != nil {
+			return errorsmod.Wrapf(err, "failed to sweep balance of %s", h.holder)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSweepTokenPairBalance,
+				sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+				sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+				sdk.NewAttribute(types.AttributeKeyReceiver, receiver.Hex()),
+				sdk.NewAttribute(types.AttributeKeySweptAmount, h.amount.String()),
+			),
+		)
+	}
+
+	if supply := k.bankKeeper.GetSupply(ctx, pair.Denom); !supply.Amount.Equal(skipped) {
+		return errorsmod.Wrapf(
+			types.ErrBalanceInvariance, "%s still in supply after sweeping token pair %s, expected %s%s",
+			supply, pair.Erc20Address, skipped, pair.Denom,
+		)
+	}
+
+	return nil
+}
@@ -29,6 +29,7 @@ const (
 	updateParams     = "cosmos/evm/erc20/MsgUpdateParams"
 	registerERC20    = "cosmos/evm/erc20/MsgRegisterERC20"
 	toggleConversion = "cosmos/evm/erc20/MsgToggleConversion"
+	deregisterPair   = "cosmos/evm/erc20/MsgDeregisterTokenPair"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -46,6 +47,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgRegisterERC20{},
 		&MsgToggleConversion{},
+		&MsgDeregisterTokenPair{},
 	)
 	registry.RegisterImplementations(
 		(*govv1beta1.Content)(nil),
@@ -66,4 +68,5 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgConvertCoin{}, convertCoinName, nil)
 	cdc.RegisterConcrete(&MsgRegisterERC20{}, registerERC20, nil)
 	cdc.RegisterConcrete(&MsgToggleConversion{}, toggleConversion, nil)
+	cdc.RegisterConcrete(&MsgDeregisterTokenPair{}, deregisterPair, nil)
 }
@@ -12,6 +12,9 @@ const (
 	EventTypeRegisterERC20          = "register_erc20"
 	EventTypeToggleTokenConversion  = "toggle_token_conversion" // #nosec
 	EventTypeRegisterERC20Extension = "register_erc20_extension"
+	EventTypeDeregisterTokenPair    = "deregister_token_pair"
+	EventTypeSweepTokenPairBalance  = "sweep_token_pair_balance"
+	EventTypeSkipTokenPairBalance   = "skip_token_pair_balance"
 
 	EventTypeFailedConvertERC20 = "failed_convert_erc20"
 
@@ -19,6 +22,9 @@ const (
 	AttributeKeyCosmosCoin     = "cosmos_coin"
 	AttributeKeyERC20Token     = "erc20_token" // #nosec
 	AttributeKeyReceiver       = "receiver"
+	AttributeKeySweptAmount    = "swept_amount"
+	AttributeKeyHolder         = "holder"
+	AttributeKeySkippedAmount  = "skipped_amount"
 )
 
 // LogTransfer Event type for Transfer(address from, address to, uint256 value)
@@ -17,9 +17,10 @@ func NewGenesisState(params Params, pairs []TokenPair, allowances []Allowance) G
 // default params and chain config values.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:     DefaultParams(),
-		TokenPairs: []TokenPair{},
-		Allowances: []Allowance{},
+		Params:       DefaultParams(),
+		TokenPairs:   []TokenPair{},
+		Allowances:   []Allowance{},
+		PermitNonces: []PermitNonce{},
 	}
 }
 
@@ -73,6 +74,21 @@ func (gs GenesisState) Validate() error {
 		seenAllowance[a.Erc20Address+a.Owner+a.Spender] = true
 	}
 
+	// Permit nonces are kept for deregistered token pairs, so they are not
+	// required to have a corresponding token pair
+	seenNonce := make(map[string]bool)
+	for _, n := range gs.PermitNonces {
+		if seenNonce[n.Erc20Address+n.Owner] {
+			return fmt.Errorf("duplicated permit nonce on genesis: %s", n.Erc20Address+n.Owner)
+		}
+
+		if err := n.Validate(); err != nil {
+			return fmt.Errorf("invalid permit nonce on genesis: %w", err)
+		}
+
+		seenNonce[n.Erc20Address+n.Owner] = true
+	}
+
 	return nil
 }
 
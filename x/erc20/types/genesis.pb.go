@@ -36,6 +36,8 @@ type GenesisState struct {
 	NativePrecompiles []string `protobuf:"bytes,4,rep,name=native_precompiles,json=nativePrecompiles,proto3" json:"native_precompiles,omitempty"`
 	// dynamic_precompiles is a slice of registered dynamic precompiles at genesis
 	DynamicPrecompiles []string `protobuf:"bytes,5,rep,name=dynamic_precompiles,json=dynamicPrecompiles,proto3" json:"dynamic_precompiles,omitempty"`
+	// permit_nonces is a slice of the EIP-2612 permit nonces at genesis
+	PermitNonces []PermitNonce `protobuf:"bytes,6,rep,name=permit_nonces,json=permitNonces,proto3" json:"permit_nonces"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -106,6 +108,13 @@ func (m *GenesisState) GetDynamicPrecompiles() []string {
 	return nil
 }
 
+func (m *GenesisState) GetPermitNonces() []PermitNonce {
+	if m != nil {
+		return m.PermitNonces
+	}
+	return nil
+}
+
 // Params defines the erc20 module params
 type Params struct {
 	// enable_erc20 is the parameter to enable the conversion of Cosmos coins <-->
@@ -171,33 +180,34 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/erc20/v1/genesis.proto", fileDescriptor_e964b7a0cc2cbbd5) }
 
 var fileDescriptor_e964b7a0cc2cbbd5 = []byte{
-	// 401 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcf, 0xaa, 0xd3, 0x40,
-	0x14, 0xc6, 0x93, 0xe6, 0xde, 0xd2, 0x4e, 0xba, 0xb0, 0x53, 0x17, 0xa1, 0x85, 0xf4, 0x8f, 0x9b,
-	0xe2, 0x22, 0xb1, 0x75, 0x23, 0x82, 0x8a, 0x05, 0x11, 0xbb, 0x2a, 0xd5, 0x95, 0x9b, 0x30, 0x8d,
-	0x87, 0x38, 0x98, 0x99, 0x09, 0x33, 0x63, 0xb4, 0x6f, 0xe1, 0x63, 0xb8, 0xf4, 0x31, 0xba, 0xec,
-	0x52, 0x10, 0x44, 0xda, 0x85, 0xaf, 0x21, 0x9d, 0x69, 0x69, 0x2a, 0xe5, 0x6e, 0xc2, 0xf0, 0xf1,
-	0xfb, 0x7d, 0x39, 0x1c, 0x0e, 0x1a, 0xa6, 0x42, 0x31, 0xa1, 0x62, 0x28, 0x59, 0x0c, 0x32, 0x9d,
-	0x3e, 0x8a, 0xcb, 0x49, 0x9c, 0x01, 0x07, 0x45, 0x55, 0x54, 0x48, 0xa1, 0x05, 0xee, 0x58, 0x24,
-	0x82, 0x92, 0x45, 0x06, 0x89, 0xca, 0x49, 0xb7, 0x4d, 0x18, 0xe5, 0x22, 0x36, 0x5f, 0xcb, 0x75,
-	0xfb, 0xd7, 0xaa, 0xac, 0x60, 0x81, 0xfb, 0x99, 0xc8, 0x84, 0x79, 0xc6, 0x87, 0x97, 0x4d, 0x47,
-	0xbf, 0x6a, 0xa8, 0xf5, 0xda, 0xfe, 0xf0, 0xad, 0x26, 0x1a, 0xf0, 0x73, 0x54, 0x2f, 0x88, 0x24,
-	0x4c, 0x05, 0xee, 0xc0, 0x1d, 0xfb, 0xd3, 0x5e, 0x74, 0x65, 0x80, 0x68, 0x61, 0x90, 0x59, 0x73,
-	0xf3, 0xbb, 0xef, 0x7c, 0xff, 0xfb, 0xe3, 0xa1, 0xbb, 0x3c, 0x5a, 0x78, 0x8e, 0x7c, 0x2d, 0x3e,
-	0x01, 0x4f, 0x0a, 0x42, 0xa5, 0x0a, 0x6a, 0x03, 0x6f, 0xec, 0x4f, 0xc3, 0xab, 0x25, 0xef, 0x0e,
-	0xdc, 0x82, 0x50, 0x59, 0xed, 0x41, 0xfa, 0x94, 0x2a, 0xfc, 0x06, 0x21, 0x92, 0xe7, 0xe2, 0x0b,
-	0xe1, 0x29, 0xa8, 0xc0, 0xbb, 0xa3, 0xea, 0xe5, 0x09, 0xbb, 0xa8, 0x3a, 0xcb, 0xf8, 0x09, 0xc2,
-	0x9c, 0x68, 0x5a, 0x42, 0x52, 0x48, 0x48, 0x05, 0x2b, 0x68, 0x0e, 0x2a, 0xb8, 0x19, 0x78, 0xe3,
-	0xa6, 0x51, 0x5c, 0xab, 0xb4, 0x2d, 0xb4, 0x38, 0x33, 0xf8, 0x29, 0xea, 0x7c, 0x58, 0x73, 0xc2,
-	0x68, 0x7a, 0xa1, 0xde, 0xfe, 0xaf, 0xe2, 0x23, 0x55, 0x71, 0x47, 0x12, 0xd5, 0xed, 0xa6, 0xf0,
-	0x10, 0xb5, 0x80, 0x93, 0x55, 0x0e, 0x89, 0x99, 0xd9, 0x2c, 0xb7, 0xb1, 0xf4, 0x6d, 0xf6, 0xea,
-	0x10, 0xe1, 0x17, 0xa8, 0x57, 0x80, 0x64, 0x54, 0x29, 0x2a, 0x78, 0x0e, 0x4a, 0x25, 0x12, 0x32,
-	0xaa, 0xb4, 0x24, 0x9a, 0x0a, 0x1e, 0xdc, 0x1a, 0xa3, 0x7b, 0x89, 0x2c, 0x2b, 0xc4, 0xfc, 0xa6,
-	0x51, 0xbb, 0xe7, 0xcd, 0x9e, 0x6d, 0x76, 0xa1, 0xbb, 0xdd, 0x85, 0xee, 0x9f, 0x5d, 0xe8, 0x7e,
-	0xdb, 0x87, 0xce, 0x76, 0x1f, 0x3a, 0x3f, 0xf7, 0xa1, 0xf3, 0xfe, 0x41, 0x46, 0xf5, 0xc7, 0xcf,
-	0xab, 0x28, 0x15, 0x2c, 0xae, 0x5c, 0xcb, 0xd7, 0xe3, 0xbd, 0xe8, 0x75, 0x01, 0x6a, 0x55, 0x37,
-	0x77, 0xf1, 0xf8, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x94, 0x48, 0x19, 0xb7, 0x9b, 0x02, 0x00,
-	0x00,
+	// 425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x4f, 0x6b, 0xd4, 0x40,
+	0x18, 0xc6, 0x37, 0x4d, 0xbb, 0xb4, 0xb3, 0x2b, 0xd8, 0xa9, 0x87, 0xb0, 0x85, 0x34, 0xad, 0x97,
+	0xc5, 0x43, 0x62, 0xd7, 0x8b, 0x08, 0x2a, 0x16, 0x44, 0xec, 0x41, 0xc2, 0xea, 0xc9, 0x4b, 0x98,
+	0x8d, 0x2f, 0x71, 0x30, 0xf3, 0x87, 0x79, 0xc7, 0x68, 0xbf, 0x85, 0x1f, 0xc3, 0x8b, 0xe0, 0xc7,
+	0xe8, 0xb1, 0x47, 0x4f, 0x22, 0xbb, 0x07, 0xbf, 0x86, 0xec, 0xcc, 0x96, 0xcd, 0x2e, 0xc1, 0x4b,
+	0x18, 0x1e, 0x7e, 0xbf, 0x27, 0x79, 0x27, 0x2f, 0x39, 0x2d, 0x15, 0x0a, 0x85, 0x19, 0x34, 0x22,
+	0x03, 0x53, 0x4e, 0x1e, 0x66, 0xcd, 0x79, 0x56, 0x81, 0x04, 0xe4, 0x98, 0x6a, 0xa3, 0xac, 0xa2,
+	0x47, 0x1e, 0x49, 0xa1, 0x11, 0xa9, 0x43, 0xd2, 0xe6, 0x7c, 0x74, 0xc8, 0x04, 0x97, 0x2a, 0x73,
+	0x4f, 0xcf, 0x8d, 0x4e, 0xba, 0xaa, 0xbc, 0xe0, 0x81, 0x7b, 0x95, 0xaa, 0x94, 0x3b, 0x66, 0xcb,
+	0x93, 0x4f, 0xcf, 0x7e, 0x84, 0x64, 0xf8, 0xca, 0xbf, 0xf0, 0xad, 0x65, 0x16, 0xe8, 0x33, 0xd2,
+	0xd7, 0xcc, 0x30, 0x81, 0x51, 0x90, 0x04, 0xe3, 0xc1, 0xe4, 0x38, 0xed, 0xf8, 0x80, 0x34, 0x77,
+	0xc8, 0xc5, 0xc1, 0xf5, 0xef, 0x93, 0xde, 0xf7, 0xbf, 0x3f, 0x1f, 0x04, 0xd3, 0x95, 0x45, 0x2f,
+	0xc9, 0xc0, 0xaa, 0x4f, 0x20, 0x0b, 0xcd, 0xb8, 0xc1, 0x68, 0x27, 0x09, 0xc7, 0x83, 0x49, 0xdc,
+	0x59, 0xf2, 0x6e, 0xc9, 0xe5, 0x8c, 0x9b, 0x76, 0x0f, 0xb1, 0xb7, 0x29, 0xd2, 0xd7, 0x84, 0xb0,
+	0xba, 0x56, 0x5f, 0x98, 0x2c, 0x01, 0xa3, 0xf0, 0x3f, 0x55, 0x2f, 0x6e, 0xb1, 0x8d, 0xaa, 0xb5,
+	0x4c, 0x1f, 0x13, 0x2a, 0x99, 0xe5, 0x0d, 0x14, 0xda, 0x40, 0xa9, 0x84, 0xe6, 0x35, 0x60, 0xb4,
+	0x9b, 0x84, 0xe3, 0x03, 0xa7, 0x04, 0x5e, 0x39, 0xf4, 0x50, 0xbe, 0x66, 0xe8, 0x13, 0x72, 0xf4,
+	0xe1, 0x4a, 0x32, 0xc1, 0xcb, 0x0d, 0x75, 0x6f, 0x5b, 0xa5, 0x2b, 0xaa, 0xed, 0xe6, 0xe4, 0x8e,
+	0x06, 0x23, 0xb8, 0x2d, 0xa4, 0x72, 0x33, 0xf4, 0xdd, 0x0c, 0x49, 0xf7, 0x9d, 0x3a, 0xf2, 0x8d,
+	0xda, 0x9a, 0x62, 0xa8, 0xd7, 0x39, 0x9e, 0x19, 0xd2, 0xf7, 0x77, 0x4f, 0x4f, 0xc9, 0x10, 0x24,
+	0x9b, 0xd5, 0x50, 0xb8, 0x06, 0xf7, 0xbb, 0xf6, 0xa7, 0x03, 0x9f, 0xbd, 0x5c, 0x46, 0xf4, 0x39,
+	0x39, 0x76, 0x32, 0x22, 0x57, 0xb2, 0x06, 0xc4, 0xc2, 0x40, 0xc5, 0xd1, 0x1a, 0x66, 0xb9, 0x92,
+	0xd1, 0x9e, 0x33, 0x46, 0x9b, 0xc8, 0xb4, 0x45, 0x5c, 0xee, 0xee, 0xef, 0xdc, 0x0d, 0x2f, 0x9e,
+	0x5e, 0xcf, 0xe3, 0xe0, 0x66, 0x1e, 0x07, 0x7f, 0xe6, 0x71, 0xf0, 0x6d, 0x11, 0xf7, 0x6e, 0x16,
+	0x71, 0xef, 0xd7, 0x22, 0xee, 0xbd, 0xbf, 0x5f, 0x71, 0xfb, 0xf1, 0xf3, 0x2c, 0x2d, 0x95, 0xc8,
+	0x5a, 0xfb, 0xf7, 0x75, 0xb5, 0x81, 0xf6, 0x4a, 0x03, 0xce, 0xfa, 0x6e, 0xd3, 0x1e, 0xfd, 0x1b,
+	0x00, 0x3c, 0xbe, 0x9e, 0x91, 0xed, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -220,6 +230,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PermitNonces) > 0 {
+		for iNdEx := len(m.PermitNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PermitNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.DynamicPrecompiles) > 0 {
 		for iNdEx := len(m.DynamicPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.DynamicPrecompiles[iNdEx])
@@ -365,6 +389,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PermitNonces) > 0 {
+		for _, e := range m.PermitNonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -583,6 +613,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.DynamicPrecompiles = append(m.DynamicPrecompiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermitNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PermitNonces = append(m.PermitNonces, PermitNonce{})
+			if err := m.PermitNonces[len(m.PermitNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
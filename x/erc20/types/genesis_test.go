@@ -311,6 +311,67 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid genesis - permit nonces without token pair",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PermitNonces: []types.PermitNonce{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Owner:        testconstants.ExampleEvmAddressAlice,
+						Nonce:        2,
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated permit nonces",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PermitNonces: []types.PermitNonce{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Owner:        testconstants.ExampleEvmAddressAlice,
+						Nonce:        2,
+					},
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Owner:        testconstants.ExampleEvmAddressAlice,
+						Nonce:        3,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid permit nonce owner",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PermitNonces: []types.PermitNonce{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Owner:        "bad",
+						Nonce:        2,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - zero permit nonce",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PermitNonces: []types.PermitNonce{
+					{
+						Erc20Address: testconstants.WEVMOSContractMainnet,
+						Owner:        testconstants.ExampleEvmAddressAlice,
+						Nonce:        0,
+					},
+				},
+			},
+			expPass: false,
+		},
 		{
 			// Voting period cant be zero
 			name:     "empty genesis",
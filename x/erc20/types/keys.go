@@ -34,6 +34,7 @@ const (
 	prefixAllowance
 	prefixNativePrecompiles
 	prefixDynamicPrecompiles
+	prefixPermitNonce
 )
 
 // KVStore key prefixes
@@ -45,6 +46,7 @@ var (
 	KeyPrefixAllowance          = []byte{prefixAllowance}
 	KeyPrefixNativePrecompiles  = []byte{prefixNativePrecompiles}
 	KeyPrefixDynamicPrecompiles = []byte{prefixDynamicPrecompiles}
+	KeyPrefixPermitNonce        = []byte{prefixPermitNonce}
 )
 
 func AllowanceKey(
@@ -54,3 +56,12 @@ func AllowanceKey(
 ) []byte {
 	return append(append(erc20.Bytes(), owner.Bytes()...), spender.Bytes()...)
 }
+
+// PermitNonceKey returns the key of the EIP-2612 permit nonce of the given
+// owner on the given erc20 precompile address.
+func PermitNonceKey(
+	erc20 common.Address,
+	owner common.Address,
+) []byte {
+	return append(erc20.Bytes(), owner.Bytes()...)
+}
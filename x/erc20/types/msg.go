@@ -1,6 +1,8 @@
 package types
 
 import (
+	"strings"
+
 	"github.com/ethereum/go-ethereum/common"
 	protov2 "google.golang.org/protobuf/proto"
 
@@ -19,11 +21,13 @@ var (
 	_ sdk.Msg              = &MsgUpdateParams{}
 	_ sdk.Msg              = &MsgRegisterERC20{}
 	_ sdk.Msg              = &MsgToggleConversion{}
+	_ sdk.Msg              = &MsgDeregisterTokenPair{}
 	_ sdk.HasValidateBasic = &MsgConvertERC20{}
 	_ sdk.HasValidateBasic = &MsgConvertCoin{}
 	_ sdk.HasValidateBasic = &MsgUpdateParams{}
 	_ sdk.HasValidateBasic = &MsgRegisterERC20{}
 	_ sdk.HasValidateBasic = &MsgToggleConversion{}
+	_ sdk.HasValidateBasic = &MsgDeregisterTokenPair{}
 )
 
 const (
@@ -121,6 +125,19 @@ func (m *MsgToggleConversion) ValidateBasic() error {
 	return nil
 }
 
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgDeregisterTokenPair) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if strings.TrimSpace(m.Token) == "" {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "token cannot be empty")
+	}
+
+	return nil
+}
+
 // Route should return the name of the module
 func (msg MsgConvertCoin) Route() string { return RouterKey }
 
@@ -279,3 +279,47 @@ func (suite *MsgsTestSuite) TestMsgUpdateValidateBasic() {
 		})
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgDeregisterTokenPairValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgDeregisterTokenPair
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&types.MsgDeregisterTokenPair{
+				Authority: "invalid",
+				Token:     utiltx.GenerateAddress().String(),
+			},
+			false,
+		},
+		{
+			"fail - empty token",
+			&types.MsgDeregisterTokenPair{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Token:     " ",
+			},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&types.MsgDeregisterTokenPair{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Token:     utiltx.GenerateAddress().String(),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
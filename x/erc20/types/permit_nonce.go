@@ -0,0 +1,35 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewPermitNonce returns the EIP-2612 permit nonce of the owner on the given
+// erc20 precompile address.
+func NewPermitNonce(erc20 common.Address, owner common.Address, nonce uint64) PermitNonce {
+	return PermitNonce{
+		Erc20Address: erc20.Hex(),
+		Owner:        owner.Hex(),
+		Nonce:        nonce,
+	}
+}
+
+func (p PermitNonce) Validate() error {
+	if !common.IsHexAddress(p.Erc20Address) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid erc20 hex address %s", p.Erc20Address)
+	}
+
+	if !common.IsHexAddress(p.Owner) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid owner hex address %s", p.Owner)
+	}
+
+	if p.Nonce == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "permit nonce cannot be zero")
+	}
+
+	return nil
+}
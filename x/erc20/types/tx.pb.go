@@ -527,6 +527,101 @@ func (m *MsgToggleConversionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgToggleConversionResponse proto.InternalMessageInfo
 
+// MsgDeregisterTokenPair is the Msg/DeregisterTokenPair request type for
+// deregistering a token pair.
+type MsgDeregisterTokenPair struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *MsgDeregisterTokenPair) Reset()         { *m = MsgDeregisterTokenPair{} }
+func (m *MsgDeregisterTokenPair) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterTokenPair) ProtoMessage()    {}
+func (*MsgDeregisterTokenPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e06c8e6992ada536, []int{10}
+}
+func (m *MsgDeregisterTokenPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterTokenPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterTokenPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeregisterTokenPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterTokenPair.Merge(m, src)
+}
+func (m *MsgDeregisterTokenPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterTokenPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterTokenPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeregisterTokenPair proto.InternalMessageInfo
+
+func (m *MsgDeregisterTokenPair) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgDeregisterTokenPair) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+// MsgDeregisterTokenPairResponse defines the response structure for executing
+// a DeregisterTokenPair message.
+type MsgDeregisterTokenPairResponse struct {
+}
+
+func (m *MsgDeregisterTokenPairResponse) Reset()         { *m = MsgDeregisterTokenPairResponse{} }
+func (m *MsgDeregisterTokenPairResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterTokenPairResponse) ProtoMessage()    {}
+func (*MsgDeregisterTokenPairResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e06c8e6992ada536, []int{11}
+}
+func (m *MsgDeregisterTokenPairResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterTokenPairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterTokenPairResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeregisterTokenPairResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterTokenPairResponse.Merge(m, src)
+}
+func (m *MsgDeregisterTokenPairResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterTokenPairResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterTokenPairResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeregisterTokenPairResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgConvertERC20)(nil), "cosmos.evm.erc20.v1.MsgConvertERC20")
 	proto.RegisterType((*MsgConvertERC20Response)(nil), "cosmos.evm.erc20.v1.MsgConvertERC20Response")
@@ -538,62 +633,67 @@ func init() {
 	proto.RegisterType((*MsgRegisterERC20Response)(nil), "cosmos.evm.erc20.v1.MsgRegisterERC20Response")
 	proto.RegisterType((*MsgToggleConversion)(nil), "cosmos.evm.erc20.v1.MsgToggleConversion")
 	proto.RegisterType((*MsgToggleConversionResponse)(nil), "cosmos.evm.erc20.v1.MsgToggleConversionResponse")
+	proto.RegisterType((*MsgDeregisterTokenPair)(nil), "cosmos.evm.erc20.v1.MsgDeregisterTokenPair")
+	proto.RegisterType((*MsgDeregisterTokenPairResponse)(nil), "cosmos.evm.erc20.v1.MsgDeregisterTokenPairResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/evm/erc20/v1/tx.proto", fileDescriptor_e06c8e6992ada536) }
 
 var fileDescriptor_e06c8e6992ada536 = []byte{
-	// 796 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x4f, 0x13, 0x4d,
-	0x18, 0xee, 0x42, 0x69, 0xbe, 0x0e, 0x7c, 0xc0, 0xb7, 0xf0, 0x41, 0x59, 0xf8, 0xca, 0xe7, 0xf2,
-	0x43, 0xac, 0xb0, 0xdb, 0x16, 0x35, 0xb1, 0x89, 0x26, 0x96, 0x78, 0xf0, 0xd0, 0xc4, 0xac, 0x7a,
-	0xf1, 0x42, 0xb6, 0xdb, 0xc9, 0xb0, 0x81, 0x9d, 0x69, 0x76, 0x86, 0x06, 0x6e, 0x86, 0xa3, 0x89,
-	0x89, 0xc6, 0xbb, 0x89, 0x37, 0x8f, 0x1c, 0xfc, 0x03, 0x3c, 0x19, 0x8e, 0x44, 0x2f, 0xc6, 0x03,
-	0x31, 0x60, 0xc2, 0xc1, 0x9b, 0x7f, 0x81, 0xd9, 0x99, 0xe9, 0xb2, 0x5d, 0xb6, 0xb6, 0xf1, 0xd2,
-	0x74, 0xde, 0xf7, 0x79, 0xe7, 0x7d, 0x9e, 0xf7, 0xc7, 0x2c, 0x98, 0x73, 0x08, 0xf5, 0x08, 0x35,
-	0x61, 0xcb, 0x33, 0xa1, 0xef, 0x94, 0x8b, 0x66, 0xab, 0x64, 0xb2, 0x3d, 0xa3, 0xe9, 0x13, 0x46,
-	0xd4, 0x09, 0xe1, 0x35, 0x60, 0xcb, 0x33, 0xb8, 0xd7, 0x68, 0x95, 0xb4, 0x7f, 0x6c, 0xcf, 0xc5,
-	0xc4, 0xe4, 0xbf, 0x02, 0xa7, 0xe5, 0xe5, 0x2d, 0x75, 0x9b, 0x42, 0xb3, 0x55, 0xaa, 0x43, 0x66,
-	0x97, 0x4c, 0x87, 0xb8, 0x58, 0xfa, 0xaf, 0x24, 0x65, 0x41, 0x10, 0x43, 0xea, 0x52, 0x09, 0x99,
-	0x96, 0x10, 0x8f, 0xa2, 0xc0, 0xe9, 0x51, 0x24, 0x1d, 0x33, 0xc2, 0xb1, 0xc9, 0x4f, 0xa6, 0x24,
-	0x24, 0x5c, 0x93, 0x88, 0x20, 0x22, 0xec, 0xc1, 0x3f, 0x69, 0x9d, 0x43, 0x84, 0xa0, 0x1d, 0x68,
-	0xda, 0x4d, 0xd7, 0xb4, 0x31, 0x26, 0xcc, 0x66, 0x2e, 0xc1, 0x32, 0x46, 0xff, 0xa9, 0x80, 0xb1,
-	0x1a, 0x45, 0x1b, 0x04, 0xb7, 0xa0, 0xcf, 0xee, 0x5b, 0x1b, 0xe5, 0xa2, 0x7a, 0x0d, 0x8c, 0x3b,
-	0x04, 0x33, 0xdf, 0x76, 0xd8, 0xa6, 0xdd, 0x68, 0xf8, 0x90, 0xd2, 0x9c, 0xf2, 0xbf, 0xb2, 0x92,
-	0xb5, 0xc6, 0xda, 0xf6, 0x7b, 0xc2, 0xac, 0x56, 0x40, 0xc6, 0xf6, 0xc8, 0x2e, 0x66, 0xb9, 0x81,
-	0x00, 0x50, 0xd5, 0x8f, 0x4e, 0xe6, 0x53, 0x5f, 0x4f, 0xe6, 0xff, 0x15, 0xc4, 0x68, 0x63, 0xdb,
-	0x70, 0x89, 0xe9, 0xd9, 0x6c, 0xcb, 0x78, 0x80, 0xd9, 0xbb, 0xf3, 0xc3, 0x82, 0x62, 0xc9, 0x08,
-	0xf5, 0x06, 0xf8, 0xcb, 0x87, 0x0e, 0x74, 0x5b, 0xd0, 0xcf, 0x0d, 0xf2, 0xe8, 0xdc, 0xa7, 0xf7,
-	0x6b, 0x93, 0x52, 0x92, 0xcc, 0xf0, 0x88, 0xf9, 0x2e, 0x46, 0x56, 0x88, 0x54, 0xa7, 0x40, 0x86,
-	0x42, 0xdc, 0x80, 0x7e, 0x2e, 0xcd, 0x29, 0xc9, 0x53, 0xa5, 0x70, 0x70, 0x7e, 0x58, 0x90, 0x87,
-	0xe7, 0xe7, 0x87, 0x05, 0x2d, 0x52, 0xe3, 0x98, 0x40, 0x7d, 0x06, 0x4c, 0xc7, 0x4c, 0x16, 0xa4,
-	0x4d, 0x82, 0x29, 0xd4, 0x3f, 0x2a, 0x60, 0xf4, 0xc2, 0xb7, 0x41, 0x5c, 0xac, 0xae, 0x83, 0x74,
-	0xd0, 0x3b, 0x5e, 0x82, 0xe1, 0xf2, 0x8c, 0x21, 0x09, 0x06, 0xcd, 0x35, 0x64, 0x73, 0x8d, 0x00,
-	0x58, 0x4d, 0x07, 0xe2, 0x2d, 0x0e, 0x56, 0xb5, 0x88, 0x38, 0x5e, 0x9a, 0x88, 0x84, 0x62, 0x28,
-	0xa1, 0x97, 0xec, 0xb6, 0xb8, 0x52, 0x4c, 0x5c, 0x74, 0x80, 0xf6, 0xe4, 0x08, 0x75, 0xb2, 0xd6,
-	0x73, 0x60, 0xaa, 0xd3, 0x12, 0x4a, 0xfc, 0x20, 0x5a, 0xfe, 0xa4, 0xd9, 0xb0, 0x19, 0x7c, 0x68,
-	0xfb, 0xb6, 0x47, 0xd5, 0x5b, 0x20, 0x6b, 0xef, 0xb2, 0x2d, 0xe2, 0xbb, 0x6c, 0x5f, 0xf4, 0xfa,
-	0x37, 0xac, 0x2e, 0xa0, 0xea, 0x5d, 0x90, 0x69, 0xf2, 0x1b, 0xb8, 0xc8, 0xe1, 0xf2, 0xac, 0x91,
-	0xb0, 0x22, 0x86, 0x48, 0x52, 0xcd, 0x06, 0xf5, 0x91, 0x33, 0x20, 0xa2, 0x2a, 0x37, 0x03, 0x61,
-	0x17, 0xf7, 0x05, 0xda, 0xf4, 0x64, 0x6d, 0x51, 0xba, 0xb2, 0x81, 0x51, 0x53, 0xa8, 0xee, 0xad,
-	0x02, 0xc6, 0x6b, 0x14, 0x59, 0x10, 0xb9, 0x94, 0x41, 0x5f, 0x4c, 0x74, 0x50, 0x71, 0x17, 0x61,
-	0xe8, 0xf7, 0xd4, 0x26, 0x71, 0xea, 0x32, 0x18, 0xe5, 0xa9, 0xe5, 0xfc, 0xc3, 0x40, 0xe0, 0xe0,
-	0x4a, 0xd6, 0x8a, 0x59, 0x2b, 0xeb, 0xa2, 0x33, 0x3c, 0x28, 0x60, 0xbf, 0x90, 0xcc, 0xbe, 0x83,
-	0x8e, 0xae, 0x81, 0x5c, 0xdc, 0x16, 0xf2, 0x7f, 0xa3, 0x80, 0x89, 0x1a, 0x45, 0x8f, 0x09, 0x42,
-	0x3b, 0x50, 0xb4, 0x8f, 0xba, 0x04, 0xff, 0x71, 0x87, 0x26, 0xc1, 0x10, 0x23, 0xdb, 0x10, 0xcb,
-	0x29, 0x14, 0x87, 0xca, 0xed, 0xcb, 0x75, 0x5f, 0x4e, 0x66, 0x1e, 0x27, 0xa2, 0xff, 0x07, 0x66,
-	0x13, 0xcc, 0x6d, 0xfe, 0xe5, 0x1f, 0x69, 0x30, 0x58, 0xa3, 0x48, 0x7d, 0xa5, 0x80, 0x91, 0x8e,
-	0x57, 0x65, 0x31, 0x71, 0x34, 0x62, 0x7b, 0xa8, 0xad, 0xf6, 0x83, 0x0a, 0x8b, 0xb5, 0x76, 0xf0,
-	0xf9, 0xfb, 0xeb, 0x81, 0xab, 0xea, 0x92, 0x99, 0xfc, 0x6e, 0x9b, 0x8e, 0x88, 0xda, 0xe4, 0x36,
-	0xf5, 0x85, 0x02, 0x86, 0xa3, 0x9b, 0xbd, 0xd0, 0x23, 0x59, 0x00, 0xd2, 0xae, 0xf7, 0x01, 0x0a,
-	0x09, 0xad, 0x72, 0x42, 0xcb, 0xea, 0x62, 0x2f, 0x42, 0xfc, 0x91, 0xa8, 0x83, 0x91, 0x8e, 0x2d,
-	0xec, 0x5a, 0xa2, 0x28, 0xaa, 0x7b, 0x89, 0x92, 0xf6, 0x41, 0x85, 0xe0, 0xef, 0xce, 0x5d, 0x58,
-	0xea, 0x16, 0xde, 0x01, 0xd3, 0xd6, 0xfa, 0x82, 0x85, 0x69, 0x30, 0x18, 0xbf, 0x34, 0xb2, 0x2b,
-	0xdd, 0xae, 0x88, 0x23, 0xb5, 0x62, 0xbf, 0xc8, 0x76, 0x3e, 0x6d, 0xe8, 0x59, 0xf0, 0x8e, 0x54,
-	0xef, 0x1c, 0x9d, 0xe6, 0x95, 0xe3, 0xd3, 0xbc, 0xf2, 0xed, 0x34, 0xaf, 0xbc, 0x3c, 0xcb, 0xa7,
-	0x8e, 0xcf, 0xf2, 0xa9, 0x2f, 0x67, 0xf9, 0xd4, 0xd3, 0x05, 0xe4, 0xb2, 0xad, 0xdd, 0xba, 0xe1,
-	0x10, 0xcf, 0x4c, 0x98, 0x6c, 0xb6, 0xdf, 0x84, 0xb4, 0x9e, 0xe1, 0x1f, 0xc1, 0xf5, 0x5f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x3b, 0x2d, 0x64, 0x53, 0xf7, 0x07, 0x00, 0x00,
+	// 846 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x4f, 0x2b, 0x55,
+	0x14, 0xef, 0xf0, 0xd1, 0xd8, 0x0b, 0x02, 0x0e, 0x08, 0xc3, 0x80, 0x03, 0x0e, 0x1f, 0x42, 0x85,
+	0x99, 0x7e, 0xa8, 0x89, 0x8d, 0x9a, 0x58, 0x74, 0xe1, 0xa2, 0x09, 0x19, 0x71, 0xe3, 0x86, 0x4c,
+	0xa7, 0x37, 0x97, 0x09, 0xcc, 0xbd, 0xcd, 0xdc, 0x4b, 0x85, 0x9d, 0x61, 0x69, 0x62, 0xa2, 0x71,
+	0x6f, 0x62, 0xe2, 0xc2, 0x25, 0x0b, 0xff, 0x00, 0x57, 0x86, 0x25, 0xd1, 0x8d, 0x71, 0x41, 0x5e,
+	0x80, 0x84, 0xfd, 0xfb, 0x0b, 0x5e, 0xee, 0x9d, 0xdb, 0x61, 0x3a, 0x4c, 0xd3, 0xbe, 0x97, 0xb7,
+	0x69, 0x7a, 0xcf, 0xf9, 0x9d, 0x7b, 0x7e, 0xbf, 0x73, 0xce, 0x3d, 0x2d, 0x58, 0xf6, 0x08, 0x0d,
+	0x08, 0xb5, 0x61, 0x27, 0xb0, 0x61, 0xe8, 0x55, 0x4a, 0x76, 0xa7, 0x6c, 0xb3, 0x33, 0xab, 0x1d,
+	0x12, 0x46, 0xd4, 0xd9, 0xc8, 0x6b, 0xc1, 0x4e, 0x60, 0x09, 0xaf, 0xd5, 0x29, 0xeb, 0x6f, 0xb9,
+	0x81, 0x8f, 0x89, 0x2d, 0x3e, 0x23, 0x9c, 0x6e, 0xc8, 0x5b, 0x9a, 0x2e, 0x85, 0x76, 0xa7, 0xdc,
+	0x84, 0xcc, 0x2d, 0xdb, 0x1e, 0xf1, 0xb1, 0xf4, 0xbf, 0x9b, 0x95, 0x05, 0x41, 0x0c, 0xa9, 0x4f,
+	0x25, 0x64, 0x41, 0x42, 0x02, 0x8a, 0xb8, 0x33, 0xa0, 0x48, 0x3a, 0x16, 0x23, 0xc7, 0xa1, 0x38,
+	0xd9, 0x92, 0x50, 0xe4, 0x9a, 0x43, 0x04, 0x91, 0xc8, 0xce, 0xbf, 0x49, 0xeb, 0x32, 0x22, 0x04,
+	0x9d, 0x40, 0xdb, 0x6d, 0xfb, 0xb6, 0x8b, 0x31, 0x61, 0x2e, 0xf3, 0x09, 0x96, 0x31, 0xe6, 0x73,
+	0x05, 0x4c, 0x37, 0x28, 0xda, 0x23, 0xb8, 0x03, 0x43, 0xf6, 0xa5, 0xb3, 0x57, 0x29, 0xa9, 0xdb,
+	0x60, 0xc6, 0x23, 0x98, 0x85, 0xae, 0xc7, 0x0e, 0xdd, 0x56, 0x2b, 0x84, 0x94, 0x6a, 0xca, 0xaa,
+	0xb2, 0x55, 0x70, 0xa6, 0xbb, 0xf6, 0xcf, 0x23, 0xb3, 0x5a, 0x03, 0x79, 0x37, 0x20, 0xa7, 0x98,
+	0x69, 0x23, 0x1c, 0x50, 0x37, 0xaf, 0x6e, 0x56, 0x72, 0xff, 0xdf, 0xac, 0xbc, 0x1d, 0x11, 0xa3,
+	0xad, 0x63, 0xcb, 0x27, 0x76, 0xe0, 0xb2, 0x23, 0xeb, 0x2b, 0xcc, 0xfe, 0x78, 0xb8, 0x2c, 0x2a,
+	0x8e, 0x8c, 0x50, 0x3f, 0x00, 0x6f, 0x84, 0xd0, 0x83, 0x7e, 0x07, 0x86, 0xda, 0xa8, 0x88, 0xd6,
+	0xfe, 0xf9, 0x73, 0x77, 0x4e, 0x4a, 0x92, 0x19, 0xbe, 0x66, 0xa1, 0x8f, 0x91, 0x13, 0x23, 0xd5,
+	0x79, 0x90, 0xa7, 0x10, 0xb7, 0x60, 0xa8, 0x8d, 0x09, 0x4a, 0xf2, 0x54, 0x2b, 0x5e, 0x3c, 0x5c,
+	0x16, 0xe5, 0xe1, 0x87, 0x87, 0xcb, 0xa2, 0x9e, 0xa8, 0x71, 0x4a, 0xa0, 0xb9, 0x08, 0x16, 0x52,
+	0x26, 0x07, 0xd2, 0x36, 0xc1, 0x14, 0x9a, 0x7f, 0x2b, 0x60, 0xea, 0xd1, 0xb7, 0x47, 0x7c, 0xac,
+	0x56, 0xc1, 0x18, 0xef, 0x9d, 0x28, 0xc1, 0x44, 0x65, 0xd1, 0x92, 0x04, 0x79, 0x73, 0x2d, 0xd9,
+	0x5c, 0x8b, 0x03, 0xeb, 0x63, 0x5c, 0xbc, 0x23, 0xc0, 0xaa, 0x9e, 0x10, 0x27, 0x4a, 0x93, 0x90,
+	0x50, 0x8a, 0x25, 0x0c, 0x92, 0xdd, 0x15, 0x57, 0x4e, 0x89, 0x4b, 0x0e, 0xd0, 0x99, 0x1c, 0xa1,
+	0x5e, 0xd6, 0xa6, 0x06, 0xe6, 0x7b, 0x2d, 0xb1, 0xc4, 0xbf, 0xa2, 0x96, 0x7f, 0xd3, 0x6e, 0xb9,
+	0x0c, 0xee, 0xbb, 0xa1, 0x1b, 0x50, 0xf5, 0x23, 0x50, 0x70, 0x4f, 0xd9, 0x11, 0x09, 0x7d, 0x76,
+	0xae, 0x29, 0x03, 0x58, 0x3d, 0x42, 0xd5, 0xcf, 0x40, 0xbe, 0x2d, 0x6e, 0x10, 0x22, 0x27, 0x2a,
+	0x4b, 0x56, 0xc6, 0x13, 0xb1, 0xa2, 0x24, 0xf5, 0x02, 0xaf, 0x8f, 0x9c, 0x81, 0x28, 0xaa, 0xf6,
+	0x21, 0x17, 0xf6, 0x78, 0x1f, 0xd7, 0x66, 0x66, 0x6b, 0x4b, 0xd2, 0x95, 0x0d, 0x4c, 0x9a, 0x62,
+	0x75, 0xbf, 0x29, 0x60, 0xa6, 0x41, 0x91, 0x03, 0x91, 0x4f, 0x19, 0x0c, 0xa3, 0x89, 0xe6, 0x15,
+	0xf7, 0x11, 0x86, 0xe1, 0x40, 0x6d, 0x12, 0xa7, 0x6e, 0x82, 0x29, 0x91, 0x5a, 0xce, 0x3f, 0xe4,
+	0x02, 0x47, 0xb7, 0x0a, 0x4e, 0xca, 0x5a, 0xab, 0x46, 0x9d, 0x11, 0x41, 0x9c, 0xfd, 0x5a, 0x36,
+	0xfb, 0x1e, 0x3a, 0xa6, 0x0e, 0xb4, 0xb4, 0x2d, 0xe6, 0xff, 0xab, 0x02, 0x66, 0x1b, 0x14, 0x1d,
+	0x10, 0x84, 0x4e, 0x60, 0xd4, 0x3e, 0xea, 0x13, 0xfc, 0xca, 0x1d, 0x9a, 0x03, 0xe3, 0x8c, 0x1c,
+	0x43, 0x2c, 0xa7, 0x30, 0x3a, 0xd4, 0x3e, 0x7e, 0x5a, 0xf7, 0xcd, 0x6c, 0xe6, 0x69, 0x22, 0xe6,
+	0x3b, 0x60, 0x29, 0xc3, 0x1c, 0xf3, 0xff, 0x5d, 0x11, 0x83, 0xf7, 0x05, 0x0c, 0xa5, 0xbc, 0x03,
+	0x9e, 0x70, 0xdf, 0xf5, 0xc3, 0xd7, 0x2c, 0xe1, 0x93, 0xa7, 0x12, 0xb6, 0xb3, 0x25, 0x64, 0x70,
+	0x31, 0x57, 0x81, 0x91, 0xed, 0xe9, 0x0a, 0xa9, 0xdc, 0x8f, 0x83, 0xd1, 0x06, 0x45, 0xea, 0xcf,
+	0x0a, 0x98, 0xec, 0x59, 0x8f, 0xeb, 0x99, 0x33, 0x9e, 0x5a, 0x28, 0xfa, 0xce, 0x30, 0xa8, 0xb8,
+	0x6a, 0xbb, 0x17, 0xff, 0xde, 0xff, 0x32, 0xf2, 0x9e, 0xba, 0x61, 0x67, 0xff, 0x00, 0xd9, 0x5e,
+	0x14, 0x75, 0x28, 0x6c, 0xea, 0x8f, 0x0a, 0x98, 0x48, 0xae, 0xa8, 0xb5, 0x01, 0xc9, 0x38, 0x48,
+	0x7f, 0x7f, 0x08, 0x50, 0x4c, 0x68, 0x47, 0x10, 0xda, 0x54, 0xd7, 0x07, 0x11, 0x12, 0xdb, 0xae,
+	0x09, 0x26, 0x7b, 0xd6, 0x49, 0xdf, 0x12, 0x25, 0x51, 0xfa, 0xce, 0x30, 0xa8, 0x2e, 0x23, 0x15,
+	0x82, 0x37, 0x7b, 0x1f, 0xf5, 0x46, 0xbf, 0xf0, 0x1e, 0x98, 0xbe, 0x3b, 0x14, 0x2c, 0x4e, 0x83,
+	0xc1, 0xcc, 0x93, 0xb7, 0xb7, 0xd5, 0xef, 0x8a, 0x34, 0x52, 0x2f, 0x0d, 0x8b, 0x8c, 0xf3, 0x7d,
+	0x07, 0x66, 0xb3, 0xde, 0x4a, 0xdf, 0x66, 0x65, 0x80, 0xf5, 0xea, 0x4b, 0x80, 0xbb, 0x89, 0xf5,
+	0xf1, 0xef, 0xf9, 0x26, 0xae, 0x7f, 0x7a, 0x75, 0x6b, 0x28, 0xd7, 0xb7, 0x86, 0xf2, 0xec, 0xd6,
+	0x50, 0x7e, 0xba, 0x33, 0x72, 0xd7, 0x77, 0x46, 0xee, 0xbf, 0x3b, 0x23, 0xf7, 0xed, 0x1a, 0xf2,
+	0xd9, 0xd1, 0x69, 0xd3, 0xf2, 0x48, 0x60, 0x67, 0x3c, 0x2c, 0x76, 0xde, 0x86, 0xb4, 0x99, 0x17,
+	0x7f, 0x23, 0xaa, 0x2f, 0x06, 0x00, 0x7a, 0xc9, 0x2c, 0x31, 0x39, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -626,6 +726,11 @@ type MsgClient interface {
 	// token pair conversion. The authority is hard-coded to the Cosmos SDK x/gov
 	// module account
 	ToggleConversion(ctx context.Context, in *MsgToggleConversion, opts ...grpc.CallOption) (*MsgToggleConversionResponse, error)
+	// DeregisterTokenPair defines a governance operation for deregistering a
+	// token pair. The outstanding balances are migrated back to the native
+	// representation of the token. The authority is hard-coded to the Cosmos SDK
+	// x/gov module account
+	DeregisterTokenPair(ctx context.Context, in *MsgDeregisterTokenPair, opts ...grpc.CallOption) (*MsgDeregisterTokenPairResponse, error)
 }
 
 type msgClient struct {
@@ -681,6 +786,15 @@ func (c *msgClient) ToggleConversion(ctx context.Context, in *MsgToggleConversio
 	return out, nil
 }
 
+func (c *msgClient) DeregisterTokenPair(ctx context.Context, in *MsgDeregisterTokenPair, opts ...grpc.CallOption) (*MsgDeregisterTokenPairResponse, error) {
+	out := new(MsgDeregisterTokenPairResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.erc20.v1.Msg/DeregisterTokenPair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ConvertERC20 mints a native Cosmos coin representation of the ERC20 token
@@ -701,6 +815,11 @@ type MsgServer interface {
 	// token pair conversion. The authority is hard-coded to the Cosmos SDK x/gov
 	// module account
 	ToggleConversion(context.Context, *MsgToggleConversion) (*MsgToggleConversionResponse, error)
+	// DeregisterTokenPair defines a governance operation for deregistering a
+	// token pair. The outstanding balances are migrated back to the native
+	// representation of the token. The authority is hard-coded to the Cosmos SDK
+	// x/gov module account
+	DeregisterTokenPair(context.Context, *MsgDeregisterTokenPair) (*MsgDeregisterTokenPairResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -722,6 +841,9 @@ func (*UnimplementedMsgServer) RegisterERC20(ctx context.Context, req *MsgRegist
 func (*UnimplementedMsgServer) ToggleConversion(ctx context.Context, req *MsgToggleConversion) (*MsgToggleConversionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ToggleConversion not implemented")
 }
+func (*UnimplementedMsgServer) DeregisterTokenPair(ctx context.Context, req *MsgDeregisterTokenPair) (*MsgDeregisterTokenPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterTokenPair not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -817,6 +939,25 @@ func _Msg_ToggleConversion_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DeregisterTokenPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeregisterTokenPair)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeregisterTokenPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.erc20.v1.Msg/DeregisterTokenPair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeregisterTokenPair(ctx, req.(*MsgDeregisterTokenPair))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.erc20.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -841,6 +982,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ToggleConversion",
 			Handler:    _Msg_ToggleConversion_Handler,
 		},
+		{
+			MethodName: "DeregisterTokenPair",
+			Handler:    _Msg_DeregisterTokenPair_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/erc20/v1/tx.proto",
@@ -1178,6 +1323,66 @@ func (m *MsgToggleConversionResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgDeregisterTokenPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterTokenPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterTokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterTokenPairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterTokenPairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterTokenPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1327,6 +1532,32 @@ func (m *MsgToggleConversionResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgDeregisterTokenPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeregisterTokenPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2253,6 +2484,170 @@ func (m *MsgToggleConversionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgDeregisterTokenPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterTokenPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterTokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeregisterTokenPairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterTokenPairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterTokenPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/evm/x/feemarket/types"
@@ -23,6 +26,9 @@ func GetQueryCmd() *cobra.Command {
 		GetBlockGasCmd(),
 		GetBaseFeeCmd(),
 		GetParamsCmd(),
+		GetBaseFeeAtCmd(),
+		GetFeeHistoryCmd(),
+		GetBurnedBaseFeeCmd(),
 	)
 	return cmd
 }
@@ -114,3 +120,107 @@ If the height is not provided, it will use the latest height from context.`,
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetBaseFeeAtCmd queries the base fee of a block recorded in the fee history
+func GetBaseFeeAtCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "base-fee-at [height]",
+		Short: "Get the base fee of a block from the fee history",
+		Long: fmt.Sprintf(`Get the base fee of a block from the fee history.
+Only the latest %d blocks are recorded in the fee history.`, types.FeeHistoryWindow),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[0], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BaseFeeAt(cmd.Context(), &types.QueryBaseFeeAtRequest{Height: height})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetFeeHistoryCmd queries the fee market values of a range of blocks
+// recorded in the fee history
+func GetFeeHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-history [from-height] [to-height]",
+		Short: "Get the base fee and block gas of a range of blocks from the fee history",
+		Long: fmt.Sprintf(`Get the base fee, gas wanted, gas used and gas limit of a range of blocks from the fee history.
+Only the latest %d blocks are recorded in the fee history, the blocks out of it are omitted.`, types.FeeHistoryWindow),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid from height %s: %w", args[0], err)
+			}
+			toHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid to height %s: %w", args[1], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeHistory(cmd.Context(), &types.QueryFeeHistoryRequest{
+				FromHeight: fromHeight,
+				ToHeight:   toHeight,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetBurnedBaseFeeCmd queries the cumulative amount of base fees burned
+func GetBurnedBaseFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burned-base-fee",
+		Short: "Get the cumulative amount of base fees burned",
+		Long:  "Get the cumulative amount of base fees burned by the base fee split policy.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BurnedBaseFee(cmd.Context(), &types.QueryBurnedBaseFeeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -24,13 +24,18 @@ func InitGenesis(
 
 	k.SetBlockGasWanted(ctx, data.BlockGas)
 
+	if !data.BurnedBaseFee.IsNil() && data.BurnedBaseFee.IsPositive() {
+		k.SetBurnedBaseFee(ctx, data.BurnedBaseFee)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis exports genesis state of the fee market module
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:   k.GetParams(ctx),
-		BlockGas: k.GetBlockGasWanted(ctx),
+		Params:        k.GetParams(ctx),
+		BlockGas:      k.GetBlockGasWanted(ctx),
+		BurnedBaseFee: k.GetBurnedBaseFee(ctx),
 	}
 }
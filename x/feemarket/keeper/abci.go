@@ -12,7 +12,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// BeginBlock updates base fee
+// BeginBlock updates base fee, and the Cosmos base fee if the gas lanes are
+// separate
 func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 	baseFee := k.CalculateBaseFee(ctx)
 
@@ -21,6 +22,10 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 		return nil
 	}
 
+	// the Cosmos base fee is calculated first, as it starts from the parent base
+	// fee when the gas lanes get separate
+	cosmosBaseFee := k.CalculateCosmosBaseFee(ctx)
+	k.SetCosmosBaseFee(ctx, cosmosBaseFee)
 	k.SetBaseFee(ctx, baseFee)
 
 	defer func() {
@@ -34,17 +39,20 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 	}()
 
 	// Store current base fee in event
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeFeeMarket,
-			sdk.NewAttribute(types.AttributeKeyBaseFee, baseFee.String()),
-		),
-	})
+	event := sdk.NewEvent(
+		types.EventTypeFeeMarket,
+		sdk.NewAttribute(types.AttributeKeyBaseFee, baseFee.String()),
+	)
+	if !cosmosBaseFee.IsNil() {
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyCosmosBaseFee, cosmosBaseFee.String()))
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{event})
 
 	return nil
 }
 
-// EndBlock update block gas wanted.
+// EndBlock update block gas wanted, and records the fee market values of the
+// block in the fee history.
 // The EVM end block logic doesn't update the validator set, thus it returns
 // an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context) error {
@@ -78,6 +86,38 @@ func (k *Keeper) EndBlock(ctx sdk.Context) error {
 	updatedGasWanted := math.LegacyMaxDec(limitedGasWanted, math.LegacyNewDec(gasUsed.Int64())).TruncateInt().Uint64()
 	k.SetBlockGasWanted(ctx, updatedGasWanted)
 
+	// the gas used of the block is apportioned to the gas lanes according to
+	// their gas wanted
+	updatedLaneGasWanted := make(map[types.GasLane]uint64, len(types.GasLanes))
+	for _, lane := range types.GasLanes {
+		laneGasWanted := math.NewIntFromUint64(k.GetTransientLaneGasWanted(ctx, lane))
+		limitedLaneGasWanted := math.LegacyNewDecFromInt(laneGasWanted).Mul(minGasMultiplier)
+		laneGasUsed := math.LegacyZeroDec()
+		if gasWanted.IsPositive() {
+			laneGasUsed = math.LegacyNewDecFromInt(gasUsed.Mul(laneGasWanted)).QuoInt(gasWanted)
+		}
+		updatedLaneGasWanted[lane] = math.LegacyMaxDec(limitedLaneGasWanted, laneGasUsed).TruncateInt().Uint64()
+		k.SetBlockLaneGasWanted(ctx, lane, updatedLaneGasWanted[lane])
+	}
+
+	baseFee := k.GetBaseFee(ctx)
+	if baseFee.IsNil() {
+		baseFee = math.LegacyZeroDec()
+	}
+	var gasLimit uint64
+	if consParams := ctx.ConsensusParams(); consParams.Block != nil && consParams.Block.MaxGas > 0 {
+		gasLimit = uint64(consParams.Block.MaxGas) //nolint:gosec // G115 // max gas is positive
+	}
+	k.SetBlockFee(ctx, types.BlockFee{
+		Height:          ctx.BlockHeight(),
+		BaseFee:         baseFee,
+		GasWanted:       updatedGasWanted,
+		GasUsed:         gasUsed.Uint64(),
+		GasLimit:        gasLimit,
+		EvmGasWanted:    updatedLaneGasWanted[types.GasLaneEVM],
+		CosmosGasWanted: updatedLaneGasWanted[types.GasLaneCosmos],
+	})
+
 	defer func() {
 		telemetry.SetGauge(float32(updatedGasWanted), "feemarket", "block_gas")
 	}()
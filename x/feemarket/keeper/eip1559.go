@@ -3,7 +3,7 @@ package keeper
 import (
 	"math"
 
-	"github.com/cosmos/evm/utils"
+	"github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
@@ -11,8 +11,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// CalculateBaseFee calculates the base fee for the current block. This is only calculated once per
+// CalculateBaseFee calculates the base fee for the current block, using the
+// base fee model selected by the FeeModel parameter. This is only calculated once per
 // block during BeginBlock. If the NoBaseFee parameter is enabled or below activation height, this function returns nil.
+// If the gas lanes are separate, the base fee prices the Ethereum transactions
+// and only depends on the gas of the EVM lane.
 // NOTE: This code is inspired from the go-ethereum EIP1559 implementation and adapted to Cosmos SDK-based
 // chains. For the canonical code refer to: https://github.com/ethereum/go-ethereum/blob/master/consensus/misc/eip1559.go
 func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
@@ -23,8 +26,6 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
 		return sdkmath.LegacyDec{}
 	}
 
-	consParams := ctx.ConsensusParams()
-
 	// If the current block is the first EIP-1559 block, return the base fee
 	// defined in the parameters (DefaultBaseFee if it hasn't been changed by
 	// governance).
@@ -42,28 +43,68 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
 	}
 
 	parentGasUsed := k.GetBlockGasWanted(ctx)
+	if params.HasSeparateGasLanes() {
+		parentGasUsed = k.GetBlockLaneGasWanted(ctx, types.GasLaneEVM)
+	}
+
+	return k.calculateLaneBaseFee(ctx, params, types.GasLaneEVM, parentBaseFee, parentGasUsed)
+}
+
+// CalculateCosmosBaseFee calculates the base fee of the Cosmos transactions for
+// the current block from the gas of the Cosmos lane, as CalculateBaseFee does
+// for the Ethereum transactions. It returns nil if the gas lanes are not
+// separate, the Cosmos transactions being priced by the base fee.
+func (k Keeper) CalculateCosmosBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
+	params := k.GetParams(ctx)
+
+	if !params.IsBaseFeeEnabled(ctx.BlockHeight()) || !params.HasSeparateGasLanes() {
+		return sdkmath.LegacyDec{}
+	}
 
+	parentBaseFee := k.GetCosmosBaseFee(ctx)
+	if ctx.BlockHeight() == params.EnableHeight || parentBaseFee.IsNil() {
+		return parentBaseFee
+	}
+
+	return k.calculateLaneBaseFee(ctx, params, types.GasLaneCosmos, parentBaseFee, k.GetBlockLaneGasWanted(ctx, types.GasLaneCosmos))
+}
+
+// calculateLaneBaseFee calculates the base fee of the given gas lane from the
+// parent base fee and gas used of the lane, against the gas target of the lane.
+func (k Keeper) calculateLaneBaseFee(
+	ctx sdk.Context,
+	params types.Params,
+	lane types.GasLane,
+	parentBaseFee sdkmath.LegacyDec,
+	parentGasUsed uint64,
+) sdkmath.LegacyDec {
 	gasLimit := sdkmath.NewIntFromUint64(math.MaxUint64)
 
 	// NOTE: a MaxGas equal to -1 means that block gas is unlimited
-	if consParams.Block != nil && consParams.Block.MaxGas > -1 {
+	if consParams := ctx.ConsensusParams(); consParams.Block != nil && consParams.Block.MaxGas > -1 {
 		gasLimit = sdkmath.NewInt(consParams.Block.MaxGas)
 	}
 
-	// CONTRACT: ElasticityMultiplier cannot be 0 as it's checked in the params
-	// validation
-	parentGasTargetInt := gasLimit.Quo(sdkmath.NewIntFromUint64(uint64(params.ElasticityMultiplier)))
+	// CONTRACT: the elasticity multipliers cannot be 0 as it's checked in the
+	// params validation
+	parentGasTargetInt := gasLimit.Quo(sdkmath.NewIntFromUint64(uint64(params.LaneElasticityMultiplier(lane))))
 	if !parentGasTargetInt.IsUint64() {
 		return sdkmath.LegacyDec{}
 	}
 
+	model, err := types.NewBaseFeeModel(params.FeeModel)
+	if err != nil {
+		// impossible if the parameter validation passed.
+		k.Logger(ctx).Error("failed to get the base fee model", "error", err.Error())
+		return sdkmath.LegacyDec{}
+	}
+
 	factor := evmtypes.GetEVMCoinDecimals().ConversionFactor()
-	return utils.CalcGasBaseFee(
+	return model.CalcBaseFee(
+		params,
+		parentBaseFee,
 		parentGasUsed,
 		parentGasTargetInt.Uint64(),
-		uint64(params.BaseFeeChangeDenominator),
-		parentBaseFee,
 		sdkmath.LegacyOneDec().QuoInt(factor),
-		params.MinGasPrice,
 	)
 }
@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/cosmos/evm/x/feemarket/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -29,7 +32,9 @@ func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types
 
 	res := &types.QueryBaseFeeResponse{}
 	baseFee := k.GetBaseFee(ctx)
+	cosmosBaseFee := k.GetCosmosBaseFee(ctx)
 	res.BaseFee = &baseFee
+	res.CosmosBaseFee = &cosmosBaseFee
 
 	return res, nil
 }
@@ -38,12 +43,76 @@ func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types
 func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*types.QueryBlockGasResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	gas := sdkmath.NewIntFromUint64(k.GetBlockGasWanted(ctx))
+	evmGas := sdkmath.NewIntFromUint64(k.GetBlockLaneGasWanted(ctx, types.GasLaneEVM))
+	cosmosGas := sdkmath.NewIntFromUint64(k.GetBlockLaneGasWanted(ctx, types.GasLaneCosmos))
 
 	if !gas.IsInt64() {
 		return nil, errorsmod.Wrapf(sdk.ErrIntOverflowCoin, "block gas %s is higher than MaxInt64", gas)
 	}
+	if !evmGas.IsInt64() {
+		return nil, errorsmod.Wrapf(sdk.ErrIntOverflowCoin, "block evm gas %s is higher than MaxInt64", evmGas)
+	}
+	if !cosmosGas.IsInt64() {
+		return nil, errorsmod.Wrapf(sdk.ErrIntOverflowCoin, "block cosmos gas %s is higher than MaxInt64", cosmosGas)
+	}
 
 	return &types.QueryBlockGasResponse{
-		Gas: gas.Int64(),
+		Gas:       gas.Int64(),
+		EvmGas:    evmGas.Int64(),
+		CosmosGas: cosmosGas.Int64(),
+	}, nil
+}
+
+// BaseFeeAt implements the Query/BaseFeeAt gRPC method
+func (k Keeper) BaseFeeAt(c context.Context, req *types.QueryBaseFeeAtRequest) (*types.QueryBaseFeeAtResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Height <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height %d", req.Height)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	blockFee, found := k.GetBlockFee(ctx, req.Height)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no fee history at height %d", req.Height)
+	}
+
+	return &types.QueryBaseFeeAtResponse{
+		BaseFee: blockFee.BaseFee,
+	}, nil
+}
+
+// FeeHistory implements the Query/FeeHistory gRPC method
+func (k Keeper) FeeHistory(c context.Context, req *types.QueryFeeHistoryRequest) (*types.QueryFeeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.FromHeight <= 0 || req.FromHeight > req.ToHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height range [%d, %d]", req.FromHeight, req.ToHeight)
+	}
+	if req.ToHeight-req.FromHeight >= types.FeeHistoryWindow {
+		return nil, status.Errorf(codes.InvalidArgument, "height range exceeds the fee history window of %d blocks", types.FeeHistoryWindow)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	blockFees := []types.BlockFee{}
+	for height := req.FromHeight; height <= req.ToHeight; height++ {
+		if blockFee, found := k.GetBlockFee(ctx, height); found {
+			blockFees = append(blockFees, blockFee)
+		}
+	}
+
+	return &types.QueryFeeHistoryResponse{
+		BlockFees: blockFees,
+	}, nil
+}
+
+// BurnedBaseFee implements the Query/BurnedBaseFee gRPC method
+func (k Keeper) BurnedBaseFee(c context.Context, _ *types.QueryBurnedBaseFeeRequest) (*types.QueryBurnedBaseFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBurnedBaseFeeResponse{
+		Burned: k.GetBurnedBaseFee(ctx),
 	}, nil
 }
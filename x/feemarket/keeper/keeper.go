@@ -1,9 +1,12 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/cosmos/evm/x/feemarket/types"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -62,6 +65,22 @@ func (k Keeper) GetBlockGasWanted(ctx sdk.Context) uint64 {
 	return sdk.BigEndianToUint64(store.Get(types.KeyPrefixBlockGasWanted))
 }
 
+// SetBlockLaneGasWanted sets the block gas wanted of the given gas lane to the
+// store.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) SetBlockLaneGasWanted(ctx sdk.Context, lane types.GasLane, gas uint64) {
+	store := ctx.KVStore(k.storeKey)
+	gasBz := sdk.Uint64ToBigEndian(gas)
+	store.Set(types.BlockLaneGasWantedKey(lane), gasBz)
+}
+
+// GetBlockLaneGasWanted returns the last block gas wanted value of the given gas
+// lane from the store.
+func (k Keeper) GetBlockLaneGasWanted(ctx sdk.Context, lane types.GasLane) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.BigEndianToUint64(store.Get(types.BlockLaneGasWantedKey(lane)))
+}
+
 // GetTransientGasWanted returns the gas wanted in the current block from transient store.
 func (k Keeper) GetTransientGasWanted(ctx sdk.Context) uint64 {
 	store := ctx.TransientStore(k.transientKey)
@@ -75,9 +94,100 @@ func (k Keeper) SetTransientBlockGasWanted(ctx sdk.Context, gasWanted uint64) {
 	store.Set(types.KeyPrefixTransientBlockGasWanted, gasBz)
 }
 
-// AddTransientGasWanted adds the cumulative gas wanted in the transient store
-func (k Keeper) AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64, error) {
+// GetTransientLaneGasWanted returns the gas wanted of the given gas lane in the
+// current block from transient store.
+func (k Keeper) GetTransientLaneGasWanted(ctx sdk.Context, lane types.GasLane) uint64 {
+	store := ctx.TransientStore(k.transientKey)
+	return sdk.BigEndianToUint64(store.Get(types.TransientBlockLaneGasWantedKey(lane)))
+}
+
+// SetTransientLaneGasWanted sets the block gas wanted of the given gas lane to
+// the transient store.
+func (k Keeper) SetTransientLaneGasWanted(ctx sdk.Context, lane types.GasLane, gasWanted uint64) {
+	store := ctx.TransientStore(k.transientKey)
+	gasBz := sdk.Uint64ToBigEndian(gasWanted)
+	store.Set(types.TransientBlockLaneGasWantedKey(lane), gasBz)
+}
+
+// AddTransientGasWanted adds the gas wanted of a tx to the cumulative gas wanted
+// of the block and of the gas lane of the tx in the transient store. It returns
+// the cumulative gas wanted of the block.
+func (k Keeper) AddTransientGasWanted(ctx sdk.Context, gasWanted uint64, lane types.GasLane) (uint64, error) {
+	k.SetTransientLaneGasWanted(ctx, lane, k.GetTransientLaneGasWanted(ctx, lane)+gasWanted)
+
 	result := k.GetTransientGasWanted(ctx) + gasWanted
 	k.SetTransientBlockGasWanted(ctx, result)
 	return result, nil
 }
+
+// ----------------------------------------------------------------------------
+// Burned Base Fee
+// Cumulative amount of the base fees burned by the base fee split.
+// ----------------------------------------------------------------------------
+
+// GetBurnedBaseFee returns the cumulative amount of base fees burned.
+func (k Keeper) GetBurnedBaseFee(ctx sdk.Context) math.LegacyDec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixBurnedBaseFee)
+	if len(bz) == 0 {
+		return math.LegacyZeroDec()
+	}
+
+	var burned math.LegacyDec
+	if err := burned.Unmarshal(bz); err != nil {
+		panic(fmt.Errorf("failed to unmarshal burned base fee: %w", err))
+	}
+	return burned
+}
+
+// AddBurnedBaseFee adds the given amount to the cumulative amount of base fees
+// burned.
+func (k Keeper) AddBurnedBaseFee(ctx sdk.Context, amount math.LegacyDec) {
+	k.SetBurnedBaseFee(ctx, k.GetBurnedBaseFee(ctx).Add(amount))
+}
+
+// SetBurnedBaseFee sets the cumulative amount of base fees burned.
+func (k Keeper) SetBurnedBaseFee(ctx sdk.Context, burned math.LegacyDec) {
+	bz, err := burned.Marshal()
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal burned base fee: %w", err))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefixBurnedBaseFee, bz)
+}
+
+// ----------------------------------------------------------------------------
+// Fee History
+// Ring buffer of the fee market values of the latest blocks.
+// ----------------------------------------------------------------------------
+
+// SetBlockFee records the fee market values of a block in the fee history,
+// replacing the block recorded FeeHistoryWindow blocks before.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) SetBlockFee(ctx sdk.Context, blockFee types.BlockFee) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BlockFeeKey(blockFee.Height), k.cdc.MustMarshal(&blockFee))
+}
+
+// GetBlockFee returns the fee market values of the block at the given height,
+// and false if the block is not recorded in the fee history.
+func (k Keeper) GetBlockFee(ctx sdk.Context, height int64) (types.BlockFee, bool) {
+	if height <= 0 {
+		return types.BlockFee{}, false
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BlockFeeKey(height))
+	if bz == nil {
+		return types.BlockFee{}, false
+	}
+
+	var blockFee types.BlockFee
+	k.cdc.MustUnmarshal(bz, &blockFee)
+	// the entry may have been replaced by a later block
+	if blockFee.Height != height {
+		return types.BlockFee{}, false
+	}
+	return blockFee, true
+}
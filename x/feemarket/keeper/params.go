@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/cosmos/evm/x/feemarket/types"
 
 	"cosmossdk.io/math"
@@ -61,3 +63,44 @@ func (k Keeper) SetBaseFee(ctx sdk.Context, baseFee math.LegacyDec) {
 		return
 	}
 }
+
+// GetCosmosBaseFee gets the base fee of the Cosmos transactions from the store,
+// which is the base fee unless the gas lanes are separate. It starts from the
+// base fee when the gas lanes get separate.
+func (k Keeper) GetCosmosBaseFee(ctx sdk.Context) math.LegacyDec {
+	params := k.GetParams(ctx)
+	if params.NoBaseFee {
+		return math.LegacyDec{}
+	}
+	if !params.HasSeparateGasLanes() {
+		return params.BaseFee
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixCosmosBaseFee)
+	if len(bz) == 0 {
+		return params.BaseFee
+	}
+
+	var baseFee math.LegacyDec
+	if err := baseFee.Unmarshal(bz); err != nil {
+		panic(fmt.Errorf("failed to unmarshal cosmos base fee: %w", err))
+	}
+	return baseFee
+}
+
+// SetCosmosBaseFee sets the base fee of the Cosmos transactions in the store,
+// or deletes it if nil.
+func (k Keeper) SetCosmosBaseFee(ctx sdk.Context, baseFee math.LegacyDec) {
+	store := ctx.KVStore(k.storeKey)
+	if baseFee.IsNil() {
+		store.Delete(types.KeyPrefixCosmosBaseFee)
+		return
+	}
+
+	bz, err := baseFee.Marshal()
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal cosmos base fee: %w", err))
+	}
+	store.Set(types.KeyPrefixCosmosBaseFee, bz)
+}
@@ -4,5 +4,6 @@ package types
 const (
 	EventTypeFeeMarket = "fee_market"
 
-	AttributeKeyBaseFee = "base_fee"
+	AttributeKeyBaseFee       = "base_fee"
+	AttributeKeyCosmosBaseFee = "cosmos_base_fee"
 )
@@ -0,0 +1,114 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// BaseFeeModel defines an algorithm calculating the base fee of a block from
+// the base fee and the gas of its parent block. The amounts are denominated in
+// the same unit as the fee market params.
+type BaseFeeModel interface {
+	// CalcBaseFee returns the base fee of a block, given the base fee and the
+	// gas used of its parent block, the gas target of the blocks and the
+	// smallest base fee increment.
+	CalcBaseFee(p Params, parentBaseFee math.LegacyDec, parentGasUsed, gasTarget uint64, minUnitGas math.LegacyDec) math.LegacyDec
+	// MaxBaseFeeIncrease returns the maximum increase of the base fee from a
+	// block to the next one, assuming all the block gas limit is consumed by
+	// the EVM lane. It is never lower than the smallest base fee increment.
+	MaxBaseFeeIncrease(p Params, baseFee, minUnitGas math.LegacyDec) math.LegacyDec
+}
+
+// NewBaseFeeModel returns the base fee model of the given fee model.
+func NewBaseFeeModel(feeModel FeeModel) (BaseFeeModel, error) {
+	switch feeModel {
+	case FeeModelEIP1559:
+		return EIP1559Model{}, nil
+	case FeeModelAIMD:
+		return AIMDModel{}, nil
+	default:
+		return nil, fmt.Errorf("invalid fee model: %s", feeModel)
+	}
+}
+
+// EIP1559Model adjusts the base fee proportionally to the deviation of the gas
+// used from the target, bounded by the base fee change denominator.
+// NOTE: This code is inspired from the go-ethereum EIP1559 implementation. For
+// the canonical code refer to: https://github.com/ethereum/go-ethereum/blob/master/consensus/misc/eip1559.go
+type EIP1559Model struct{}
+
+var _ BaseFeeModel = EIP1559Model{}
+
+// CalcBaseFee implements BaseFeeModel.
+func (EIP1559Model) CalcBaseFee(p Params, parentBaseFee math.LegacyDec, parentGasUsed, gasTarget uint64, minUnitGas math.LegacyDec) math.LegacyDec {
+	// If the parent gasUsed is the same as the target, the baseFee remains unchanged.
+	if parentGasUsed == gasTarget {
+		return parentBaseFee
+	}
+
+	if gasTarget == 0 {
+		return math.LegacyZeroDec()
+	}
+
+	num := math.LegacyNewDecFromInt(math.NewIntFromUint64(parentGasUsed).Sub(math.NewIntFromUint64(gasTarget)).Abs())
+	num = num.Mul(parentBaseFee)
+	num = num.QuoInt(math.NewIntFromUint64(gasTarget))
+	num = num.QuoInt(math.NewIntFromUint64(uint64(p.BaseFeeChangeDenominator)))
+
+	if parentGasUsed > gasTarget {
+		// If the parent block used more gas than its target, the baseFee should increase.
+		// max(1, parentBaseFee * gasUsedDelta / parentGasTarget / baseFeeChangeDenominator)
+		baseFeeDelta := math.LegacyMaxDec(num, minUnitGas)
+		return parentBaseFee.Add(baseFeeDelta)
+	}
+
+	// Otherwise if the parent block used less gas than its target, the baseFee should decrease.
+	// max(minGasPrice, parentBaseFee * gasUsedDelta / parentGasTarget / baseFeeChangeDenominator)
+	return math.LegacyMaxDec(parentBaseFee.Sub(num), p.MinGasPrice)
+}
+
+// MaxBaseFeeIncrease implements BaseFeeModel. The increase is at maximum when
+// the gas used is equal to the gas limit, which is, with the elasticity
+// multiplier of the EVM lane:
+//
+//	MaxDelta = max(MinUnitGas, BaseFee * (GasLimit - GasLimit / ElasticityMultiplier) / (GasLimit / ElasticityMultiplier) / Denominator)
+//	         = max(MinUnitGas, BaseFee * (ElasticityMultiplier - 1) / Denominator)
+func (EIP1559Model) MaxBaseFeeIncrease(p Params, baseFee, minUnitGas math.LegacyDec) math.LegacyDec {
+	elasticityMultiplier := p.LaneElasticityMultiplier(GasLaneEVM)
+	if elasticityMultiplier == 0 || p.BaseFeeChangeDenominator == 0 {
+		// impossible if the parameter validation passed.
+		return math.LegacyZeroDec()
+	}
+	delta := baseFee.MulInt64(int64(elasticityMultiplier) - 1).QuoInt64(int64(p.BaseFeeChangeDenominator))
+	return math.LegacyMaxDec(delta, minUnitGas)
+}
+
+// AIMDModel additively increases the base fee after a block using more gas
+// than the target, and multiplicatively decreases it after a block using less
+// gas than the target. The base fee reacts to congestion by a bounded amount,
+// and converges quickly to the min gas price when the blocks are not full.
+type AIMDModel struct{}
+
+var _ BaseFeeModel = AIMDModel{}
+
+// CalcBaseFee implements BaseFeeModel.
+func (AIMDModel) CalcBaseFee(p Params, parentBaseFee math.LegacyDec, parentGasUsed, gasTarget uint64, minUnitGas math.LegacyDec) math.LegacyDec {
+	switch {
+	case parentGasUsed > gasTarget:
+		// parentBaseFee + max(minUnitGas, additiveIncrease)
+		return parentBaseFee.Add(math.LegacyMaxDec(p.AdditiveIncrease, minUnitGas))
+	case parentGasUsed < gasTarget:
+		// max(minGasPrice, parentBaseFee * multiplicativeDecrease)
+		return math.LegacyMaxDec(parentBaseFee.Mul(p.MultiplicativeDecrease), p.MinGasPrice)
+	default:
+		return parentBaseFee
+	}
+}
+
+// MaxBaseFeeIncrease implements BaseFeeModel. The base fee increases by the
+// additive increase regardless of the gas used above the target, as in
+// CalcBaseFee.
+func (AIMDModel) MaxBaseFeeIncrease(p Params, _, minUnitGas math.LegacyDec) math.LegacyDec {
+	return math.LegacyMaxDec(p.AdditiveIncrease, minUnitGas)
+}
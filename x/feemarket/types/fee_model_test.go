@@ -0,0 +1,116 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/math"
+)
+
+type FeeModelTestSuite struct {
+	suite.Suite
+}
+
+func TestFeeModelTestSuite(t *testing.T) {
+	suite.Run(t, new(FeeModelTestSuite))
+}
+
+func (suite *FeeModelTestSuite) TestNewBaseFeeModel() {
+	model, err := NewBaseFeeModel(FeeModelEIP1559)
+	suite.Require().NoError(err)
+	suite.Require().Equal(EIP1559Model{}, model)
+
+	model, err = NewBaseFeeModel(FeeModelAIMD)
+	suite.Require().NoError(err)
+	suite.Require().Equal(AIMDModel{}, model)
+
+	_, err = NewBaseFeeModel(FeeModel(10))
+	suite.Require().Error(err)
+}
+
+func (suite *FeeModelTestSuite) TestCalcBaseFee() {
+	params := DefaultParams()
+	params.MinGasPrice = math.LegacyNewDec(500)
+	parentBaseFee := math.LegacyNewDec(1000)
+	minUnitGas := math.LegacyOneDec()
+
+	testCases := []struct {
+		name          string
+		model         BaseFeeModel
+		parentGasUsed uint64
+		expBaseFee    math.LegacyDec
+	}{
+		{"EIP-1559 - gas used equal to the target", EIP1559Model{}, 50, parentBaseFee},
+		{"EIP-1559 - gas used above the target", EIP1559Model{}, 100, math.LegacyNewDec(1125)},
+		{"EIP-1559 - gas used slightly above the target", EIP1559Model{}, 51, math.LegacyNewDecWithPrec(10025, 1)},
+		{"EIP-1559 - gas used below the target", EIP1559Model{}, 0, math.LegacyNewDec(875)},
+		{"AIMD - gas used equal to the target", AIMDModel{}, 50, parentBaseFee},
+		{"AIMD - gas used above the target", AIMDModel{}, 51, parentBaseFee.Add(DefaultAdditiveIncrease)},
+		{"AIMD - gas used below the target", AIMDModel{}, 49, math.LegacyNewDec(875)},
+	}
+
+	for _, tc := range testCases {
+		baseFee := tc.model.CalcBaseFee(params, parentBaseFee, tc.parentGasUsed, 50, minUnitGas)
+		suite.Require().Equal(tc.expBaseFee, baseFee, tc.name)
+	}
+
+	// the base fee doesn't decrease below the min gas price
+	baseFee := AIMDModel{}.CalcBaseFee(params, math.LegacyNewDec(510), 0, 50, minUnitGas)
+	suite.Require().Equal(params.MinGasPrice, baseFee)
+	baseFee = EIP1559Model{}.CalcBaseFee(params, math.LegacyNewDec(510), 0, 50, minUnitGas)
+	suite.Require().Equal(params.MinGasPrice, baseFee)
+
+	// the base fee increases by at least the min unit gas
+	params.AdditiveIncrease = math.LegacyZeroDec()
+	baseFee = AIMDModel{}.CalcBaseFee(params, parentBaseFee, 51, 50, minUnitGas)
+	suite.Require().Equal(parentBaseFee.Add(minUnitGas), baseFee)
+}
+
+func (suite *FeeModelTestSuite) TestMaxBaseFeeIncrease() {
+	params := DefaultParams()
+	baseFee := math.LegacyNewDec(1000)
+	minUnitGas := math.LegacyOneDec()
+
+	// baseFee * (ElasticityMultiplier - 1) / BaseFeeChangeDenominator
+	suite.Require().Equal(math.LegacyNewDec(125), EIP1559Model{}.MaxBaseFeeIncrease(params, baseFee, minUnitGas))
+	suite.Require().Equal(DefaultAdditiveIncrease, AIMDModel{}.MaxBaseFeeIncrease(params, baseFee, minUnitGas))
+
+	// the increase is at least the min unit gas
+	suite.Require().Equal(minUnitGas, EIP1559Model{}.MaxBaseFeeIncrease(params, math.LegacyNewDec(1), minUnitGas))
+	params.AdditiveIncrease = math.LegacyZeroDec()
+	suite.Require().Equal(minUnitGas, AIMDModel{}.MaxBaseFeeIncrease(params, baseFee, minUnitGas))
+
+	// separate gas lanes bound the increase by the evm lane multiplier
+	params.EvmElasticityMultiplier = 4
+	params.CosmosElasticityMultiplier = 2
+	suite.Require().Equal(math.LegacyNewDec(375), EIP1559Model{}.MaxBaseFeeIncrease(params, baseFee, minUnitGas))
+}
+
+func (suite *FeeModelTestSuite) TestMaxBaseFeeIncreaseBound() {
+	const gasTarget = 50
+	minUnitGas := math.LegacyOneDec()
+	additiveIncreases := []math.LegacyDec{DefaultAdditiveIncrease, math.LegacyZeroDec(), math.LegacyNewDecWithPrec(5, 1)}
+	parentBaseFees := []math.LegacyDec{math.LegacyZeroDec(), math.LegacyOneDec(), math.LegacyNewDec(7), math.LegacyNewDec(1000)}
+
+	for _, model := range []BaseFeeModel{EIP1559Model{}, AIMDModel{}} {
+		for _, additiveIncrease := range additiveIncreases {
+			params := DefaultParams()
+			params.MinGasPrice = math.LegacyZeroDec()
+			params.AdditiveIncrease = additiveIncrease
+			gasLimit := uint64(gasTarget * params.ElasticityMultiplier)
+
+			for _, parentBaseFee := range parentBaseFees {
+				maxIncrease := model.MaxBaseFeeIncrease(params, parentBaseFee, minUnitGas)
+				for gasUsed := uint64(0); gasUsed <= gasLimit; gasUsed++ {
+					baseFee := model.CalcBaseFee(params, parentBaseFee, gasUsed, gasTarget, minUnitGas)
+					suite.Require().True(
+						baseFee.Sub(parentBaseFee).LTE(maxIncrease),
+						"%T: base fee %s increased from %s by more than %s with %d gas used",
+						model, baseFee, parentBaseFee, maxIncrease, gasUsed,
+					)
+				}
+			}
+		}
+	}
+}
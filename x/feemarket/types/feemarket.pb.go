@@ -25,6 +25,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// FeeModel defines the algorithms calculating the base fee of a block from the
+// base fee and the gas of its parent block.
+type FeeModel int32
+
+const (
+	// FEE_MODEL_EIP1559 adjusts the base fee proportionally to the deviation of
+	// the gas from the target, as defined by EIP-1559
+	FeeModelEIP1559 FeeModel = 0
+	// FEE_MODEL_AIMD additively increases the base fee above the gas target and
+	// multiplicatively decreases it below the target
+	FeeModelAIMD FeeModel = 1
+)
+
+var FeeModel_name = map[int32]string{
+	0: "FEE_MODEL_EIP1559",
+	1: "FEE_MODEL_AIMD",
+}
+
+var FeeModel_value = map[string]int32{
+	"FEE_MODEL_EIP1559": 0,
+	"FEE_MODEL_AIMD":    1,
+}
+
+func (x FeeModel) String() string {
+	return proto.EnumName(FeeModel_name, int32(x))
+}
+
+func (FeeModel) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0fc4153d77de08e0, []int{0}
+}
+
 // Params defines the EVM module parameters
 type Params struct {
 	// no_base_fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
@@ -46,6 +77,26 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_multiplier"`
+	// fee_model defines the algorithm calculating the base fee of the blocks.
+	FeeModel FeeModel `protobuf:"varint,9,opt,name=fee_model,json=feeModel,proto3,enum=cosmos.evm.feemarket.v1.FeeModel" json:"fee_model,omitempty"`
+	// additive_increase is the amount the AIMD fee model adds to the base fee
+	// after a block using more gas than the target.
+	AdditiveIncrease cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=additive_increase,json=additiveIncrease,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"additive_increase"`
+	// multiplicative_decrease is the factor the AIMD fee model multiplies the
+	// base fee by after a block using less gas than the target.
+	MultiplicativeDecrease cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=multiplicative_decrease,json=multiplicativeDecrease,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"multiplicative_decrease"`
+	// base_fee_split defines how the base fee component of the tx fees is split
+	// between burning, the community pool and the validators.
+	BaseFeeSplit BaseFeeSplit `protobuf:"bytes,12,opt,name=base_fee_split,json=baseFeeSplit,proto3" json:"base_fee_split"`
+	// evm_elasticity_multiplier bounds the gas target of the Ethereum
+	// transactions of a block. The gas of the Ethereum and Cosmos transactions is
+	// tracked against separate targets only if both lane multipliers are set, in
+	// which case the Ethereum transactions are priced by the base fee and the
+	// Cosmos transactions by a separate Cosmos base fee.
+	EvmElasticityMultiplier uint32 `protobuf:"varint,13,opt,name=evm_elasticity_multiplier,json=evmElasticityMultiplier,proto3" json:"evm_elasticity_multiplier,omitempty"`
+	// cosmos_elasticity_multiplier bounds the gas target of the Cosmos
+	// transactions of a block.
+	CosmosElasticityMultiplier uint32 `protobuf:"varint,14,opt,name=cosmos_elasticity_multiplier,json=cosmosElasticityMultiplier,proto3" json:"cosmos_elasticity_multiplier,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -109,8 +160,183 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetFeeModel() FeeModel {
+	if m != nil {
+		return m.FeeModel
+	}
+	return FeeModelEIP1559
+}
+
+func (m *Params) GetBaseFeeSplit() BaseFeeSplit {
+	if m != nil {
+		return m.BaseFeeSplit
+	}
+	return BaseFeeSplit{}
+}
+
+func (m *Params) GetEvmElasticityMultiplier() uint32 {
+	if m != nil {
+		return m.EvmElasticityMultiplier
+	}
+	return 0
+}
+
+func (m *Params) GetCosmosElasticityMultiplier() uint32 {
+	if m != nil {
+		return m.CosmosElasticityMultiplier
+	}
+	return 0
+}
+
+// BaseFeeSplit defines the shares of the base fee component of the tx fees
+// that are burned, sent to the community pool and left to the validators. The
+// shares sum up to one. If unset, the base fee is left to the validators.
+type BaseFeeSplit struct {
+	// burn is the share of the base fee that is burned
+	Burn cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=burn,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"burn"`
+	// community_pool is the share of the base fee sent to the community pool
+	CommunityPool cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=community_pool,json=communityPool,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_pool"`
+	// validators is the share of the base fee left to the validators, along
+	// with the priority tips
+	Validators cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=validators,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validators"`
+}
+
+func (m *BaseFeeSplit) Reset()         { *m = BaseFeeSplit{} }
+func (m *BaseFeeSplit) String() string { return proto.CompactTextString(m) }
+func (*BaseFeeSplit) ProtoMessage()    {}
+func (*BaseFeeSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc4153d77de08e0, []int{1}
+}
+func (m *BaseFeeSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseFeeSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseFeeSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseFeeSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseFeeSplit.Merge(m, src)
+}
+func (m *BaseFeeSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseFeeSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseFeeSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseFeeSplit proto.InternalMessageInfo
+
+// BlockFee defines the fee market values of a block, recorded in the fee
+// history.
+type BlockFee struct {
+	// height is the height of the block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// base_fee is the base fee of the block, zero if the base fee is disabled
+	BaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee"`
+	// gas_wanted is the block gas wanted used to calculate the base fee of the
+	// next block
+	GasWanted uint64 `protobuf:"varint,3,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
+	// gas_used is the gas consumed by the block
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// gas_limit is the block gas limit, zero if the block gas is unlimited
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// evm_gas_wanted is the block gas wanted of the Ethereum transactions, used
+	// to calculate the base fee of the next block if the gas lanes are separate
+	EvmGasWanted uint64 `protobuf:"varint,6,opt,name=evm_gas_wanted,json=evmGasWanted,proto3" json:"evm_gas_wanted,omitempty"`
+	// cosmos_gas_wanted is the block gas wanted of the Cosmos transactions, used
+	// to calculate the Cosmos base fee of the next block if the gas lanes are
+	// separate
+	CosmosGasWanted uint64 `protobuf:"varint,7,opt,name=cosmos_gas_wanted,json=cosmosGasWanted,proto3" json:"cosmos_gas_wanted,omitempty"`
+}
+
+func (m *BlockFee) Reset()         { *m = BlockFee{} }
+func (m *BlockFee) String() string { return proto.CompactTextString(m) }
+func (*BlockFee) ProtoMessage()    {}
+func (*BlockFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc4153d77de08e0, []int{2}
+}
+func (m *BlockFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockFee.Merge(m, src)
+}
+func (m *BlockFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockFee proto.InternalMessageInfo
+
+func (m *BlockFee) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockFee) GetGasWanted() uint64 {
+	if m != nil {
+		return m.GasWanted
+	}
+	return 0
+}
+
+func (m *BlockFee) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *BlockFee) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *BlockFee) GetEvmGasWanted() uint64 {
+	if m != nil {
+		return m.EvmGasWanted
+	}
+	return 0
+}
+
+func (m *BlockFee) GetCosmosGasWanted() uint64 {
+	if m != nil {
+		return m.CosmosGasWanted
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.evm.feemarket.v1.FeeModel", FeeModel_name, FeeModel_value)
 	proto.RegisterType((*Params)(nil), "cosmos.evm.feemarket.v1.Params")
+	proto.RegisterType((*BaseFeeSplit)(nil), "cosmos.evm.feemarket.v1.BaseFeeSplit")
+	proto.RegisterType((*BlockFee)(nil), "cosmos.evm.feemarket.v1.BlockFee")
 }
 
 func init() {
@@ -118,34 +344,58 @@ func init() {
 }
 
 var fileDescriptor_0fc4153d77de08e0 = []byte{
-	// 423 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xb1, 0x6e, 0xd4, 0x40,
-	0x10, 0xf5, 0x72, 0xc9, 0xc5, 0xd9, 0x70, 0xd2, 0xb1, 0x0a, 0xc2, 0x4a, 0x84, 0x63, 0x85, 0x22,
-	0x56, 0x0a, 0x5b, 0x51, 0x3a, 0x24, 0x0a, 0x2e, 0x11, 0x20, 0x14, 0xa4, 0xc8, 0x05, 0x05, 0xcd,
-	0x6a, 0xed, 0x4c, 0xec, 0x55, 0xbc, 0xbb, 0x96, 0x77, 0x63, 0x71, 0xbf, 0x40, 0xc5, 0x67, 0x50,
-	0xe6, 0x33, 0x52, 0xa6, 0x44, 0x14, 0x11, 0xba, 0x2b, 0x8e, 0xcf, 0x40, 0xe7, 0xe5, 0xce, 0xd7,
-	0x50, 0x5c, 0x63, 0x8d, 0xdf, 0x7b, 0xf3, 0x34, 0x3b, 0xf3, 0xf0, 0x51, 0xa6, 0xb4, 0x50, 0x3a,
-	0x86, 0x46, 0xc4, 0xd7, 0x00, 0x82, 0xd5, 0x37, 0x60, 0xe2, 0xe6, 0xa4, 0xfb, 0x89, 0xaa, 0x5a,
-	0x19, 0x45, 0x5e, 0x58, 0x61, 0x04, 0x8d, 0x88, 0x3a, 0xae, 0x39, 0xd9, 0x7b, 0xc6, 0x04, 0x97,
-	0x2a, 0x6e, 0xbf, 0x56, 0xbb, 0xb7, 0x9b, 0xab, 0x5c, 0xb5, 0x65, 0x3c, 0xaf, 0x2c, 0x7a, 0xf8,
-	0xa7, 0x87, 0xfb, 0x97, 0xac, 0x66, 0x42, 0x13, 0x1f, 0xef, 0x48, 0x45, 0x53, 0xa6, 0x81, 0x5e,
-	0x03, 0x78, 0x28, 0x40, 0xa1, 0x9b, 0x6c, 0x4b, 0x35, 0x62, 0x1a, 0xde, 0x01, 0x90, 0x37, 0x78,
-	0x7f, 0x41, 0xd2, 0xac, 0x60, 0x32, 0x07, 0x7a, 0x05, 0x52, 0x09, 0x2e, 0x99, 0x51, 0xb5, 0xf7,
-	0x24, 0x40, 0xe1, 0x20, 0xf1, 0x52, 0xab, 0x3e, 0x6b, 0x05, 0xe7, 0x1d, 0x4f, 0x4e, 0xf1, 0x73,
-	0x28, 0x99, 0x36, 0x3c, 0xe3, 0x66, 0x4c, 0xc5, 0x6d, 0x69, 0x78, 0x55, 0x72, 0xa8, 0xbd, 0x5e,
-	0xdb, 0xb8, 0xdb, 0x91, 0x9f, 0x96, 0x1c, 0x79, 0x85, 0x07, 0x20, 0x59, 0x5a, 0x02, 0x2d, 0x80,
-	0xe7, 0x85, 0xf1, 0x36, 0x03, 0x14, 0xf6, 0x92, 0xa7, 0x16, 0xfc, 0xd0, 0x62, 0xe4, 0x0c, 0xbb,
-	0xcb, 0xa9, 0xfb, 0x01, 0x0a, 0xb7, 0x47, 0xe1, 0xfd, 0xe3, 0x81, 0xf3, 0xeb, 0xf1, 0x60, 0xdf,
-	0xee, 0x47, 0x5f, 0xdd, 0x44, 0x5c, 0xc5, 0x82, 0x99, 0x22, 0xba, 0x80, 0x9c, 0x65, 0xe3, 0x73,
-	0xc8, 0x7e, 0xcc, 0xee, 0x8e, 0x51, 0xb2, 0xf5, 0x6f, 0x5e, 0x72, 0x81, 0x07, 0x82, 0x4b, 0x9a,
-	0x33, 0x4d, 0xab, 0x9a, 0x67, 0xe0, 0x6d, 0xad, 0xe9, 0xb4, 0x23, 0xb8, 0x7c, 0xcf, 0xf4, 0xe5,
-	0xbc, 0x99, 0x7c, 0xc6, 0x64, 0xe1, 0xb6, 0xf2, 0x52, 0x77, 0x4d, 0xcb, 0xa1, 0xb5, 0xec, 0xf6,
-	0xf1, 0xfa, 0xf0, 0xdb, 0xec, 0xee, 0xf8, 0xe5, 0x4a, 0x3c, 0xbe, 0xae, 0x04, 0xc4, 0xde, 0xf1,
-	0xe3, 0x86, 0xbb, 0x31, 0xdc, 0x4c, 0x86, 0x5c, 0x72, 0xc3, 0x59, 0xb9, 0x3c, 0xe8, 0xe8, 0xed,
-	0xfd, 0xc4, 0x47, 0x0f, 0x13, 0x1f, 0xfd, 0x9e, 0xf8, 0xe8, 0xfb, 0xd4, 0x77, 0x1e, 0xa6, 0xbe,
-	0xf3, 0x73, 0xea, 0x3b, 0x5f, 0x8e, 0x72, 0x6e, 0x8a, 0xdb, 0x34, 0xca, 0x94, 0x88, 0xff, 0xe3,
-	0x6d, 0xc6, 0x15, 0xe8, 0xb4, 0xdf, 0x86, 0xe6, 0xf4, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x82,
-	0x59, 0x74, 0x66, 0xa1, 0x02, 0x00, 0x00,
+	// 801 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xc1, 0x6e, 0xdb, 0x46,
+	0x10, 0x15, 0x6d, 0x45, 0xa2, 0xd6, 0x92, 0x22, 0x6f, 0xd3, 0x98, 0x91, 0x1b, 0x86, 0x75, 0x5b,
+	0x44, 0xf0, 0x81, 0x82, 0x13, 0xe4, 0xd0, 0xa0, 0x2d, 0x1a, 0x45, 0x72, 0xe2, 0xc2, 0x46, 0x0c,
+	0x16, 0x49, 0x81, 0x5e, 0x88, 0x25, 0x39, 0xa6, 0x16, 0xe6, 0x72, 0x05, 0x2e, 0xc5, 0xd6, 0x7f,
+	0x50, 0xf8, 0x94, 0x1f, 0xc8, 0xa9, 0x97, 0x1e, 0x73, 0xec, 0x27, 0xe4, 0x98, 0x63, 0xd1, 0x43,
+	0x50, 0xd8, 0x07, 0xff, 0x46, 0xb1, 0x4b, 0x4a, 0x62, 0x51, 0xeb, 0xa0, 0x5c, 0x84, 0xe5, 0xbc,
+	0x37, 0x6f, 0x67, 0x16, 0x6f, 0x46, 0xe8, 0xbe, 0xcf, 0x05, 0xe3, 0xa2, 0x0f, 0x19, 0xeb, 0x9f,
+	0x00, 0x30, 0x92, 0x9c, 0x42, 0xda, 0xcf, 0xf6, 0x16, 0x1f, 0xf6, 0x24, 0xe1, 0x29, 0xc7, 0x5b,
+	0x39, 0xd1, 0x86, 0x8c, 0xd9, 0x0b, 0x2c, 0xdb, 0xeb, 0x6e, 0x12, 0x46, 0x63, 0xde, 0x57, 0xbf,
+	0x39, 0xb7, 0x7b, 0x2b, 0xe4, 0x21, 0x57, 0xc7, 0xbe, 0x3c, 0xe5, 0xd1, 0x9d, 0x3f, 0xeb, 0xa8,
+	0x76, 0x4c, 0x12, 0xc2, 0x04, 0x36, 0xd1, 0x46, 0xcc, 0x5d, 0x8f, 0x08, 0x70, 0x4f, 0x00, 0x0c,
+	0xcd, 0xd2, 0x7a, 0xba, 0xd3, 0x88, 0xf9, 0x80, 0x08, 0xd8, 0x07, 0xc0, 0xdf, 0xa2, 0xed, 0x19,
+	0xe8, 0xfa, 0x63, 0x12, 0x87, 0xe0, 0x06, 0x10, 0x73, 0x46, 0x63, 0x92, 0xf2, 0xc4, 0x58, 0xb3,
+	0xb4, 0x5e, 0xcb, 0x31, 0xbc, 0x9c, 0xfd, 0x54, 0x11, 0x86, 0x0b, 0x1c, 0x3f, 0x44, 0x9f, 0x42,
+	0x44, 0x44, 0x4a, 0x7d, 0x9a, 0x9e, 0xb9, 0x6c, 0x1a, 0xa5, 0x74, 0x12, 0x51, 0x48, 0x8c, 0x75,
+	0x95, 0x78, 0x6b, 0x01, 0x1e, 0xcd, 0x31, 0xfc, 0x05, 0x6a, 0x41, 0x4c, 0xbc, 0x08, 0xdc, 0x31,
+	0xd0, 0x70, 0x9c, 0x1a, 0x37, 0x2c, 0xad, 0xb7, 0xee, 0x34, 0xf3, 0xe0, 0x73, 0x15, 0xc3, 0x4f,
+	0x91, 0x3e, 0xaf, 0xba, 0x66, 0x69, 0xbd, 0xc6, 0xa0, 0xf7, 0xee, 0xc3, 0xbd, 0xca, 0xdf, 0x1f,
+	0xee, 0x6d, 0xe7, 0xef, 0x23, 0x82, 0x53, 0x9b, 0xf2, 0x3e, 0x23, 0xe9, 0xd8, 0x3e, 0x84, 0x90,
+	0xf8, 0x67, 0x43, 0xf0, 0xff, 0xb8, 0x7a, 0xbb, 0xab, 0x39, 0xf5, 0xa2, 0x5e, 0x7c, 0x88, 0x5a,
+	0x8c, 0xc6, 0x6e, 0x48, 0x84, 0x3b, 0x49, 0xa8, 0x0f, 0x46, 0x7d, 0x45, 0xa5, 0x0d, 0x46, 0xe3,
+	0x67, 0x44, 0x1c, 0xcb, 0x64, 0xfc, 0x0a, 0xe1, 0x99, 0x5a, 0xa9, 0x53, 0x7d, 0x45, 0xc9, 0x4e,
+	0x2e, 0x59, 0x7a, 0x8f, 0xef, 0x50, 0x43, 0x3e, 0x3f, 0xe3, 0x01, 0x44, 0x46, 0xc3, 0xd2, 0x7a,
+	0xed, 0x07, 0x9f, 0xdb, 0x4b, 0x4c, 0x60, 0xef, 0x03, 0x1c, 0x49, 0xa2, 0xa3, 0x9f, 0x14, 0x27,
+	0xfc, 0x12, 0x6d, 0x92, 0x20, 0xa0, 0x29, 0xcd, 0xc0, 0xa5, 0xb1, 0x9f, 0x00, 0x11, 0x60, 0xa0,
+	0x55, 0xcb, 0x9a, 0x49, 0x1c, 0x14, 0x0a, 0x98, 0xa0, 0xad, 0x59, 0x9b, 0x3e, 0x51, 0xe2, 0x01,
+	0x14, 0xe2, 0x1b, 0x2b, 0x8a, 0xdf, 0xfe, 0xaf, 0xd0, 0xb0, 0xd0, 0xc1, 0xaf, 0x50, 0x7b, 0xee,
+	0x3e, 0x31, 0x89, 0x68, 0x6a, 0x34, 0x2d, 0xad, 0xb7, 0xf1, 0xe0, 0xab, 0xa5, 0xed, 0x17, 0xbe,
+	0xfd, 0x51, 0x92, 0x07, 0x0d, 0x59, 0x40, 0x7e, 0x43, 0xd3, 0x2b, 0x01, 0xf8, 0x31, 0xba, 0x03,
+	0x19, 0x73, 0xaf, 0xb7, 0x66, 0x4b, 0x59, 0x73, 0x0b, 0x32, 0x36, 0xba, 0xce, 0x9d, 0xdf, 0xa3,
+	0xcf, 0xf2, 0xcb, 0x97, 0xa4, 0xb7, 0x55, 0x7a, 0x37, 0xe7, 0x5c, 0xa7, 0xf0, 0x78, 0xe7, 0xfc,
+	0xea, 0xed, 0xee, 0xdd, 0xd2, 0xb8, 0xff, 0x5a, 0x1a, 0xf8, 0x7c, 0x2e, 0x7f, 0xa8, 0xea, 0xd5,
+	0xce, 0x0d, 0xa7, 0x43, 0x63, 0x9a, 0x52, 0x12, 0xcd, 0x07, 0x74, 0xe7, 0x4a, 0x43, 0xcd, 0x72,
+	0x8f, 0xf8, 0x1b, 0x54, 0xf5, 0xa6, 0x49, 0x6c, 0x68, 0x2b, 0x3e, 0xb9, 0xca, 0xc2, 0x2f, 0x50,
+	0xdb, 0xe7, 0x8c, 0x4d, 0x63, 0xd9, 0xc4, 0x84, 0xf3, 0xc8, 0x58, 0x5b, 0x51, 0xa7, 0x35, 0xcf,
+	0x3f, 0xe6, 0x3c, 0xc2, 0xcf, 0x11, 0xca, 0x48, 0x44, 0x03, 0x39, 0xfd, 0xc2, 0x58, 0x5f, 0x51,
+	0xac, 0x94, 0xbb, 0xf3, 0x7a, 0x0d, 0xe9, 0x83, 0x88, 0xfb, 0xa7, 0x72, 0x50, 0x6f, 0xa3, 0x5a,
+	0xb1, 0x0b, 0x34, 0xb5, 0x0b, 0x6a, 0xe3, 0xff, 0x6f, 0x81, 0xb5, 0x8f, 0xdd, 0x02, 0x77, 0x11,
+	0x92, 0x33, 0xfb, 0x0b, 0x89, 0x53, 0x08, 0x54, 0xcd, 0x55, 0xa7, 0x11, 0x12, 0xf1, 0x93, 0x0a,
+	0xe0, 0x3b, 0x48, 0x97, 0xf0, 0x54, 0x40, 0x60, 0x54, 0x15, 0x58, 0x0f, 0x89, 0x78, 0x29, 0x20,
+	0xc0, 0xdb, 0x48, 0xf2, 0xdc, 0x88, 0x32, 0x9a, 0x6f, 0xa9, 0xaa, 0x23, 0xb9, 0x87, 0xf2, 0x1b,
+	0x7f, 0x89, 0xda, 0xd2, 0x64, 0x25, 0xe9, 0x9a, 0x62, 0x34, 0x21, 0x63, 0xcf, 0xe6, 0xea, 0xbb,
+	0x68, 0xb3, 0xb0, 0x53, 0x89, 0x58, 0x57, 0xc4, 0x9b, 0x39, 0x30, 0xe7, 0xee, 0x7a, 0x48, 0x9f,
+	0x8d, 0xb7, 0xcc, 0xdb, 0x1f, 0x8d, 0xdc, 0xa3, 0x17, 0xc3, 0xd1, 0xa1, 0x3b, 0x3a, 0x38, 0xde,
+	0x7b, 0xf4, 0xe8, 0xeb, 0x4e, 0xa5, 0xfb, 0xc9, 0xf9, 0x1b, 0xeb, 0xe6, 0x8c, 0x54, 0x84, 0x65,
+	0x25, 0x0b, 0xee, 0x93, 0x83, 0xa3, 0x61, 0x47, 0xeb, 0x76, 0xce, 0xdf, 0x58, 0xcd, 0x19, 0x51,
+	0xc6, 0xba, 0xd5, 0xdf, 0x7e, 0x37, 0x2b, 0x83, 0x27, 0xef, 0x2e, 0x4c, 0xed, 0xfd, 0x85, 0xa9,
+	0xfd, 0x73, 0x61, 0x6a, 0xaf, 0x2f, 0xcd, 0xca, 0xfb, 0x4b, 0xb3, 0xf2, 0xd7, 0xa5, 0x59, 0xf9,
+	0xf9, 0x7e, 0x48, 0xd3, 0xf1, 0xd4, 0xb3, 0x7d, 0xce, 0xfa, 0x4b, 0xcc, 0x9b, 0x9e, 0x4d, 0x40,
+	0x78, 0x35, 0xf5, 0x2f, 0xf3, 0xf0, 0xdf, 0x01, 0x00, 0xa2, 0x12, 0x97, 0x79, 0xd2, 0x06, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -168,6 +418,51 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CosmosElasticityMultiplier != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.CosmosElasticityMultiplier))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.EvmElasticityMultiplier != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.EvmElasticityMultiplier))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size, err := m.BaseFeeSplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.MultiplicativeDecrease.Size()
+		i -= size
+		if _, err := m.MultiplicativeDecrease.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.AdditiveIncrease.Size()
+		i -= size
+		if _, err := m.AdditiveIncrease.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.FeeModel != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.FeeModel))
+		i--
+		dAtA[i] = 0x48
+	}
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -226,6 +521,122 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BaseFeeSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseFeeSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseFeeSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Validators.Size()
+		i -= size
+		if _, err := m.Validators.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Burn.Size()
+		i -= size
+		if _, err := m.Burn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CosmosGasWanted != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.CosmosGasWanted))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EvmGasWanted != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.EvmGasWanted))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasWanted != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasWanted))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeemarket(v)
 	base := offset
@@ -261,6 +672,65 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovFeemarket(uint64(l))
 	l = m.MinGasMultiplier.Size()
 	n += 1 + l + sovFeemarket(uint64(l))
+	if m.FeeModel != 0 {
+		n += 1 + sovFeemarket(uint64(m.FeeModel))
+	}
+	l = m.AdditiveIncrease.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MultiplicativeDecrease.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.BaseFeeSplit.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.EvmElasticityMultiplier != 0 {
+		n += 1 + sovFeemarket(uint64(m.EvmElasticityMultiplier))
+	}
+	if m.CosmosElasticityMultiplier != 0 {
+		n += 1 + sovFeemarket(uint64(m.CosmosElasticityMultiplier))
+	}
+	return n
+}
+
+func (m *BaseFeeSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Burn.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.Validators.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func (m *BlockFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeemarket(uint64(m.Height))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.GasWanted != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasWanted))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasUsed))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasLimit))
+	}
+	if m.EvmGasWanted != 0 {
+		n += 1 + sovFeemarket(uint64(m.EvmGasWanted))
+	}
+	if m.CosmosGasWanted != 0 {
+		n += 1 + sovFeemarket(uint64(m.CosmosGasWanted))
+	}
 	return n
 }
 
@@ -478,6 +948,514 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeModel", wireType)
+			}
+			m.FeeModel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeModel |= FeeModel(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditiveIncrease", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AdditiveIncrease.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiplicativeDecrease", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MultiplicativeDecrease.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmElasticityMultiplier", wireType)
+			}
+			m.EvmElasticityMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmElasticityMultiplier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosElasticityMultiplier", wireType)
+			}
+			m.CosmosElasticityMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosElasticityMultiplier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BaseFeeSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseFeeSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseFeeSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Validators.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
+			}
+			m.GasWanted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasWanted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmGasWanted", wireType)
+			}
+			m.EvmGasWanted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmGasWanted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosGasWanted", wireType)
+			}
+			m.CosmosGasWanted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosGasWanted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeemarket(dAtA[iNdEx:])
@@ -0,0 +1,27 @@
+package types
+
+// GasLane identifies the transactions whose gas wanted is tracked separately
+// to calculate the base fee.
+type GasLane uint8
+
+const (
+	// GasLaneEVM tracks the gas wanted of the Ethereum transactions
+	GasLaneEVM GasLane = iota
+	// GasLaneCosmos tracks the gas wanted of the Cosmos transactions
+	GasLaneCosmos
+)
+
+// GasLanes is the list of all the gas lanes
+var GasLanes = []GasLane{GasLaneEVM, GasLaneCosmos}
+
+// String implements fmt.Stringer.
+func (l GasLane) String() string {
+	switch l {
+	case GasLaneEVM:
+		return "evm"
+	case GasLaneCosmos:
+		return "cosmos"
+	default:
+		return "unknown"
+	}
+}
@@ -1,23 +1,35 @@
 package types
 
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
 // DefaultGenesisState sets default fee market genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:   DefaultParams(),
-		BlockGas: 0,
+		Params:        DefaultParams(),
+		BlockGas:      0,
+		BurnedBaseFee: math.LegacyZeroDec(),
 	}
 }
 
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(params Params, blockGas uint64) *GenesisState {
 	return &GenesisState{
-		Params:   params,
-		BlockGas: blockGas,
+		Params:        params,
+		BlockGas:      blockGas,
+		BurnedBaseFee: math.LegacyZeroDec(),
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	// the burned base fee is not set in the genesis files predating it
+	if !gs.BurnedBaseFee.IsNil() && gs.BurnedBaseFee.IsNegative() {
+		return fmt.Errorf("burned base fee cannot be negative: %s", gs.BurnedBaseFee)
+	}
 	return gs.Params.Validate()
 }
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -31,6 +32,9 @@ type GenesisState struct {
 	// block_gas is the amount of gas wanted on the last block before the upgrade.
 	// Zero by default.
 	BlockGas uint64 `protobuf:"varint,3,opt,name=block_gas,json=blockGas,proto3" json:"block_gas,omitempty"`
+	// burned_base_fee is the cumulative amount of base fees burned, in the
+	// decimals of the evm denom
+	BurnedBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=burned_base_fee,json=burnedBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"burned_base_fee"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -89,24 +93,27 @@ func init() {
 }
 
 var fileDescriptor_07c64d3a2a89a388 = []byte{
-	// 259 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x4f, 0x4b, 0x4d, 0xcd, 0x4d, 0x2c, 0xca, 0x4e, 0x2d,
-	0xd1, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x87, 0x28, 0xd3, 0x4b, 0x2d, 0xcb, 0xd5, 0x83, 0x2b, 0xd3, 0x2b, 0x33, 0x94,
-	0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0xb5, 0x52, 0xea, 0xb8, 0x8c, 0x44,
-	0x68, 0x84, 0x28, 0x14, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0x88, 0xa8, 0x52,
-	0x3d, 0x17, 0x8f, 0x3b, 0xc4, 0xee, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x27, 0x2e, 0xb6, 0x82,
-	0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x79, 0x3d, 0x1c, 0x6e,
-	0xd1, 0x0b, 0x00, 0x2b, 0x73, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c,
-	0x41, 0x50, 0x9d, 0x42, 0xd2, 0x5c, 0x9c, 0x49, 0x39, 0xf9, 0xc9, 0xd9, 0xf1, 0xe9, 0x89, 0xc5,
-	0x12, 0xcc, 0x0a, 0x8c, 0x1a, 0x2c, 0x41, 0x1c, 0x60, 0x01, 0xf7, 0xc4, 0x62, 0x2f, 0x16, 0x0e,
-	0x26, 0x01, 0xe6, 0x20, 0x8e, 0xa4, 0xc4, 0xe2, 0xd4, 0xf8, 0xb4, 0xd4, 0x54, 0x27, 0xc7, 0x13,
-	0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86,
-	0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x4f, 0xcf, 0x2c, 0xc9, 0x28, 0x4d,
-	0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x47, 0xf2, 0x64, 0x05, 0x92, 0x37, 0x4b, 0x2a, 0x0b, 0x52, 0x8b,
-	0x93, 0xd8, 0xc0, 0x5e, 0x31, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x8e, 0xbd, 0xe4, 0x86, 0x5e,
-	0x01, 0x00, 0x00,
+	// 318 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0x4f, 0x4a, 0xc3, 0x40,
+	0x14, 0xc6, 0x33, 0xb6, 0x94, 0x36, 0x2a, 0x6a, 0x10, 0x2c, 0x2d, 0x4c, 0x8b, 0x20, 0x0d, 0x2e,
+	0x66, 0xa8, 0x9e, 0xc0, 0x20, 0x16, 0xc4, 0x45, 0xa9, 0x3b, 0x37, 0x65, 0x92, 0xbe, 0x4e, 0x43,
+	0x9d, 0x4c, 0xc8, 0x4c, 0x83, 0xbd, 0x85, 0xc7, 0x70, 0xe9, 0x31, 0xba, 0xac, 0x3b, 0x71, 0x51,
+	0x24, 0x59, 0x78, 0x0d, 0x69, 0xc6, 0x3f, 0xdd, 0x74, 0x33, 0x3c, 0x1e, 0xbf, 0xf7, 0xfb, 0x86,
+	0xcf, 0x3e, 0x0b, 0xa4, 0x12, 0x52, 0x51, 0x48, 0x05, 0x1d, 0x03, 0x08, 0x96, 0x4c, 0x41, 0xd3,
+	0xb4, 0x4b, 0x39, 0x44, 0xa0, 0x42, 0x45, 0xe2, 0x44, 0x6a, 0xe9, 0x9c, 0x18, 0x8c, 0x40, 0x2a,
+	0xc8, 0x1f, 0x46, 0xd2, 0x6e, 0xe3, 0x88, 0x89, 0x30, 0x92, 0xb4, 0x78, 0x0d, 0xdb, 0xe8, 0x6c,
+	0x53, 0xfe, 0x1f, 0x1a, 0xf0, 0x98, 0x4b, 0x2e, 0x8b, 0x91, 0xae, 0x27, 0xb3, 0x3d, 0x7d, 0x43,
+	0xf6, 0x5e, 0xcf, 0x84, 0xdf, 0x6b, 0xa6, 0xc1, 0xf1, 0xec, 0x4a, 0xcc, 0x12, 0x26, 0x54, 0x1d,
+	0xb5, 0x91, 0xbb, 0x7b, 0xd1, 0x22, 0x5b, 0x3e, 0x43, 0xfa, 0x05, 0xe6, 0xd5, 0x16, 0xab, 0x96,
+	0xf5, 0xf2, 0xf5, 0x7a, 0x8e, 0x06, 0x3f, 0x97, 0x4e, 0xd3, 0xae, 0xf9, 0x8f, 0x32, 0x98, 0x0e,
+	0x39, 0x53, 0xf5, 0x52, 0x1b, 0xb9, 0xe5, 0x41, 0xb5, 0x58, 0xf4, 0x98, 0x72, 0xfa, 0xf6, 0x81,
+	0x3f, 0x4b, 0x22, 0x18, 0x0d, 0x7d, 0xa6, 0x60, 0x38, 0x06, 0xa8, 0x97, 0xdb, 0xc8, 0xad, 0x79,
+	0xee, 0x5a, 0xf4, 0xb1, 0x6a, 0x35, 0x4d, 0xa0, 0x1a, 0x4d, 0x49, 0x28, 0xa9, 0x60, 0x7a, 0x42,
+	0xee, 0x80, 0xb3, 0x60, 0x7e, 0x0d, 0x81, 0xc9, 0xd9, 0x37, 0x02, 0x8f, 0x29, 0xb8, 0x01, 0xb8,
+	0x2d, 0x57, 0x77, 0x0e, 0x4b, 0x83, 0xea, 0xaf, 0xce, 0xbb, 0x7a, 0xe8, 0xf0, 0x50, 0x4f, 0x66,
+	0x3e, 0x09, 0xa4, 0xa0, 0x1b, 0xfd, 0x3c, 0x6d, 0x34, 0xa4, 0xe7, 0x31, 0xa8, 0x45, 0x86, 0xd1,
+	0x32, 0xc3, 0xe8, 0x33, 0xc3, 0xe8, 0x39, 0xc7, 0xd6, 0x32, 0xc7, 0xd6, 0x7b, 0x8e, 0x2d, 0xbf,
+	0x52, 0xb4, 0x73, 0xf9, 0x3d, 0x00, 0xaf, 0x31, 0x07, 0x50, 0xb1, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -129,6 +136,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.BurnedBaseFee.Size()
+		i -= size
+		if _, err := m.BurnedBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if m.BlockGas != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockGas))
 		i--
@@ -169,6 +186,8 @@ func (m *GenesisState) Size() (n int) {
 	if m.BlockGas != 0 {
 		n += 1 + sovGenesis(uint64(m.BlockGas))
 	}
+	l = m.BurnedBaseFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -259,6 +278,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnedBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/math"
 )
 
 type GenesisTestSuite struct {
@@ -30,9 +32,27 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			&GenesisState{
 				DefaultParams(),
 				uint64(1),
+				math.LegacyNewDec(100),
+			},
+			true,
+		},
+		{
+			"valid genesis - burned base fee not set",
+			&GenesisState{
+				Params:   DefaultParams(),
+				BlockGas: uint64(1),
 			},
 			true,
 		},
+		{
+			"invalid genesis - negative burned base fee",
+			&GenesisState{
+				Params:        DefaultParams(),
+				BlockGas:      uint64(1),
+				BurnedBaseFee: math.LegacyNewDec(-1),
+			},
+			false,
+		},
 		{
 			"valid New genesis",
 			NewGenesisState(
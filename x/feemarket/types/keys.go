@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName string name of module
 	ModuleName = "feemarket"
@@ -14,23 +18,54 @@ const (
 	// TransientKey is the key to access the FeeMarket transient store, that is reset
 	// during the Commit phase.
 	TransientKey = "transient_" + ModuleName
+
+	// FeeHistoryWindow is the number of latest blocks which fee market values are
+	// recorded in the fee history.
+	FeeHistoryWindow = 8192
 )
 
 // prefix bytes for the feemarket persistent store
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixBlockFee
+	prefixBurnedBaseFee
+	prefixBlockLaneGasWanted
+	prefixCosmosBaseFee
 )
 
 const (
 	prefixTransientBlockGasUsed = iota + 1
+	prefixTransientBlockLaneGasWanted
 )
 
 // KVStore key prefixes
 var (
 	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixBlockFee       = []byte{prefixBlockFee}
+	KeyPrefixBurnedBaseFee  = []byte{prefixBurnedBaseFee}
+	KeyPrefixCosmosBaseFee  = []byte{prefixCosmosBaseFee}
 )
 
+// BlockLaneGasWantedKey returns the key of the block gas wanted of the given
+// gas lane.
+func BlockLaneGasWantedKey(lane GasLane) []byte {
+	return []byte{prefixBlockLaneGasWanted, byte(lane)}
+}
+
+// TransientBlockLaneGasWantedKey returns the transient key of the block gas
+// wanted of the given gas lane.
+func TransientBlockLaneGasWantedKey(lane GasLane) []byte {
+	return []byte{prefixTransientBlockLaneGasWanted, byte(lane)}
+}
+
+// BlockFeeKey returns the key of the fee history entry of the given height, the
+// entries being stored in a ring buffer of FeeHistoryWindow entries.
+func BlockFeeKey(height int64) []byte {
+	ringIndex := uint64(height) % FeeHistoryWindow //nolint:gosec // G115 // block height is not negative
+	return append([]byte{prefixBlockFee}, sdk.Uint64ToBigEndian(ringIndex)...)
+}
+
 // Transient Store key prefixes
 var (
 	KeyPrefixTransientBlockGasWanted = []byte{prefixTransientBlockGasUsed}
@@ -19,6 +19,19 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultFeeModel is the EIP-1559 fee model
+	DefaultFeeModel = FeeModelEIP1559
+	// DefaultAdditiveIncrease is 10% of the default base fee
+	DefaultAdditiveIncrease = math.LegacyNewDec(100_000_000)
+	// DefaultMultiplicativeDecrease is 0.875, the max decrease of the EIP-1559
+	// fee model with the default base fee change denominator
+	DefaultMultiplicativeDecrease = math.LegacyNewDecWithPrec(875, 3)
+	// DefaultBaseFeeSplit leaves all the base fee to the validators
+	DefaultBaseFeeSplit = BaseFeeSplit{
+		Burn:          math.LegacyZeroDec(),
+		CommunityPool: math.LegacyZeroDec(),
+		Validators:    math.LegacyOneDec(),
+	}
 
 	ParamsKey = []byte("Params")
 )
@@ -41,6 +54,10 @@ func NewParams(
 		EnableHeight:             enableHeight,
 		MinGasPrice:              minGasPrice,
 		MinGasMultiplier:         minGasPriceMultiplier,
+		FeeModel:                 DefaultFeeModel,
+		AdditiveIncrease:         DefaultAdditiveIncrease,
+		MultiplicativeDecrease:   DefaultMultiplicativeDecrease,
+		BaseFeeSplit:             DefaultBaseFeeSplit,
 	}
 }
 
@@ -54,6 +71,10 @@ func DefaultParams() Params {
 		EnableHeight:             DefaultEnableHeight,
 		MinGasPrice:              DefaultMinGasPrice,
 		MinGasMultiplier:         DefaultMinGasMultiplier,
+		FeeModel:                 DefaultFeeModel,
+		AdditiveIncrease:         DefaultAdditiveIncrease,
+		MultiplicativeDecrease:   DefaultMultiplicativeDecrease,
+		BaseFeeSplit:             DefaultBaseFeeSplit,
 	}
 }
 
@@ -79,6 +100,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateFeeModel(p); err != nil {
+		return err
+	}
+
+	if err := p.BaseFeeSplit.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateGasLanes(p); err != nil {
+		return err
+	}
+
 	return validateMinGasPrice(p.MinGasPrice)
 }
 
@@ -86,6 +119,26 @@ func (p *Params) IsBaseFeeEnabled(height int64) bool {
 	return !p.NoBaseFee && height >= p.EnableHeight
 }
 
+// HasSeparateGasLanes returns true if the gas of the Ethereum and Cosmos
+// transactions is tracked against separate gas targets.
+func (p Params) HasSeparateGasLanes() bool {
+	return p.EvmElasticityMultiplier != 0 && p.CosmosElasticityMultiplier != 0
+}
+
+// LaneElasticityMultiplier returns the elasticity multiplier of the given gas
+// lane, which is the block elasticity multiplier if the gas lanes are not
+// separate.
+func (p Params) LaneElasticityMultiplier(lane GasLane) uint32 {
+	switch {
+	case !p.HasSeparateGasLanes():
+		return p.ElasticityMultiplier
+	case lane == GasLaneEVM:
+		return p.EvmElasticityMultiplier
+	default:
+		return p.CosmosElasticityMultiplier
+	}
+}
+
 func validateMinGasPrice(gasPrice math.LegacyDec) error {
 	if gasPrice.IsNil() {
 		return fmt.Errorf("invalid parameter: nil")
@@ -113,3 +166,83 @@ func validateMinGasMultiplier(multiplier math.LegacyDec) error {
 
 	return nil
 }
+
+// validateFeeModel validates the fee model, and its params if it's the AIMD fee
+// model. The AIMD params may be unset when the model is not used.
+func validateFeeModel(p Params) error {
+	if _, err := NewBaseFeeModel(p.FeeModel); err != nil {
+		return err
+	}
+
+	if p.FeeModel != FeeModelAIMD {
+		return nil
+	}
+
+	if p.AdditiveIncrease.IsNil() {
+		return fmt.Errorf("additive increase cannot be nil")
+	}
+
+	if p.AdditiveIncrease.IsNegative() {
+		return fmt.Errorf("additive increase cannot be negative: %s", p.AdditiveIncrease)
+	}
+
+	if p.MultiplicativeDecrease.IsNil() {
+		return fmt.Errorf("multiplicative decrease cannot be nil")
+	}
+
+	if !p.MultiplicativeDecrease.IsPositive() || p.MultiplicativeDecrease.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("multiplicative decrease must be in (0, 1]: %s", p.MultiplicativeDecrease)
+	}
+
+	return nil
+}
+
+// validateGasLanes validates that the elasticity multipliers of the gas lanes
+// are either both set or both unset.
+func validateGasLanes(p Params) error {
+	if (p.EvmElasticityMultiplier == 0) != (p.CosmosElasticityMultiplier == 0) {
+		return fmt.Errorf(
+			"evm and cosmos elasticity multipliers must be both set or both zero: %d, %d",
+			p.EvmElasticityMultiplier, p.CosmosElasticityMultiplier,
+		)
+	}
+
+	return nil
+}
+
+// IsUnset returns true if none of the base fee shares is set, in which case
+// the base fee is left to the validators.
+func (s BaseFeeSplit) IsUnset() bool {
+	return s.Burn.IsNil() && s.CommunityPool.IsNil() && s.Validators.IsNil()
+}
+
+// Validate performs basic validation on the base fee split. The shares must be
+// all set, non-negative and sum up to one, unless they are all unset.
+func (s BaseFeeSplit) Validate() error {
+	if s.IsUnset() {
+		return nil
+	}
+
+	shares := []struct {
+		name  string
+		value math.LegacyDec
+	}{
+		{"burn", s.Burn},
+		{"community pool", s.CommunityPool},
+		{"validators", s.Validators},
+	}
+	for _, share := range shares {
+		if share.value.IsNil() {
+			return fmt.Errorf("base fee %s share cannot be nil", share.name)
+		}
+		if share.value.IsNegative() {
+			return fmt.Errorf("base fee %s share cannot be negative: %s", share.name, share.value)
+		}
+	}
+
+	if total := s.Burn.Add(s.CommunityPool).Add(s.Validators); !total.Equal(math.LegacyOneDec()) {
+		return fmt.Errorf("base fee shares must sum up to 1: %s", total)
+	}
+
+	return nil
+}
@@ -73,6 +73,126 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			NewParams(true, 7, 3, math.LegacyNewDec(2000000000), int64(544435345345435345), math.LegacyNewDecWithPrec(20, 4), math.LegacyNewDec(2)),
 			true,
 		},
+		{
+			"valid: AIMD fee model",
+			Params{
+				BaseFeeChangeDenominator: 8, ElasticityMultiplier: 2, BaseFee: DefaultBaseFee, MinGasPrice: DefaultMinGasPrice, MinGasMultiplier: DefaultMinGasMultiplier,
+				FeeModel: FeeModelAIMD, AdditiveIncrease: math.LegacyZeroDec(), MultiplicativeDecrease: math.LegacyOneDec(),
+			},
+			false,
+		},
+		{
+			"valid: EIP-1559 fee model without AIMD params",
+			Params{
+				BaseFeeChangeDenominator: 8, ElasticityMultiplier: 2, BaseFee: DefaultBaseFee, MinGasPrice: DefaultMinGasPrice, MinGasMultiplier: DefaultMinGasMultiplier,
+				FeeModel: FeeModelEIP1559,
+			},
+			false,
+		},
+		{
+			"invalid: unknown fee model",
+			Params{
+				BaseFeeChangeDenominator: 8, ElasticityMultiplier: 2, BaseFee: DefaultBaseFee, MinGasPrice: DefaultMinGasPrice, MinGasMultiplier: DefaultMinGasMultiplier,
+				FeeModel: FeeModel(10),
+			},
+			true,
+		},
+		{
+			"invalid: AIMD fee model without AIMD params",
+			Params{
+				BaseFeeChangeDenominator: 8, ElasticityMultiplier: 2, BaseFee: DefaultBaseFee, MinGasPrice: DefaultMinGasPrice, MinGasMultiplier: DefaultMinGasMultiplier,
+				FeeModel: FeeModelAIMD,
+			},
+			true,
+		},
+		{
+			"invalid: AIMD fee model with negative additive increase",
+			Params{
+				BaseFeeChangeDenominator: 8, ElasticityMultiplier: 2, BaseFee: DefaultBaseFee, MinGasPrice: DefaultMinGasPrice, MinGasMultiplier: DefaultMinGasMultiplier,
+				FeeModel: FeeModelAIMD, AdditiveIncrease: math.LegacyNewDec(-1), MultiplicativeDecrease: DefaultMultiplicativeDecrease,
+			},
+			true,
+		},
+		{
+			"invalid: AIMD fee model with zero multiplicative decrease",
+			Params{
+				BaseFeeChangeDenominator: 8, ElasticityMultiplier: 2, BaseFee: DefaultBaseFee, MinGasPrice: DefaultMinGasPrice, MinGasMultiplier: DefaultMinGasMultiplier,
+				FeeModel: FeeModelAIMD, AdditiveIncrease: DefaultAdditiveIncrease, MultiplicativeDecrease: math.LegacyZeroDec(),
+			},
+			true,
+		},
+		{
+			"invalid: AIMD fee model with multiplicative decrease bigger than 1",
+			Params{
+				BaseFeeChangeDenominator: 8, ElasticityMultiplier: 2, BaseFee: DefaultBaseFee, MinGasPrice: DefaultMinGasPrice, MinGasMultiplier: DefaultMinGasMultiplier,
+				FeeModel: FeeModelAIMD, AdditiveIncrease: DefaultAdditiveIncrease, MultiplicativeDecrease: math.LegacyNewDec(2),
+			},
+			true,
+		},
+		{
+			"valid: unset base fee split",
+			Params{
+				BaseFeeChangeDenominator: 8, ElasticityMultiplier: 2, BaseFee: DefaultBaseFee, MinGasPrice: DefaultMinGasPrice, MinGasMultiplier: DefaultMinGasMultiplier,
+				BaseFeeSplit: BaseFeeSplit{},
+			},
+			false,
+		},
+		{
+			"valid: base fee split",
+			Params{
+				BaseFeeChangeDenominator: 8, ElasticityMultiplier: 2, BaseFee: DefaultBaseFee, MinGasPrice: DefaultMinGasPrice, MinGasMultiplier: DefaultMinGasMultiplier,
+				BaseFeeSplit: BaseFeeSplit{Burn: math.LegacyNewDecWithPrec(5, 1), CommunityPool: math.LegacyNewDecWithPrec(2, 1), Validators: math.LegacyNewDecWithPrec(3, 1)},
+			},
+			false,
+		},
+		{
+			"invalid: base fee split with a missing share",
+			Params{
+				BaseFeeChangeDenominator: 8, ElasticityMultiplier: 2, BaseFee: DefaultBaseFee, MinGasPrice: DefaultMinGasPrice, MinGasMultiplier: DefaultMinGasMultiplier,
+				BaseFeeSplit: BaseFeeSplit{Burn: math.LegacyOneDec()},
+			},
+			true,
+		},
+		{
+			"invalid: base fee split with a negative share",
+			Params{
+				BaseFeeChangeDenominator: 8, ElasticityMultiplier: 2, BaseFee: DefaultBaseFee, MinGasPrice: DefaultMinGasPrice, MinGasMultiplier: DefaultMinGasMultiplier,
+				BaseFeeSplit: BaseFeeSplit{Burn: math.LegacyNewDec(2), CommunityPool: math.LegacyNewDec(-1), Validators: math.LegacyZeroDec()},
+			},
+			true,
+		},
+		{
+			"valid: separate gas lanes",
+			Params{
+				BaseFeeChangeDenominator: 8, ElasticityMultiplier: 2, BaseFee: DefaultBaseFee, MinGasPrice: DefaultMinGasPrice, MinGasMultiplier: DefaultMinGasMultiplier,
+				EvmElasticityMultiplier: 2, CosmosElasticityMultiplier: 1,
+			},
+			false,
+		},
+		{
+			"invalid: evm gas lane without cosmos gas lane",
+			Params{
+				BaseFeeChangeDenominator: 8, ElasticityMultiplier: 2, BaseFee: DefaultBaseFee, MinGasPrice: DefaultMinGasPrice, MinGasMultiplier: DefaultMinGasMultiplier,
+				EvmElasticityMultiplier: 2,
+			},
+			true,
+		},
+		{
+			"invalid: cosmos gas lane without evm gas lane",
+			Params{
+				BaseFeeChangeDenominator: 8, ElasticityMultiplier: 2, BaseFee: DefaultBaseFee, MinGasPrice: DefaultMinGasPrice, MinGasMultiplier: DefaultMinGasMultiplier,
+				CosmosElasticityMultiplier: 1,
+			},
+			true,
+		},
+		{
+			"invalid: base fee split not summing to 1",
+			Params{
+				BaseFeeChangeDenominator: 8, ElasticityMultiplier: 2, BaseFee: DefaultBaseFee, MinGasPrice: DefaultMinGasPrice, MinGasMultiplier: DefaultMinGasMultiplier,
+				BaseFeeSplit: BaseFeeSplit{Burn: math.LegacyNewDecWithPrec(5, 1), CommunityPool: math.LegacyNewDecWithPrec(2, 1), Validators: math.LegacyNewDecWithPrec(2, 1)},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
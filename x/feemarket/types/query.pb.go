@@ -156,6 +156,9 @@ var xxx_messageInfo_QueryBaseFeeRequest proto.InternalMessageInfo
 type QueryBaseFeeResponse struct {
 	// base_fee is the EIP1559 base fee
 	BaseFee *cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee,omitempty"`
+	// cosmos_base_fee is the base fee of the Cosmos transactions, equal to the
+	// base fee unless the gas lanes are separate
+	CosmosBaseFee *cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=cosmos_base_fee,json=cosmosBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"cosmos_base_fee,omitempty"`
 }
 
 func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
@@ -233,6 +236,10 @@ var xxx_messageInfo_QueryBlockGasRequest proto.InternalMessageInfo
 type QueryBlockGasResponse struct {
 	// gas is the returned block gas
 	Gas int64 `protobuf:"varint,1,opt,name=gas,proto3" json:"gas,omitempty"`
+	// evm_gas is the block gas of the Ethereum transactions
+	EvmGas int64 `protobuf:"varint,2,opt,name=evm_gas,json=evmGas,proto3" json:"evm_gas,omitempty"`
+	// cosmos_gas is the block gas of the Cosmos transactions
+	CosmosGas int64 `protobuf:"varint,3,opt,name=cosmos_gas,json=cosmosGas,proto3" json:"cosmos_gas,omitempty"`
 }
 
 func (m *QueryBlockGasResponse) Reset()         { *m = QueryBlockGasResponse{} }
@@ -275,6 +282,287 @@ func (m *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
+func (m *QueryBlockGasResponse) GetEvmGas() int64 {
+	if m != nil {
+		return m.EvmGas
+	}
+	return 0
+}
+
+func (m *QueryBlockGasResponse) GetCosmosGas() int64 {
+	if m != nil {
+		return m.CosmosGas
+	}
+	return 0
+}
+
+// QueryBaseFeeAtRequest defines the request type for querying the base fee of
+// a block recorded in the fee history.
+type QueryBaseFeeAtRequest struct {
+	// height is the height of the block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryBaseFeeAtRequest) Reset()         { *m = QueryBaseFeeAtRequest{} }
+func (m *QueryBaseFeeAtRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeAtRequest) ProtoMessage()    {}
+func (*QueryBaseFeeAtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{6}
+}
+func (m *QueryBaseFeeAtRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeAtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeAtRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeAtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeAtRequest.Merge(m, src)
+}
+func (m *QueryBaseFeeAtRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeAtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeAtRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeAtRequest proto.InternalMessageInfo
+
+func (m *QueryBaseFeeAtRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryBaseFeeAtResponse returns the base fee of a block.
+type QueryBaseFeeAtResponse struct {
+	// base_fee is the base fee of the block
+	BaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee"`
+}
+
+func (m *QueryBaseFeeAtResponse) Reset()         { *m = QueryBaseFeeAtResponse{} }
+func (m *QueryBaseFeeAtResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeAtResponse) ProtoMessage()    {}
+func (*QueryBaseFeeAtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{7}
+}
+func (m *QueryBaseFeeAtResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeAtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeAtResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeAtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeAtResponse.Merge(m, src)
+}
+func (m *QueryBaseFeeAtResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeAtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeAtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeAtResponse proto.InternalMessageInfo
+
+// QueryFeeHistoryRequest defines the request type for querying the fee market
+// values of a range of blocks recorded in the fee history.
+type QueryFeeHistoryRequest struct {
+	// from_height is the first height of the range
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height is the last height of the range, included
+	ToHeight int64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+}
+
+func (m *QueryFeeHistoryRequest) Reset()         { *m = QueryFeeHistoryRequest{} }
+func (m *QueryFeeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeHistoryRequest) ProtoMessage()    {}
+func (*QueryFeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{8}
+}
+func (m *QueryFeeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeHistoryRequest.Merge(m, src)
+}
+func (m *QueryFeeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryFeeHistoryRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryFeeHistoryRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+// QueryFeeHistoryResponse returns the fee market values of the blocks of the
+// range that are recorded in the fee history, in ascending height order.
+type QueryFeeHistoryResponse struct {
+	// block_fees are the fee market values of the blocks
+	BlockFees []BlockFee `protobuf:"bytes,1,rep,name=block_fees,json=blockFees,proto3" json:"block_fees"`
+}
+
+func (m *QueryFeeHistoryResponse) Reset()         { *m = QueryFeeHistoryResponse{} }
+func (m *QueryFeeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeHistoryResponse) ProtoMessage()    {}
+func (*QueryFeeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{9}
+}
+func (m *QueryFeeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeHistoryResponse.Merge(m, src)
+}
+func (m *QueryFeeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryFeeHistoryResponse) GetBlockFees() []BlockFee {
+	if m != nil {
+		return m.BlockFees
+	}
+	return nil
+}
+
+// QueryBurnedBaseFeeRequest defines the request type for querying the
+// cumulative amount of base fees burned.
+type QueryBurnedBaseFeeRequest struct {
+}
+
+func (m *QueryBurnedBaseFeeRequest) Reset()         { *m = QueryBurnedBaseFeeRequest{} }
+func (m *QueryBurnedBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedBaseFeeRequest) ProtoMessage()    {}
+func (*QueryBurnedBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{10}
+}
+func (m *QueryBurnedBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryBurnedBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedBaseFeeRequest proto.InternalMessageInfo
+
+// QueryBurnedBaseFeeResponse returns the cumulative amount of base fees burned.
+type QueryBurnedBaseFeeResponse struct {
+	// burned is the cumulative amount of base fees burned, in the decimals of
+	// the evm denom
+	Burned cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=burned,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"burned"`
+}
+
+func (m *QueryBurnedBaseFeeResponse) Reset()         { *m = QueryBurnedBaseFeeResponse{} }
+func (m *QueryBurnedBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedBaseFeeResponse) ProtoMessage()    {}
+func (*QueryBurnedBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{11}
+}
+func (m *QueryBurnedBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryBurnedBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedBaseFeeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evm.feemarket.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evm.feemarket.v1.QueryParamsResponse")
@@ -282,6 +570,12 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "cosmos.evm.feemarket.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryBlockGasRequest)(nil), "cosmos.evm.feemarket.v1.QueryBlockGasRequest")
 	proto.RegisterType((*QueryBlockGasResponse)(nil), "cosmos.evm.feemarket.v1.QueryBlockGasResponse")
+	proto.RegisterType((*QueryBaseFeeAtRequest)(nil), "cosmos.evm.feemarket.v1.QueryBaseFeeAtRequest")
+	proto.RegisterType((*QueryBaseFeeAtResponse)(nil), "cosmos.evm.feemarket.v1.QueryBaseFeeAtResponse")
+	proto.RegisterType((*QueryFeeHistoryRequest)(nil), "cosmos.evm.feemarket.v1.QueryFeeHistoryRequest")
+	proto.RegisterType((*QueryFeeHistoryResponse)(nil), "cosmos.evm.feemarket.v1.QueryFeeHistoryResponse")
+	proto.RegisterType((*QueryBurnedBaseFeeRequest)(nil), "cosmos.evm.feemarket.v1.QueryBurnedBaseFeeRequest")
+	proto.RegisterType((*QueryBurnedBaseFeeResponse)(nil), "cosmos.evm.feemarket.v1.QueryBurnedBaseFeeResponse")
 }
 
 func init() {
@@ -289,36 +583,54 @@ func init() {
 }
 
 var fileDescriptor_2c588b2369eb47d1 = []byte{
-	// 458 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xce, 0x11, 0x91, 0xb6, 0xc7, 0x02, 0x47, 0x0a, 0xc8, 0x20, 0x9b, 0xba, 0x48, 0xa1, 0x05,
-	0xee, 0xd4, 0xb2, 0xb1, 0x61, 0x21, 0x58, 0x18, 0xc0, 0x1b, 0x2c, 0xd5, 0xc5, 0xbc, 0x5e, 0xad,
-	0xf4, 0x7c, 0x6e, 0xee, 0x12, 0x91, 0x95, 0x99, 0x01, 0x84, 0xf8, 0x0f, 0x8c, 0xfc, 0x06, 0xa6,
-	0x8e, 0x95, 0x58, 0x10, 0x43, 0x85, 0x12, 0x24, 0xfe, 0x06, 0xca, 0xdd, 0xb9, 0xd4, 0x54, 0x56,
-	0xb3, 0x58, 0x4f, 0xcf, 0xdf, 0xfb, 0xbe, 0xef, 0x7d, 0x4f, 0x87, 0xd7, 0x33, 0xa5, 0xa5, 0xd2,
-	0x0c, 0xc6, 0x92, 0xed, 0x02, 0x48, 0x3e, 0x1c, 0x80, 0x61, 0xe3, 0x2d, 0x76, 0x30, 0x82, 0xe1,
-	0x84, 0x96, 0x43, 0x65, 0x14, 0xb9, 0xee, 0x40, 0x14, 0xc6, 0x92, 0x9e, 0x80, 0xe8, 0x78, 0x2b,
-	0xb8, 0xc2, 0x65, 0x5e, 0x28, 0x66, 0xbf, 0x0e, 0x1b, 0xf4, 0x9a, 0x08, 0xff, 0x0d, 0x3a, 0x60,
-	0x57, 0x28, 0xa1, 0x6c, 0xc9, 0xe6, 0x95, 0xef, 0xde, 0x12, 0x4a, 0x89, 0x7d, 0x60, 0xbc, 0xcc,
-	0x19, 0x2f, 0x0a, 0x65, 0xb8, 0xc9, 0x55, 0xa1, 0xdd, 0xdf, 0xb8, 0x8b, 0xc9, 0xcb, 0xb9, 0xaf,
-	0x17, 0x7c, 0xc8, 0xa5, 0x4e, 0xe1, 0x60, 0x04, 0xda, 0xc4, 0xaf, 0xf0, 0xd5, 0x5a, 0x57, 0x97,
-	0xaa, 0xd0, 0x40, 0x12, 0xdc, 0x29, 0x6d, 0xe7, 0x06, 0xba, 0x8d, 0xee, 0x5e, 0xda, 0x8e, 0x68,
-	0xc3, 0x1a, 0xd4, 0x0d, 0x26, 0x2b, 0x87, 0xc7, 0x51, 0xeb, 0xcb, 0x9f, 0xaf, 0x9b, 0x28, 0xf5,
-	0x93, 0xf1, 0xaa, 0xa7, 0x4e, 0xb8, 0x86, 0xa7, 0x00, 0x95, 0x62, 0x8a, 0xbb, 0xf5, 0xb6, 0x97,
-	0x7c, 0x84, 0x97, 0xfb, 0x5c, 0xc3, 0xce, 0x2e, 0x80, 0x15, 0x5d, 0x49, 0xa2, 0x9f, 0xc7, 0xd1,
-	0x4d, 0xa7, 0xab, 0xdf, 0x0c, 0x68, 0xae, 0x98, 0xe4, 0x66, 0x8f, 0x3e, 0x07, 0xc1, 0xb3, 0xc9,
-	0x13, 0xc8, 0xd2, 0xa5, 0xbe, 0xe3, 0x88, 0xaf, 0x55, 0x9c, 0xfb, 0x2a, 0x1b, 0x3c, 0xe3, 0x27,
-	0xdb, 0x6d, 0xe0, 0xd5, 0xff, 0xfa, 0x5e, 0xec, 0x32, 0x6e, 0x0b, 0xee, 0x96, 0x6b, 0xa7, 0xf3,
-	0x72, 0xfb, 0x5b, 0x1b, 0x5f, 0xb4, 0x58, 0xf2, 0x1e, 0xe1, 0x8e, 0xdb, 0x8a, 0xdc, 0x6b, 0x5c,
-	0xfb, 0x6c, 0x94, 0xc1, 0xfd, 0xc5, 0xc0, 0xce, 0x41, 0xdc, 0x7b, 0xf7, 0xfd, 0xf7, 0xa7, 0x0b,
-	0x6b, 0x24, 0x62, 0x4d, 0x47, 0x77, 0x31, 0x92, 0x8f, 0x08, 0x2f, 0xf9, 0xac, 0xc8, 0x39, 0x12,
-	0xf5, 0xa4, 0x83, 0x07, 0x0b, 0xa2, 0xbd, 0xa3, 0x0d, 0xeb, 0x68, 0x9d, 0xac, 0x35, 0x3a, 0xaa,
-	0xee, 0x43, 0x3e, 0x23, 0xbc, 0x5c, 0x65, 0x4a, 0xce, 0x93, 0xa9, 0xdf, 0x24, 0xa0, 0x8b, 0xc2,
-	0xbd, 0xad, 0x4d, 0x6b, 0xeb, 0x0e, 0x89, 0x9b, 0x6d, 0xcd, 0x47, 0x76, 0x04, 0xd7, 0xc9, 0xe3,
-	0xc3, 0x69, 0x88, 0x8e, 0xa6, 0x21, 0xfa, 0x35, 0x0d, 0xd1, 0x87, 0x59, 0xd8, 0x3a, 0x9a, 0x85,
-	0xad, 0x1f, 0xb3, 0xb0, 0xf5, 0xba, 0x27, 0x72, 0xb3, 0x37, 0xea, 0xd3, 0x4c, 0xc9, 0xd3, 0x3c,
-	0x6f, 0x4f, 0x31, 0x99, 0x49, 0x09, 0xba, 0xdf, 0xb1, 0xaf, 0xe5, 0xe1, 0xdf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x7d, 0x9a, 0xf3, 0x49, 0xdd, 0x03, 0x00, 0x00,
+	// 744 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0x4d, 0x4f, 0x13, 0x4f,
+	0x1c, 0xc7, 0xbb, 0x34, 0xff, 0x96, 0xfe, 0x08, 0xf9, 0xeb, 0xc8, 0x83, 0x2e, 0xda, 0xca, 0x42,
+	0xa4, 0x56, 0xdc, 0x81, 0x72, 0xf3, 0x24, 0xd5, 0x50, 0x12, 0x3d, 0x68, 0x0f, 0x26, 0x9a, 0x68,
+	0x33, 0x5b, 0xa6, 0xdb, 0x0d, 0x6c, 0xa7, 0xec, 0x6c, 0x1b, 0x1b, 0xe3, 0xc5, 0xb3, 0x07, 0x8d,
+	0xf1, 0x64, 0x4c, 0x3c, 0x1a, 0x4f, 0xc6, 0x57, 0xc1, 0x91, 0xc4, 0x8b, 0xf1, 0x40, 0x0c, 0x98,
+	0xf8, 0x36, 0xcc, 0xce, 0xcc, 0x16, 0xda, 0xb2, 0x76, 0xb9, 0x90, 0xe5, 0xf7, 0xf8, 0x99, 0xef,
+	0xce, 0xb7, 0x0b, 0x0b, 0x35, 0xc6, 0x5d, 0xc6, 0x31, 0xed, 0xb8, 0xb8, 0x4e, 0xa9, 0x4b, 0xbc,
+	0x6d, 0xea, 0xe3, 0xce, 0x2a, 0xde, 0x6d, 0x53, 0xaf, 0x6b, 0xb6, 0x3c, 0xe6, 0x33, 0x34, 0x2b,
+	0x8b, 0x4c, 0xda, 0x71, 0xcd, 0x5e, 0x91, 0xd9, 0x59, 0xd5, 0xcf, 0x13, 0xd7, 0x69, 0x32, 0x2c,
+	0xfe, 0xca, 0x5a, 0x7d, 0x29, 0x6a, 0xe0, 0x71, 0xa3, 0x2c, 0x9c, 0xb2, 0x99, 0xcd, 0xc4, 0x23,
+	0x0e, 0x9e, 0x54, 0xf4, 0xb2, 0xcd, 0x98, 0xbd, 0x43, 0x31, 0x69, 0x39, 0x98, 0x34, 0x9b, 0xcc,
+	0x27, 0xbe, 0xc3, 0x9a, 0x5c, 0x66, 0x8d, 0x29, 0x40, 0x0f, 0x03, 0xae, 0x07, 0xc4, 0x23, 0x2e,
+	0xaf, 0xd0, 0xdd, 0x36, 0xe5, 0xbe, 0xf1, 0x18, 0x2e, 0xf4, 0x45, 0x79, 0x8b, 0x35, 0x39, 0x45,
+	0x25, 0x48, 0xb5, 0x44, 0xe4, 0xa2, 0x76, 0x55, 0xcb, 0x4f, 0x14, 0x73, 0x66, 0xc4, 0x31, 0x4c,
+	0xd9, 0x58, 0xca, 0xec, 0x1d, 0xe4, 0x12, 0x9f, 0xff, 0x7c, 0x2d, 0x68, 0x15, 0xd5, 0x69, 0x4c,
+	0xab, 0xd1, 0x25, 0xc2, 0xe9, 0x06, 0xa5, 0xe1, 0xc6, 0x0f, 0x1a, 0x4c, 0xf5, 0xc7, 0xd5, 0xce,
+	0x5b, 0x30, 0x6e, 0x11, 0x4e, 0xab, 0x75, 0x4a, 0xc5, 0xd6, 0x4c, 0x29, 0xf7, 0xf3, 0x20, 0x37,
+	0x27, 0x17, 0xf3, 0xad, 0x6d, 0xd3, 0x61, 0xd8, 0x25, 0x7e, 0xc3, 0xbc, 0x4f, 0x6d, 0x52, 0xeb,
+	0xde, 0xa5, 0xb5, 0x4a, 0xda, 0x92, 0x33, 0x50, 0x19, 0xfe, 0x97, 0x75, 0xd5, 0xde, 0x88, 0xb1,
+	0x78, 0x23, 0x26, 0x65, 0x52, 0xc1, 0x18, 0x33, 0x21, 0xdc, 0x0e, 0xab, 0x6d, 0x97, 0x49, 0x4f,
+	0x27, 0x02, 0xd3, 0x03, 0x71, 0x45, 0x7d, 0x0e, 0x92, 0x36, 0x91, 0x32, 0x25, 0x2b, 0xc1, 0x23,
+	0x9a, 0x85, 0x34, 0xed, 0xb8, 0xd5, 0x20, 0x3a, 0x26, 0xa2, 0x29, 0xda, 0x71, 0xcb, 0x84, 0xa3,
+	0x2b, 0x00, 0x0a, 0x32, 0xc8, 0x25, 0x45, 0x2e, 0x23, 0x23, 0x65, 0xc2, 0x0d, 0x1c, 0xae, 0x90,
+	0x28, 0xeb, 0xbe, 0xda, 0x8d, 0x66, 0x20, 0xd5, 0xa0, 0x8e, 0xdd, 0xf0, 0xd5, 0x16, 0xf5, 0x9f,
+	0xf1, 0x14, 0x66, 0x06, 0x1b, 0x14, 0xd4, 0x9d, 0x21, 0x29, 0xf3, 0xc1, 0xfb, 0x19, 0xa1, 0x85,
+	0x7c, 0x7d, 0xa1, 0xa6, 0xc6, 0x23, 0x35, 0x7e, 0x83, 0xd2, 0x4d, 0x87, 0xfb, 0xcc, 0xeb, 0x86,
+	0x40, 0x39, 0x98, 0xa8, 0x7b, 0xcc, 0xad, 0xf6, 0x51, 0x41, 0x10, 0xda, 0x14, 0x11, 0x34, 0x07,
+	0x19, 0x9f, 0x85, 0x69, 0x29, 0xc2, 0xb8, 0xcf, 0x64, 0xd2, 0xa8, 0xc3, 0xec, 0xd0, 0x5c, 0xc5,
+	0x7d, 0x0f, 0xc0, 0x0a, 0x04, 0x0e, 0xc0, 0x03, 0x4d, 0x93, 0xf9, 0x89, 0xe2, 0x7c, 0xe4, 0xd5,
+	0x13, 0xef, 0x62, 0x83, 0xd2, 0x93, 0x97, 0x2f, 0x63, 0xa9, 0x20, 0x37, 0xe6, 0xe0, 0x92, 0x94,
+	0xa7, 0xed, 0x35, 0xe9, 0xd6, 0xc0, 0x2d, 0x7c, 0x06, 0xfa, 0x69, 0x49, 0xc5, 0x71, 0x1b, 0x52,
+	0x96, 0x48, 0x9c, 0x59, 0x3d, 0xd5, 0x57, 0xfc, 0x96, 0x86, 0xff, 0xc4, 0x02, 0xf4, 0x5a, 0x83,
+	0x94, 0x34, 0x09, 0xba, 0x11, 0x79, 0x94, 0x61, 0x67, 0xea, 0xcb, 0xf1, 0x8a, 0x25, 0xb1, 0xb1,
+	0xf4, 0xea, 0xfb, 0xef, 0x77, 0x63, 0xf3, 0x28, 0x87, 0xa3, 0x7e, 0x43, 0xa4, 0x2b, 0xd1, 0x5b,
+	0x0d, 0xd2, 0xea, 0xb8, 0x68, 0xc4, 0x8a, 0x7e, 0xc9, 0xf4, 0x9b, 0x31, 0xab, 0x15, 0xd1, 0x75,
+	0x41, 0xb4, 0x80, 0xe6, 0x23, 0x89, 0xc2, 0x2b, 0x8a, 0xde, 0x6b, 0x30, 0x1e, 0x1a, 0x0b, 0x8d,
+	0x5a, 0xd3, 0x6f, 0x4c, 0xdd, 0x8c, 0x5b, 0xae, 0xb0, 0x0a, 0x02, 0x6b, 0x11, 0x19, 0xd1, 0x58,
+	0xe2, 0x06, 0x06, 0x4e, 0xfe, 0xa4, 0x41, 0xa6, 0x67, 0x2e, 0x64, 0xc6, 0x3a, 0x7f, 0xcf, 0xb6,
+	0x3a, 0x8e, 0x5d, 0xaf, 0xd0, 0x8a, 0x02, 0x6d, 0x19, 0x15, 0x46, 0x2a, 0x86, 0x5f, 0x48, 0x6f,
+	0xbd, 0x44, 0x1f, 0x35, 0x80, 0x63, 0x23, 0xa1, 0x11, 0x3b, 0x87, 0xac, 0xac, 0xaf, 0xc4, 0x6f,
+	0x50, 0x94, 0xcb, 0x82, 0xf2, 0x1a, 0x5a, 0xc4, 0xff, 0xf8, 0x5a, 0x55, 0x1b, 0x0a, 0xe8, 0x8b,
+	0x06, 0x93, 0x7d, 0x1e, 0x43, 0xc5, 0x11, 0xb2, 0x9c, 0xe2, 0x56, 0x7d, 0xed, 0x4c, 0x3d, 0x0a,
+	0x74, 0x45, 0x80, 0x16, 0x50, 0x3e, 0x5a, 0x4e, 0xd1, 0xd7, 0xfb, 0x64, 0x94, 0xd6, 0xf7, 0x0e,
+	0xb3, 0xda, 0xfe, 0x61, 0x56, 0xfb, 0x75, 0x98, 0xd5, 0xde, 0x1c, 0x65, 0x13, 0xfb, 0x47, 0xd9,
+	0xc4, 0x8f, 0xa3, 0x6c, 0xe2, 0xc9, 0x92, 0xed, 0xf8, 0x8d, 0xb6, 0x65, 0xd6, 0x98, 0x7b, 0x72,
+	0xda, 0xf3, 0x13, 0xf3, 0xfc, 0x6e, 0x8b, 0x72, 0x2b, 0x25, 0x3e, 0xb6, 0x6b, 0x7f, 0x07, 0x00,
+	0xcd, 0x09, 0x4e, 0x4f, 0x1c, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -339,6 +651,13 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// BaseFeeAt queries the base fee of a block recorded in the fee history.
+	BaseFeeAt(ctx context.Context, in *QueryBaseFeeAtRequest, opts ...grpc.CallOption) (*QueryBaseFeeAtResponse, error)
+	// FeeHistory queries the fee market values of a range of blocks recorded in
+	// the fee history.
+	FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error)
+	// BurnedBaseFee queries the cumulative amount of base fees burned.
+	BurnedBaseFee(ctx context.Context, in *QueryBurnedBaseFeeRequest, opts ...grpc.CallOption) (*QueryBurnedBaseFeeResponse, error)
 }
 
 type queryClient struct {
@@ -376,6 +695,33 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) BaseFeeAt(ctx context.Context, in *QueryBaseFeeAtRequest, opts ...grpc.CallOption) (*QueryBaseFeeAtResponse, error) {
+	out := new(QueryBaseFeeAtResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.feemarket.v1.Query/BaseFeeAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error) {
+	out := new(QueryFeeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.feemarket.v1.Query/FeeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BurnedBaseFee(ctx context.Context, in *QueryBurnedBaseFeeRequest, opts ...grpc.CallOption) (*QueryBurnedBaseFeeResponse, error) {
+	out := new(QueryBurnedBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.feemarket.v1.Query/BurnedBaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/feemarket module.
@@ -384,6 +730,13 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// BaseFeeAt queries the base fee of a block recorded in the fee history.
+	BaseFeeAt(context.Context, *QueryBaseFeeAtRequest) (*QueryBaseFeeAtResponse, error)
+	// FeeHistory queries the fee market values of a range of blocks recorded in
+	// the fee history.
+	FeeHistory(context.Context, *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error)
+	// BurnedBaseFee queries the cumulative amount of base fees burned.
+	BurnedBaseFee(context.Context, *QueryBurnedBaseFeeRequest) (*QueryBurnedBaseFeeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -399,6 +752,15 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (*UnimplementedQueryServer) BaseFeeAt(ctx context.Context, req *QueryBaseFeeAtRequest) (*QueryBaseFeeAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFeeAt not implemented")
+}
+func (*UnimplementedQueryServer) FeeHistory(ctx context.Context, req *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeHistory not implemented")
+}
+func (*UnimplementedQueryServer) BurnedBaseFee(ctx context.Context, req *QueryBurnedBaseFeeRequest) (*QueryBurnedBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnedBaseFee not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -458,6 +820,61 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BaseFeeAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFeeAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.feemarket.v1.Query/BaseFeeAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFeeAt(ctx, req.(*QueryBaseFeeAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.feemarket.v1.Query/FeeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeHistory(ctx, req.(*QueryFeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurnedBaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnedBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnedBaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.feemarket.v1.Query/BurnedBaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnedBaseFee(ctx, req.(*QueryBurnedBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.feemarket.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -474,6 +891,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "BaseFeeAt",
+			Handler:    _Query_BaseFeeAt_Handler,
+		},
+		{
+			MethodName: "FeeHistory",
+			Handler:    _Query_FeeHistory_Handler,
+		},
+		{
+			MethodName: "BurnedBaseFee",
+			Handler:    _Query_BurnedBaseFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/feemarket/v1/query.proto",
@@ -578,6 +1007,18 @@ func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CosmosBaseFee != nil {
+		{
+			size := m.CosmosBaseFee.Size()
+			i -= size
+			if _, err := m.CosmosBaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.BaseFee != nil {
 		{
 			size := m.BaseFee.Size()
@@ -636,6 +1077,16 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CosmosGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CosmosGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EvmGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EvmGas))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Gas != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
 		i--
@@ -644,24 +1095,211 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryBaseFeeAtRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryBaseFeeAtRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeAtRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeAtResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeAtResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeAtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockFees) > 0 {
+		for iNdEx := len(m.BlockFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnedBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnedBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Burned.Size()
+		i -= size
+		if _, err := m.Burned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
 func (m *QueryParamsResponse) Size() (n int) {
@@ -694,6 +1332,10 @@ func (m *QueryBaseFeeResponse) Size() (n int) {
 		l = m.BaseFee.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.CosmosBaseFee != nil {
+		l = m.CosmosBaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -715,6 +1357,85 @@ func (m *QueryBlockGasResponse) Size() (n int) {
 	if m.Gas != 0 {
 		n += 1 + sovQuery(uint64(m.Gas))
 	}
+	if m.EvmGas != 0 {
+		n += 1 + sovQuery(uint64(m.EvmGas))
+	}
+	if m.CosmosGas != 0 {
+		n += 1 + sovQuery(uint64(m.CosmosGas))
+	}
+	return n
+}
+
+func (m *QueryBaseFeeAtRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryBaseFeeAtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFeeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func (m *QueryFeeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockFees) > 0 {
+		for _, e := range m.BlockFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBurnedBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBurnedBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Burned.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -972,6 +1693,42 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.CosmosBaseFee = &v
+			if err := m.CosmosBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1091,6 +1848,503 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmGas", wireType)
+			}
+			m.EvmGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosGas", wireType)
+			}
+			m.CosmosGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeAtRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeAtRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeAtRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeAtResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeAtResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeAtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockFees = append(m.BlockFees, BlockFee{})
+			if err := m.BlockFees[len(m.BlockFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnedBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnedBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
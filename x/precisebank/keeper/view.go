@@ -8,6 +8,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // GetBalance returns the balance of a specific denom for an address. This will
@@ -52,6 +53,12 @@ func (k Keeper) IterateAccountBalances(ctx context.Context, account sdk.AccAddre
 	k.bk.IterateAccountBalances(ctx, account, cb)
 }
 
+// DenomOwners returns the accounts holding the given denom, using the denom
+// index of the bank store. The holders of the extended denom are not supported.
+func (k Keeper) DenomOwners(ctx context.Context, req *banktypes.QueryDenomOwnersRequest) (*banktypes.QueryDenomOwnersResponse, error) {
+	return k.bk.DenomOwners(ctx, req)
+}
+
 // SpendableCoins returns the total balances of spendable coins for an account
 // by address. If the account has no spendable coins, an empty Coins slice is
 // returned.
@@ -119,6 +119,65 @@ func (_c *BankKeeper_BurnCoins_Call) RunAndReturn(run func(context.Context, stri
 	return _c
 }
 
+// DenomOwners provides a mock function with given fields: ctx, req
+func (_m *BankKeeper) DenomOwners(ctx context.Context, req *banktypes.QueryDenomOwnersRequest) (*banktypes.QueryDenomOwnersResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DenomOwners")
+	}
+
+	var r0 *banktypes.QueryDenomOwnersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *banktypes.QueryDenomOwnersRequest) (*banktypes.QueryDenomOwnersResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *banktypes.QueryDenomOwnersRequest) *banktypes.QueryDenomOwnersResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*banktypes.QueryDenomOwnersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *banktypes.QueryDenomOwnersRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BankKeeper_DenomOwners_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DenomOwners'
+type BankKeeper_DenomOwners_Call struct {
+	*mock.Call
+}
+
+// DenomOwners is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *banktypes.QueryDenomOwnersRequest
+func (_e *BankKeeper_Expecter) DenomOwners(ctx interface{}, req interface{}) *BankKeeper_DenomOwners_Call {
+	return &BankKeeper_DenomOwners_Call{Call: _e.mock.On("DenomOwners", ctx, req)}
+}
+
+func (_c *BankKeeper_DenomOwners_Call) Run(run func(ctx context.Context, req *banktypes.QueryDenomOwnersRequest)) *BankKeeper_DenomOwners_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*banktypes.QueryDenomOwnersRequest))
+	})
+	return _c
+}
+
+func (_c *BankKeeper_DenomOwners_Call) Return(_a0 *banktypes.QueryDenomOwnersResponse, _a1 error) *BankKeeper_DenomOwners_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BankKeeper_DenomOwners_Call) RunAndReturn(run func(context.Context, *banktypes.QueryDenomOwnersRequest) (*banktypes.QueryDenomOwnersResponse, error)) *BankKeeper_DenomOwners_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllBalances provides a mock function with given fields: ctx, addr
 func (_m *BankKeeper) GetAllBalances(ctx context.Context, addr types.AccAddress) types.Coins {
 	ret := _m.Called(ctx, addr)
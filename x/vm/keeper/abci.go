@@ -11,6 +11,7 @@ import (
 )
 
 // BeginBlock emits a base fee event which will be adjusted to the evm decimals
+// and archives the block hash
 func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 	logger := ctx.Logger().With("begin_block", "evm")
 
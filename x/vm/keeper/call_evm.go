@@ -2,9 +2,11 @@ package keeper
 
 import (
 	"math/big"
+	"strconv"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
@@ -80,5 +82,24 @@ func (k Keeper) CallEVMWithData(
 
 	ctx.GasMeter().ConsumeGas(res.GasUsed, "apply evm message")
 
+	if commit {
+		// record the committed call, so that it can be replayed by the block
+		// tracers. The events are not part of the consensus state.
+		var recipient string
+		if contract != nil {
+			recipient = contract.Hex()
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCosmosCall,
+				sdk.NewAttribute(types.AttributeKeySender, from.Hex()),
+				sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+				sdk.NewAttribute(types.AttributeKeyNonce, strconv.FormatUint(nonce, 10)),
+				sdk.NewAttribute(types.AttributeKeyGasLimit, strconv.FormatUint(msg.GasLimit, 10)),
+				sdk.NewAttribute(types.AttributeKeyInput, hexutil.Encode(data)),
+			),
+		)
+	}
+
 	return res, nil
 }
@@ -57,6 +57,64 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 	return nil
 }
 
+// SplitBaseFee applies the base fee split policy of the fee market params to
+// the base fee component of a transaction fee, which is held by the fee collector
+// module account. The amount is denominated in the EVM denom with 18 decimals.
+// The burned share is removed from the supply, the community pool share is sent
+// to the community pool and the remaining amount is left in the fee collector to
+// be distributed to the validators.
+func (k *Keeper) SplitBaseFee(ctx sdk.Context, baseFeeAmount *big.Int) error {
+	if baseFeeAmount == nil || baseFeeAmount.Sign() <= 0 {
+		return nil
+	}
+
+	split := k.feeMarketWrapper.GetParams(ctx).BaseFeeSplit
+	if split.IsUnset() || split.Validators.Equal(sdkmath.LegacyOneDec()) {
+		return nil
+	}
+
+	amount := sdkmath.LegacyNewDecFromBigInt(baseFeeAmount)
+	feeCollector := k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	burned := amount.Mul(split.Burn).TruncateInt().BigInt()
+	if burned.Sign() > 0 {
+		if err := k.bankWrapper.BurnAmountFromAccount(ctx, feeCollector, burned); err != nil {
+			return errorsmod.Wrapf(err, "failed to burn base fee %s", burned)
+		}
+		k.feeMarketWrapper.AddBurnedBaseFee(ctx, burned)
+	}
+
+	// The community pool is funded with whole units of the bank denom, the
+	// fractional remainder is left to the validators. The share is left to the
+	// validators as well if the app doesn't wire a community pool.
+	communityPool := big.NewInt(0)
+	if k.communityPoolKeeper != nil {
+		conversionFactor := types.GetEVMCoinDecimals().ConversionFactor()
+		communityPoolAmount := amount.Mul(split.CommunityPool).TruncateInt().Quo(conversionFactor)
+		if communityPoolAmount.IsPositive() {
+			coins := sdk.Coins{sdk.NewCoin(types.GetEVMCoinDenom(), communityPoolAmount)}
+			if err := k.communityPoolKeeper.FundCommunityPool(ctx, coins, feeCollector); err != nil {
+				return errorsmod.Wrapf(err, "failed to fund community pool with base fee %s", coins)
+			}
+			communityPool = communityPoolAmount.Mul(conversionFactor).BigInt()
+		}
+	}
+
+	validators := new(big.Int).Sub(baseFeeAmount, burned)
+	validators.Sub(validators, communityPool)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBaseFeeSplit,
+			sdk.NewAttribute(types.AttributeKeyBurned, burned.String()),
+			sdk.NewAttribute(types.AttributeKeyCommunityPool, communityPool.String()),
+			sdk.NewAttribute(types.AttributeKeyValidators, validators.String()),
+		),
+	)
+
+	return nil
+}
+
 // ResetGasMeterAndConsumeGas reset first the gas meter consumed value to zero and set it back to the new value
 // 'gasUsed'
 func (k *Keeper) ResetGasMeterAndConsumeGas(ctx sdk.Context, gasUsed uint64) {
@@ -27,9 +27,11 @@ import (
 	"github.com/cosmos/evm/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -213,6 +215,124 @@ func (k Keeper) Code(c context.Context, req *types.QueryCodeRequest) (*types.Que
 	}, nil
 }
 
+// StorageRange implements the Query/StorageRange gRPC method. The storage of
+// the account is paginated by storage key, so that the next key of a page can
+// be used as the key of the following page request. The page size defaults and
+// is capped to MaxRangeQueryLimit, and offset pagination is not supported.
+func (k Keeper) StorageRange(c context.Context, req *types.QueryStorageRangeRequest) (*types.QueryStorageRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := cosmosevmtypes.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address := common.HexToAddress(req.Address)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(address))
+
+	pageReq, err := rangePageRequest(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var storage types.Storage
+	pageRes, err := query.Paginate(store, pageReq, func(key, value []byte) error {
+		storage = append(storage, types.NewState(common.BytesToHash(key), common.BytesToHash(value)))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStorageRangeResponse{
+		Storage:    storage,
+		Pagination: pageRes,
+	}, nil
+}
+
+// AccountRange implements the Query/AccountRange gRPC method. Only the accounts
+// holding contract code are returned, paginated by address. Externally owned
+// accounts are regular Cosmos accounts and can be listed with the auth module.
+// The page size defaults and is capped to MaxRangeQueryLimit, offset pagination
+// is not supported and the storage of each account is capped to its first
+// MaxDumpStorageEntries entries.
+func (k Keeper) AccountRange(c context.Context, req *types.QueryAccountRangeRequest) (*types.QueryAccountRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	pageReq, err := rangePageRequest(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var accounts []types.DumpAccount
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCodeHash)
+
+	pageRes, err := query.Paginate(store, pageReq, func(key, _ []byte) error {
+		address := common.BytesToAddress(key)
+		acct := k.GetAccountOrEmpty(ctx, address)
+		codeHash := common.BytesToHash(acct.CodeHash)
+
+		account := types.DumpAccount{
+			Address:  address.Hex(),
+			Balance:  acct.Balance.String(),
+			Nonce:    acct.Nonce,
+			CodeHash: codeHash.Hex(),
+		}
+
+		if req.IncludeCode {
+			account.Code = k.GetCode(ctx, codeHash)
+		}
+
+		if req.IncludeStorage {
+			k.ForEachStorage(ctx, address, func(key, value common.Hash) bool {
+				account.Storage = append(account.Storage, types.NewState(key, value))
+				return len(account.Storage) < types.MaxDumpStorageEntries
+			})
+		}
+
+		accounts = append(accounts, account)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccountRangeResponse{
+		Accounts:   accounts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// rangePageRequest returns the page request of the range queries, which must
+// not iterate past the returned page: the limit is capped to MaxRangeQueryLimit,
+// a missing limit defaults to it, the total is never counted and the pages are
+// requested by key rather than by offset.
+func rangePageRequest(pageReq *query.PageRequest) (*query.PageRequest, error) {
+	if pageReq == nil {
+		return &query.PageRequest{Limit: types.MaxRangeQueryLimit}, nil
+	}
+	if pageReq.Offset != 0 {
+		return nil, status.Error(codes.InvalidArgument, "offset pagination is not supported, use the next key")
+	}
+
+	capped := *pageReq
+	capped.CountTotal = false
+	if capped.Limit == 0 || capped.Limit > types.MaxRangeQueryLimit {
+		capped.Limit = types.MaxRangeQueryLimit
+	}
+	return &capped, nil
+}
+
 // Params implements the Query/Params gRPC method
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -229,7 +349,7 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx := withQueryMode(sdk.UnwrapSDKContext(c))
 
 	var args types.TransactionArgs
 	err := json.Unmarshal(req.Args, &args)
@@ -237,10 +357,21 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	stateOverrides, blockOverrides, err := req.UnmarshalOverrides()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx, err = k.applyStateOverrides(ctx, stateOverrides)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	ctx = k.applyBlockOverrides(ctx, cfg, blockOverrides)
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
@@ -264,7 +395,7 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 
 // EstimateGas implements eth_estimateGas rpc api.
 func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*types.EstimateGasResponse, error) {
-	return k.EstimateGasInternal(c, req, types.RPC)
+	return k.EstimateGasInternal(withQueryMode(sdk.UnwrapSDKContext(c)), req, types.RPC)
 }
 
 // EstimateGasInternal returns the gas estimation for the corresponding request.
@@ -289,6 +420,22 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	stateOverrides, blockOverrides, err := req.UnmarshalOverrides()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx, err = k.applyStateOverrides(ctx, stateOverrides)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load evm config")
+	}
+	ctx = k.applyBlockOverrides(ctx, cfg, blockOverrides)
+
 	// Binary search the gas requirement, as it may be higher than the amount used
 	var (
 		lo     = ethparams.TxGas - 1
@@ -315,10 +462,6 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 	}
 
 	gasCap = hi
-	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
-	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to load evm config")
-	}
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
@@ -434,6 +577,44 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 	return &types.EstimateGasResponse{Gas: hi}, nil
 }
 
+// SimulateV1 implements the eth_simulateV1 rpc api. It executes a sequence of
+// simulated blocks, each one containing a batch of calls, on top of the
+// current state. Every call sees the state changes of the previous ones.
+func (k Keeper) SimulateV1(c context.Context, req *types.QuerySimulateV1Request) (*types.QuerySimulateV1Response, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := withQueryMode(sdk.UnwrapSDKContext(c))
+
+	var opts types.SimOpts
+	if err := json.Unmarshal(req.Opts, &opts); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	results, err := k.simulateV1(ctx, cfg, opts, req.GasCap)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resultData, err := json.Marshal(results)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySimulateV1Response{
+		Data: resultData,
+	}, nil
+}
+
 // TraceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
 // be tracer-dependent.
@@ -457,7 +638,7 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		requestedHeight = 1
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx := withQueryMode(sdk.UnwrapSDKContext(c))
 	// the caller sets the `ctx.BlockHeight()` to be `requestedHeight - 1`, so we can get the context of block beginning
 	if requestedHeight > ctx.BlockHeight()+1 {
 		return nil, status.Errorf(codes.FailedPrecondition, "requested height [%d] must be less than or equal to current height [%d]", requestedHeight, ctx.BlockHeight())
@@ -557,7 +738,7 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 		contextHeight = 1
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx := withQueryMode(sdk.UnwrapSDKContext(c))
 	ctx = ctx.WithBlockHeight(contextHeight)
 	ctx = ctx.WithBlockTime(req.BlockTime)
 	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
@@ -580,11 +761,46 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 
 	signer := ethtypes.MakeSigner(types.GetEthChainConfig(), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
 	txsLength := len(req.Txs)
-	results := make([]*types.TxTraceResult, 0, txsLength)
+	results := make([]*types.TxTraceResult, 0, txsLength+len(req.CosmosCalls))
 
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
 
+	// the EVM calls executed by Cosmos messages are traced in block order,
+	// before the ethereum tx that follows them
+	cosmosCalls := req.CosmosCalls
+	traceCosmosCalls := func(ethTxIndex int) {
+		for len(cosmosCalls) > 0 && cosmosCalls[0].EthTxIndex <= uint64(ethTxIndex) { //nolint:gosec // G115 // won't exceed uint64
+			call := cosmosCalls[0]
+			cosmosCalls = cosmosCalls[1:]
+
+			result := types.TxTraceResult{
+				CosmosMsg: &types.CosmosMsgRef{
+					TxHash:   call.TxHash,
+					TxIndex:  call.TxIndex,
+					MsgIndex: call.MsgIndex,
+					MsgType:  call.MsgType,
+					Phase:    call.Phase,
+				},
+			}
+			// the calls don't belong to the ethereum txs of the block: they are
+			// identified by their Cosmos tx, and their logs are indexed from
+			// zero as when they were executed, apart from the ethereum logs
+			callTxConfig := statedb.NewEmptyTxConfig(txConfig.BlockHash)
+			callTxConfig.TxHash = common.HexToHash(call.TxHash)
+			callTxConfig.TxIndex = uint(call.TxIndex) //nolint:gosec // G115 // won't exceed uint64
+			traceResult, _, err := k.traceCosmosCall(ctx, cfg, callTxConfig, call, req.TraceConfig)
+			if err != nil {
+				result.Error = err.Error()
+			} else {
+				result.Result = traceResult
+			}
+			results = append(results, &result)
+		}
+	}
+
 	for i, tx := range req.Txs {
+		traceCosmosCalls(i)
+
 		result := types.TxTraceResult{}
 		ethTx := tx.AsTransaction()
 		txConfig.TxHash = ethTx.Hash()
@@ -598,6 +814,7 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 		}
 		results = append(results, &result)
 	}
+	traceCosmosCalls(txsLength)
 
 	resultData, err := json.Marshal(results)
 	if err != nil {
@@ -609,6 +826,83 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 	}, nil
 }
 
+// TraceCall configures a new tracer according to the provided configuration, and
+// executes the given call arguments on top of the requested block. The state and
+// block overrides are applied before execution and nothing is committed. The
+// return value will be tracer-dependent.
+func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (*types.QueryTraceCallResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TraceConfig != nil && req.TraceConfig.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
+	}
+
+	var args types.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	stateOverrides, blockOverrides, err := req.UnmarshalOverrides()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// the call is executed on top of the state at the end of the requested block
+	contextHeight := req.BlockNumber
+	if contextHeight < 1 {
+		// 0 is a special value in `ContextWithHeight`
+		contextHeight = 1
+	}
+
+	ctx := withQueryMode(sdk.UnwrapSDKContext(c))
+	ctx = ctx.WithBlockHeight(contextHeight)
+	ctx = ctx.WithBlockTime(req.BlockTime)
+	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
+	ctx = ctx.WithConsensusParams(tmproto.ConsensusParams{
+		Block: &tmproto.BlockParams{MaxGas: req.BlockMaxGas},
+	})
+
+	ctx, err = k.applyStateOverrides(ctx, stateOverrides)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+	ctx = k.applyBlockOverrides(ctx, cfg, blockOverrides)
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	nonce := k.GetNonce(ctx, args.GetFrom())
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	if err := args.CallDefaults(req.GasCap, cfg.BaseFee, types.GetEthChainConfig().ChainID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	msg := args.ToMessage(cfg.BaseFee, false, false)
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	// pass false to not commit StateDB
+	result, _, err := k.traceMsg(ctx, cfg, txConfig, msg, req.TraceConfig, false, false)
+	if err != nil {
+		// error will be returned with detail status from traceMsg
+		return nil, err
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraceCallResponse{
+		Data: resultData,
+	}, nil
+}
+
 // traceTx do trace on one transaction, it returns a tuple: (traceResult, nextLogIndex, error).
 func (k *Keeper) traceTx(
 	ctx sdk.Context,
@@ -618,6 +912,59 @@ func (k *Keeper) traceTx(
 	tx *ethtypes.Transaction,
 	traceConfig *types.TraceConfig,
 	commitMessage bool,
+) (*any, uint, error) {
+	msg, err := core.TransactionToMessage(tx, signer, cfg.BaseFee)
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+
+	return k.traceMsg(ctx, cfg, txConfig, msg, traceConfig, commitMessage, false)
+}
+
+// traceCosmosCall replays and traces an EVM call executed by a Cosmos message,
+// as executed by CallEVMWithData. The call is always committed, so that the
+// following traces of the block see its state changes. Only the EVM call is
+// replayed: the other state changes of its message, e.g. the coins minted
+// before a transfer, are not.
+func (k *Keeper) traceCosmosCall(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	call *types.CosmosEVMCall,
+	traceConfig *types.TraceConfig,
+) (*any, uint, error) {
+	var to *common.Address
+	if call.To != "" {
+		contract := common.HexToAddress(call.To)
+		to = &contract
+	}
+
+	msg := &core.Message{
+		From:       common.HexToAddress(call.From),
+		To:         to,
+		Nonce:      call.Nonce,
+		Value:      big.NewInt(0),
+		GasLimit:   call.GasLimit,
+		GasPrice:   big.NewInt(0),
+		GasTipCap:  big.NewInt(0),
+		GasFeeCap:  big.NewInt(0),
+		Data:       call.Data,
+		AccessList: ethtypes.AccessList{},
+	}
+
+	return k.traceMsg(ctx, cfg, txConfig, msg, traceConfig, true, true)
+}
+
+// traceMsg do trace on one message, it returns a tuple: (traceResult, nextLogIndex, error).
+// Internal messages are the ones executed by Cosmos messages, which get a full gas refund.
+func (k *Keeper) traceMsg(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	msg *core.Message,
+	traceConfig *types.TraceConfig,
+	commitMessage bool,
+	internal bool,
 ) (*any, uint, error) {
 	// Assemble the structured logger or the JavaScript tracer
 	var (
@@ -627,10 +974,6 @@ func (k *Keeper) traceTx(
 		err              error
 		timeout          = defaultTraceTimeout
 	)
-	msg, err := core.TransactionToMessage(tx, signer, cfg.BaseFee)
-	if err != nil {
-		return nil, 0, status.Error(codes.Internal, err.Error())
-	}
 
 	if traceConfig == nil {
 		traceConfig = &types.TraceConfig{}
@@ -698,7 +1041,7 @@ func (k *Keeper) traceTx(
 
 	// Build EVM execution context
 	ctx = buildTraceCtx(ctx, msg.GasLimit)
-	res, err := k.ApplyMessageWithConfig(ctx, *msg, tracer.Hooks, commitMessage, cfg, txConfig, false)
+	res, err := k.ApplyMessageWithConfig(ctx, *msg, tracer.Hooks, commitMessage, cfg, txConfig, internal)
 	if err != nil {
 		return nil, 0, status.Error(codes.Internal, err.Error())
 	}
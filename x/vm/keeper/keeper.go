@@ -81,6 +81,15 @@ type Keeper struct {
 	// evmMempool is the custom EVM appside mempool
 	// if it is nil, the default comet mempool will be used
 	evmMempool *evmmempool.ExperimentalEVMMempool
+
+	// headerHashStore is the node local archival store of the block header
+	// hashes, serving the heights beyond the history serve window in queries.
+	// It is nil if the node doesn't archive the header hashes.
+	headerHashStore types.HeaderHashStore
+
+	// communityPoolKeeper funds the community pool with its share of the base
+	// fee split. It is nil if the app doesn't wire a community pool.
+	communityPoolKeeper types.CommunityPoolKeeper
 }
 
 // NewKeeper generates new evm module keeper
@@ -408,14 +417,47 @@ func (k Keeper) GetEvmMempool() *evmmempool.ExperimentalEVMMempool {
 	return k.evmMempool
 }
 
-// SetHeaderHash sets current block hash into EIP-2935 compatible storage contract.
-func (k Keeper) SetHeaderHash(ctx sdk.Context) {
-	window := uint64(types.DefaultHistoryServeWindow)
-	params := k.GetParams(ctx)
-	if params.HistoryServeWindow > 0 {
-		window = params.HistoryServeWindow
+// SetHeaderHashStore sets the archival store of the block header hashes
+func (k *Keeper) SetHeaderHashStore(store types.HeaderHashStore) {
+	k.headerHashStore = store
+}
+
+// queryModeKey is the context key marking the gRPC queries executing the EVM.
+type queryModeKey struct{}
+
+// withQueryMode marks the context as the one of a gRPC query, in which the
+// archived header hashes can be served.
+func withQueryMode(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(queryModeKey{}, true)
+}
+
+// isQueryMode returns true if the context is the one of a gRPC query. The
+// query contexts share their exec mode with CheckTx, so the marker is required
+// on top of it to keep the transactions, including the ones checked for the
+// mempool, from depending on the node local archive.
+func isQueryMode(ctx sdk.Context) bool {
+	marked, _ := ctx.Value(queryModeKey{}).(bool)
+	return marked && ctx.ExecMode() == sdk.ExecModeCheck
+}
+
+// SetCommunityPoolKeeper sets the keeper funding the community pool with its
+// share of the base fee split
+func (k *Keeper) SetCommunityPoolKeeper(cpk types.CommunityPoolKeeper) {
+	k.communityPoolKeeper = cpk
+}
+
+// historyServeWindow returns the number of block hashes kept in the EIP-2935
+// compatible storage contract.
+func (k Keeper) historyServeWindow(ctx sdk.Context) uint64 {
+	if window := k.GetParams(ctx).HistoryServeWindow; window > 0 {
+		return window
 	}
+	return types.DefaultHistoryServeWindow
+}
 
+// SetHeaderHash sets current block hash into EIP-2935 compatible storage contract.
+func (k Keeper) SetHeaderHash(ctx sdk.Context) {
+	window := k.historyServeWindow(ctx)
 	acct := k.GetAccount(ctx, ethparams.HistoryStorageAddress)
 	if acct != nil && acct.IsContract() {
 		// set current block hash in the contract storage, compatible with EIP-2935
@@ -428,13 +470,7 @@ func (k Keeper) SetHeaderHash(ctx sdk.Context) {
 
 // GetHeaderHash sets block hash into EIP-2935 compatible storage contract.
 func (k Keeper) GetHeaderHash(ctx sdk.Context, height uint64) common.Hash {
-	window := uint64(types.DefaultHistoryServeWindow)
-	params := k.GetParams(ctx)
-	if params.HistoryServeWindow > 0 {
-		window = params.HistoryServeWindow
-	}
-
-	ringIndex := height % window
+	ringIndex := height % k.historyServeWindow(ctx)
 	var key common.Hash
 	binary.BigEndian.PutUint64(key[24:], ringIndex)
 	return k.GetState(ctx, ethparams.HistoryStorageAddress, key)
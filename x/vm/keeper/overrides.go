@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/evm/x/vm/statedb"
+	"github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// applyStateOverrides returns a cached context on top of which the given state
+// overrides have been committed. The original context is returned untouched if
+// there are no overrides. The returned context must never be committed.
+func (k *Keeper) applyStateOverrides(ctx sdk.Context, overrides types.StateOverride) (sdk.Context, error) {
+	if len(overrides) == 0 {
+		return ctx, nil
+	}
+
+	overrideCtx, _ := ctx.CacheContext()
+	if err := k.commitStateOverrides(overrideCtx, overrides); err != nil {
+		return ctx, err
+	}
+
+	return overrideCtx, nil
+}
+
+// commitStateOverrides applies the given state overrides and commits them into
+// the given context.
+func (k *Keeper) commitStateOverrides(ctx sdk.Context, overrides types.StateOverride) error {
+	if len(overrides) == 0 {
+		return nil
+	}
+
+	stateDB := statedb.New(ctx, k, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	if err := overrides.Apply(stateDB); err != nil {
+		return err
+	}
+	if err := stateDB.Commit(); err != nil {
+		return errorsmod.Wrap(err, "failed to commit state overrides")
+	}
+
+	return nil
+}
+
+// applyBlockOverrides updates the block context and the EVM configuration with
+// the given block overrides.
+func (k *Keeper) applyBlockOverrides(ctx sdk.Context, cfg *statedb.EVMConfig, overrides *types.BlockOverrides) sdk.Context {
+	if overrides == nil {
+		return ctx
+	}
+
+	if overrides.Number != nil {
+		ctx = ctx.WithBlockHeight(overrides.Number.ToInt().Int64())
+	}
+	if overrides.Time != nil {
+		ctx = ctx.WithBlockTime(time.Unix(int64(*overrides.Time), 0).UTC()) //#nosec G115 -- validated in BlockOverrides.Validate
+	}
+	if overrides.GasLimit != nil {
+		ctx = k.SetConsensusParamsInCtx(ctx)
+		cp := ctx.ConsensusParams()
+		blockParams := tmproto.BlockParams{MaxGas: int64(*overrides.GasLimit)} //#nosec G115 -- validated in BlockOverrides.Validate
+		if cp.Block != nil {
+			blockParams.MaxBytes = cp.Block.MaxBytes
+		}
+		cp.Block = &blockParams
+		ctx = ctx.WithConsensusParams(cp)
+	}
+	if overrides.FeeRecipient != nil {
+		cfg.CoinBase = *overrides.FeeRecipient
+	}
+	if overrides.BaseFee != nil {
+		cfg.BaseFee = overrides.BaseFee.ToInt()
+	}
+
+	return ctx
+}
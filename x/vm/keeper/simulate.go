@@ -0,0 +1,383 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/holiman/uint256"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// transferAddress is the address of the pseudo contract emitting the native
+	// value transfer logs, as defined by ERC-7528.
+	transferAddress = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+	// transferTopic is the topic of the ERC-20 Transfer(address,address,uint256) event.
+	transferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+)
+
+// simulateV1 executes the simulated blocks sequentially on top of the given
+// context. Every call sees the state changes of the previous ones. Nothing is
+// committed to the given context.
+func (k *Keeper) simulateV1(ctx sdk.Context, cfg *statedb.EVMConfig, opts types.SimOpts, gasCap uint64) ([]*types.SimBlockResult, error) {
+	ctx, _ = ctx.CacheContext()
+	ctx = k.SetConsensusParamsInCtx(ctx)
+
+	blocks, err := opts.SanitizeChain(uint64(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	if err != nil {
+		return nil, err
+	}
+
+	if gasCap == 0 {
+		gasCap = math.MaxUint64
+	}
+
+	parentHash := k.GetHashFn(ctx)(uint64(ctx.BlockHeight())) //#nosec G115 -- int overflow is not a concern here
+	results := make([]*types.SimBlockResult, 0, len(blocks))
+	for _, block := range blocks {
+		result, err := k.simulateBlock(ctx, cfg, block, parentHash, opts, &gasCap)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+		parentHash = result.Header.Hash()
+	}
+
+	return results, nil
+}
+
+// simulateBlock executes the calls of a single simulated block and assembles
+// the resulting header. The number and time block overrides must be set.
+func (k *Keeper) simulateBlock(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	block types.SimBlock,
+	parentHash common.Hash,
+	opts types.SimOpts,
+	gasRemaining *uint64,
+) (*types.SimBlockResult, error) {
+	blockCfg := *cfg
+	if !opts.Validation {
+		// the base fee defaults to zero, so that calls without gas price succeed
+		blockCfg.BaseFee = new(big.Int)
+	}
+	ctx = k.applyBlockOverrides(ctx, &blockCfg, block.BlockOverrides)
+
+	if err := k.commitStateOverrides(ctx, block.StateOverrides); err != nil {
+		return nil, err
+	}
+
+	var (
+		chainID  = types.GetEthChainConfig().ChainID
+		gasLimit = cosmosevmtypes.BlockGasLimit(ctx)
+		gasUsed  uint64
+		logIndex uint
+
+		txs      = make([]*ethtypes.Transaction, 0, len(block.Calls))
+		senders  = make([]common.Address, 0, len(block.Calls))
+		receipts = make([]*ethtypes.Receipt, 0, len(block.Calls))
+		calls    = make([]types.SimCallResult, 0, len(block.Calls))
+	)
+
+	for i, call := range block.Calls {
+		if err := ctx.Context().Err(); err != nil {
+			return nil, err
+		}
+
+		if call.From == nil {
+			call.From = &common.Address{}
+		}
+		if call.Nonce == nil {
+			nonce := k.GetNonce(ctx, *call.From)
+			call.Nonce = (*hexutil.Uint64)(&nonce)
+		}
+		remaining := gasLimit - gasUsed
+		if call.Gas == nil {
+			gas := min(remaining, *gasRemaining)
+			call.Gas = (*hexutil.Uint64)(&gas)
+		}
+		if uint64(*call.Gas) > remaining {
+			return nil, fmt.Errorf("block gas limit reached: %d >= %d", gasUsed, gasLimit)
+		}
+		if err := call.CallDefaults(*gasRemaining, blockCfg.BaseFee, chainID); err != nil {
+			return nil, err
+		}
+
+		tx := call.ToTransaction(ethtypes.DynamicFeeTxType)
+		msg := call.ToMessage(blockCfg.BaseFee, !opts.Validation, !opts.Validation)
+		if opts.Validation {
+			if err := k.validateSimMessage(ctx, msg, blockCfg.BaseFee); err != nil {
+				return nil, fmt.Errorf("call %d: %w", i, err)
+			}
+		}
+		if err := k.buySimGas(ctx, msg, opts.Validation); err != nil {
+			return nil, err
+		}
+
+		var tracer *simTracer
+		if opts.TraceTransfers {
+			tracer = newSimTracer()
+		}
+		txConfig := statedb.NewTxConfig(common.Hash{}, tx.Hash(), uint(i), logIndex) //nolint:gosec // G115 // won't exceed uint
+		res, err := k.ApplyMessageWithConfig(buildTraceCtx(ctx, msg.GasLimit), *msg, tracer.Hooks(), true, &blockCfg, txConfig, false)
+		if err != nil {
+			return nil, fmt.Errorf("call %d: %w", i, err)
+		}
+		if err := k.refundSimGas(ctx, msg, res.GasUsed, opts.Validation); err != nil {
+			return nil, err
+		}
+
+		*gasRemaining -= min(res.GasUsed, *gasRemaining)
+		gasUsed += res.GasUsed
+
+		callRes := types.SimCallResult{
+			ReturnValue: res.Ret,
+			Logs:        []*ethtypes.Log{},
+			GasUsed:     hexutil.Uint64(res.GasUsed),
+			Status:      hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+		}
+		if res.Failed() {
+			callRes.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+			if res.VmError == vm.ErrExecutionReverted.Error() {
+				revertErr := types.NewExecErrorWithReason(res.Ret)
+				callRes.Error = &types.SimCallError{
+					Message: revertErr.Error(),
+					Code:    types.SimErrCodeReverted,
+					Data:    revertErr.ErrorData().(string),
+				}
+			} else {
+				callRes.Error = &types.SimCallError{Message: res.VmError, Code: types.SimErrCodeVMError}
+			}
+		} else {
+			logs := types.LogsToEthereum(res.Logs)
+			if tracer != nil {
+				logs = tracer.mergeLogs(logs, txConfig)
+			}
+			callRes.Logs = logs
+		}
+		logIndex += uint(len(callRes.Logs))
+
+		receipt := &ethtypes.Receipt{
+			Type:              tx.Type(),
+			Status:            uint64(callRes.Status),
+			CumulativeGasUsed: gasUsed,
+			Logs:              callRes.Logs,
+			TxHash:            tx.Hash(),
+			GasUsed:           res.GasUsed,
+			TransactionIndex:  uint(i), //nolint:gosec // G115 // won't exceed uint
+		}
+		receipt.Bloom = ethtypes.CreateBloom(receipt)
+
+		txs = append(txs, tx)
+		senders = append(senders, msg.From)
+		receipts = append(receipts, receipt)
+		calls = append(calls, callRes)
+	}
+
+	// NOTE: the state root is left empty as it is not computed on queries
+	header := &ethtypes.Header{
+		ParentHash:  parentHash,
+		UncleHash:   ethtypes.EmptyUncleHash,
+		Coinbase:    blockCfg.CoinBase,
+		TxHash:      ethtypes.DeriveSha(ethtypes.Transactions(txs), trie.NewStackTrie(nil)),
+		ReceiptHash: ethtypes.DeriveSha(ethtypes.Receipts(receipts), trie.NewStackTrie(nil)),
+		Bloom:       ethtypes.MergeBloom(receipts),
+		Difficulty:  big.NewInt(0),
+		Number:      big.NewInt(ctx.BlockHeight()),
+		GasLimit:    gasLimit,
+		GasUsed:     gasUsed,
+		Time:        uint64(ctx.BlockTime().Unix()), //#nosec G115 -- int overflow is not a concern here
+		Extra:       []byte{},
+		BaseFee:     blockCfg.BaseFee,
+	}
+	blockHash := header.Hash()
+	for _, receipt := range receipts {
+		for _, log := range receipt.Logs {
+			log.BlockHash = blockHash
+			log.BlockNumber = header.Number.Uint64()
+		}
+	}
+
+	// make the hash of the simulated block available to the BLOCKHASH opcode
+	// of the following ones
+	k.SetHeaderHash(ctx.WithHeaderHash(blockHash.Bytes()))
+
+	return &types.SimBlockResult{
+		Header:       header,
+		Transactions: txs,
+		Senders:      senders,
+		Calls:        calls,
+	}, nil
+}
+
+// validateSimMessage performs the nonce, fee and balance checks done by the
+// ante handler on real transactions.
+func (k *Keeper) validateSimMessage(ctx sdk.Context, msg *core.Message, baseFee *big.Int) error {
+	nonce := k.GetNonce(ctx, msg.From)
+	switch {
+	case msg.Nonce < nonce:
+		return fmt.Errorf("%w: address %s, tx: %d state: %d", core.ErrNonceTooLow, msg.From.Hex(), msg.Nonce, nonce)
+	case msg.Nonce > nonce:
+		return fmt.Errorf("%w: address %s, tx: %d state: %d", core.ErrNonceTooHigh, msg.From.Hex(), msg.Nonce, nonce)
+	}
+
+	if msg.GasFeeCap.Cmp(msg.GasTipCap) < 0 {
+		return fmt.Errorf("%w: address %s, maxPriorityFeePerGas: %s, maxFeePerGas: %s", core.ErrTipAboveFeeCap, msg.From.Hex(), msg.GasTipCap, msg.GasFeeCap)
+	}
+	if baseFee != nil && msg.GasFeeCap.Cmp(baseFee) < 0 {
+		return fmt.Errorf("%w: address %s, maxFeePerGas: %s, baseFee: %s", core.ErrFeeCapTooLow, msg.From.Hex(), msg.GasFeeCap, baseFee)
+	}
+
+	cost := new(big.Int).Mul(new(big.Int).SetUint64(msg.GasLimit), msg.GasFeeCap)
+	cost.Add(cost, msg.Value)
+	balance := k.GetAccountOrEmpty(ctx, msg.From).Balance.ToBig()
+	if balance.Cmp(cost) < 0 {
+		return fmt.Errorf("%w: address %s have %s want %s", core.ErrInsufficientFunds, msg.From.Hex(), balance, cost)
+	}
+
+	return nil
+}
+
+// buySimGas increments the nonce of the sender, as it's done by the ante
+// handler on real transactions. The contract creation nonce is handled by
+// ApplyMessageWithConfig. If the validation is enabled, the gas limit of the
+// message is charged upfront to the sender.
+func (k *Keeper) buySimGas(ctx sdk.Context, msg *core.Message, validation bool) error {
+	stateDB := statedb.New(ctx, k, statedb.NewEmptyTxConfig(common.Hash{}))
+	if msg.To != nil {
+		stateDB.SetNonce(msg.From, stateDB.GetNonce(msg.From)+1, tracing.NonceChangeEoACall)
+	}
+	if validation {
+		cost := new(big.Int).Mul(new(big.Int).SetUint64(msg.GasLimit), msg.GasPrice)
+		stateDB.SubBalance(msg.From, uint256.MustFromBig(cost), tracing.BalanceDecreaseGasBuy)
+	}
+	return stateDB.Commit()
+}
+
+// refundSimGas refunds the sender for the unused gas of the message if the
+// validation is enabled.
+func (k *Keeper) refundSimGas(ctx sdk.Context, msg *core.Message, gasUsed uint64, validation bool) error {
+	if !validation || gasUsed >= msg.GasLimit {
+		return nil
+	}
+	stateDB := statedb.New(ctx, k, statedb.NewEmptyTxConfig(common.Hash{}))
+	refund := new(big.Int).Mul(new(big.Int).SetUint64(msg.GasLimit-gasUsed), msg.GasPrice)
+	stateDB.AddBalance(msg.From, uint256.MustFromBig(refund), tracing.BalanceIncreaseGasReturn)
+	return stateDB.Commit()
+}
+
+// simTransferLog is a native value transfer log, along with the number of
+// logs emitted by the call before it.
+type simTransferLog struct {
+	position int
+	log      *ethtypes.Log
+}
+
+// simTracer records the native value transfers of a simulated call as
+// ERC-7528 logs. The transfers of the reverted call frames are discarded.
+type simTracer struct {
+	stateDB interface{ Logs() []*ethtypes.Log }
+	frames  [][]simTransferLog
+}
+
+func newSimTracer() *simTracer {
+	return &simTracer{}
+}
+
+// Hooks returns the tracing hooks of the tracer, nil if the tracer is not set.
+func (t *simTracer) Hooks() *tracing.Hooks {
+	if t == nil {
+		return nil
+	}
+	return &tracing.Hooks{
+		OnTxStart: t.onTxStart,
+		OnEnter:   t.onEnter,
+		OnExit:    t.onExit,
+	}
+}
+
+func (t *simTracer) onTxStart(vm *tracing.VMContext, _ *ethtypes.Transaction, _ common.Address) {
+	t.stateDB, _ = vm.StateDB.(interface{ Logs() []*ethtypes.Log })
+	t.frames = nil
+}
+
+func (t *simTracer) onEnter(_ int, typ byte, from, to common.Address, _ []byte, _ uint64, value *big.Int) {
+	t.frames = append(t.frames, nil)
+	if vm.OpCode(typ) == vm.DELEGATECALL || value == nil || value.Sign() == 0 {
+		return
+	}
+
+	position := 0
+	if t.stateDB != nil {
+		position = len(t.stateDB.Logs())
+	}
+	t.frames[len(t.frames)-1] = append(t.frames[len(t.frames)-1], simTransferLog{
+		position: position,
+		log: &ethtypes.Log{
+			Address: transferAddress,
+			Topics:  []common.Hash{transferTopic, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+			Data:    common.BigToHash(value).Bytes(),
+		},
+	})
+}
+
+func (t *simTracer) onExit(depth int, _ []byte, _ uint64, _ error, reverted bool) {
+	size := len(t.frames)
+	if depth == 0 {
+		if reverted && size > 0 {
+			t.frames[0] = nil
+		}
+		return
+	}
+	if size <= 1 {
+		return
+	}
+
+	frame := t.frames[size-1]
+	t.frames = t.frames[:size-1]
+	if !reverted {
+		t.frames[size-2] = append(t.frames[size-2], frame...)
+	}
+}
+
+// mergeLogs inserts the recorded transfer logs among the logs emitted by the
+// call, in execution order, and updates the log indexes accordingly.
+func (t *simTracer) mergeLogs(logs []*ethtypes.Log, txConfig statedb.TxConfig) []*ethtypes.Log {
+	if len(t.frames) == 0 || len(t.frames[0]) == 0 {
+		return logs
+	}
+
+	transfers := t.frames[0]
+	merged := make([]*ethtypes.Log, 0, len(logs)+len(transfers))
+	for i := 0; i <= len(logs); i++ {
+		for len(transfers) > 0 && transfers[0].position <= i {
+			log := transfers[0].log
+			log.TxHash = txConfig.TxHash
+			log.TxIndex = txConfig.TxIndex
+			merged = append(merged, log)
+			transfers = transfers[1:]
+		}
+		if i < len(logs) {
+			merged = append(merged, logs[i])
+		}
+	}
+
+	for i, log := range merged {
+		log.Index = txConfig.LogIndex + uint(i) //nolint:gosec // G115 // won't exceed uint
+	}
+	return merged
+}
@@ -80,7 +80,8 @@ func (k *Keeper) NewEVM(
 
 // GetHashFn implements vm.GetHashFunc for Ethermint. It handles 3 cases:
 //  1. The requested height matches the current height from context (and thus same epoch number)
-//  2. The requested height is from an previous height from the same chain epoch
+//  2. The requested height is from an previous height from the same chain epoch, served by the
+//     archival header hash store in the gRPC queries if beyond the history serve window
 //  3. The requested height is from a height greater than the latest one
 func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 	return func(height uint64) common.Hash {
@@ -114,6 +115,19 @@ func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 		case ctx.BlockHeight() > h:
 			// Case 2: The requested height is historical, query EIP-2935 contract storage for that
 			// see: https://github.com/cosmos/evm/issues/406
+			if k.headerHashStore != nil && isQueryMode(ctx) &&
+				uint64(ctx.BlockHeight())-height >= k.historyServeWindow(ctx) { //nolint:gosec // G115 // block height won't exceed uint64
+				// The hashes beyond the history serve window are overwritten in the contract
+				// storage, so they are served by the node local archive instead. It is only
+				// consulted by the queries, since the transactions must not depend on the
+				// node setup.
+				archivedHash, err := k.headerHashStore.GetHeaderHash(h)
+				if err != nil {
+					k.Logger(ctx).Error("failed to get archived header hash", "height", h, "error", err)
+					return common.Hash{}
+				}
+				return archivedHash
+			}
 			return k.GetHeaderHash(ctx, height)
 		default:
 			// Case 3: The requested height is greater than the latest one, return empty hash
@@ -283,6 +297,14 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From)
 	}
 
+	// apply the base fee split policy to the base fee paid for the gas used
+	if baseFee := k.GetBaseFee(ctx); baseFee != nil {
+		baseFeeAmount := new(big.Int).Mul(baseFee, new(big.Int).SetUint64(res.GasUsed))
+		if err = k.SplitBaseFee(ctx, baseFeeAmount); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to split base fee")
+		}
+	}
+
 	if len(ethLogs) > 0 {
 		// Update transient block bloom filter
 		k.SetBlockBloomTransient(ctx, bloom)
@@ -73,6 +73,9 @@ type stateObject struct {
 	dirtyCode      bool
 	selfDestructed bool
 	newContract    bool
+	// fakeStorage is set once the storage is replaced by a state override, the
+	// slots not set by the override are then empty instead of read from the store
+	fakeStorage bool
 }
 
 // newObject creates a state object.
@@ -231,6 +234,9 @@ func (s *stateObject) GetCommittedState(key common.Hash) common.Hash {
 	if value, cached := s.originStorage[key]; cached {
 		return value
 	}
+	if s.fakeStorage {
+		return common.Hash{}
+	}
 	// If no live objects are available, load it from keeper
 	value := s.db.keeper.GetState(s.db.ctx, s.Address(), key)
 	s.originStorage[key] = value
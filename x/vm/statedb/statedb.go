@@ -36,7 +36,10 @@ type revision struct {
 	journalIndex int
 }
 
-var _ vm.StateDB = &StateDB{}
+var (
+	_ vm.StateDB            = &StateDB{}
+	_ types.OverrideStateDB = &StateDB{}
+)
 
 // StateDB structs within the ethereum protocol are used to store anything
 // within the merkle trie. StateDBs take care of caching and storing
@@ -411,6 +414,15 @@ func (s *StateDB) ForEachStorage(addr common.Address, cb func(key, value common.
 	if so == nil {
 		return nil
 	}
+	if so.fakeStorage {
+		// the storage replaced by a state override is only kept in memory
+		for key, value := range so.dirtyStorage {
+			if value != (common.Hash{}) && !cb(key, value) {
+				break
+			}
+		}
+		return nil
+	}
 	s.keeper.ForEachStorage(s.ctx, addr, func(key, value common.Hash) bool {
 		if value, dirty := so.dirtyStorage[key]; dirty {
 			return cb(key, value)
@@ -468,6 +480,14 @@ func (s *StateDB) SubBalance(addr common.Address, amount *uint256.Int, reason tr
 	return stateObject.SubBalance(amount)
 }
 
+// SetBalance sets the balance of the account associated with addr.
+func (s *StateDB) SetBalance(addr common.Address, amount *uint256.Int) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetBalance(amount)
+	}
+}
+
 // SetNonce sets the nonce of account.
 func (s *StateDB) SetNonce(addr common.Address, nonce uint64, reason tracing.NonceChangeReason) {
 	stateObject := s.getOrNewStateObject(addr)
@@ -495,6 +515,24 @@ func (s *StateDB) SetState(addr common.Address, key, value common.Hash) common.H
 	return common.Hash{}
 }
 
+// SetStorage replaces the entire storage of the account with the given one.
+// Like in Geth, the existing slots are not enumerated, the account storage is
+// marked as fake and the slots that are not present in the new storage read as
+// empty. It is used for state overrides in eth_call like queries.
+func (s *StateDB) SetStorage(addr common.Address, storage map[common.Hash]common.Hash) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject == nil {
+		return
+	}
+
+	stateObject.fakeStorage = true
+	stateObject.originStorage = make(Storage)
+	stateObject.dirtyStorage = make(Storage)
+	for key, value := range storage {
+		stateObject.SetState(key, value)
+	}
+}
+
 // SelfDestruct marks the given account as self-destructed.
 // This clears the account balance.
 //
@@ -344,6 +344,53 @@ func (suite *StateDBTestSuite) TestState() {
 	}
 }
 
+func (suite *StateDBTestSuite) TestSetStorage() {
+	key1 := common.BigToHash(big.NewInt(1))
+	key2 := common.BigToHash(big.NewInt(2))
+	key3 := common.BigToHash(big.NewInt(3))
+	value := common.BigToHash(big.NewInt(10))
+
+	ctx := sdk.Context{}
+	keeper := mocks.NewEVMKeeper()
+	db := statedb.New(ctx, keeper, emptyTxConfig)
+	db.SetState(address, key1, value)
+	db.SetState(address, key2, value)
+	suite.Require().NoError(db.Commit())
+
+	// replace the whole storage without iterating the stored one, key1 must
+	// read as cleared
+	iterKeeper := &storageIterationKeeper{EVMKeeper: keeper}
+	db = statedb.New(ctx, iterKeeper, emptyTxConfig)
+	db.SetStorage(address, map[common.Hash]common.Hash{key2: key3, key3: value})
+	suite.Require().Equal(common.Hash{}, db.GetState(address, key1))
+	suite.Require().Equal(common.Hash{}, db.GetCommittedState(address, key1))
+	suite.Require().Equal(key3, db.GetState(address, key2))
+	suite.Require().Equal(value, db.GetState(address, key3))
+
+	storage := make(map[common.Hash]common.Hash)
+	suite.Require().NoError(db.ForEachStorage(address, func(key, value common.Hash) bool {
+		storage[key] = value
+		return true
+	}))
+	suite.Require().Equal(map[common.Hash]common.Hash{key2: key3, key3: value}, storage)
+	suite.Require().False(iterKeeper.iterated, "expected the stored storage not to be iterated")
+
+	suite.Require().NoError(db.Commit())
+	suite.Require().Equal(key3, keeper.GetState(ctx, address, key2))
+	suite.Require().Equal(value, keeper.GetState(ctx, address, key3))
+}
+
+// storageIterationKeeper records whether the storage of an account is iterated.
+type storageIterationKeeper struct {
+	*mocks.EVMKeeper
+	iterated bool
+}
+
+func (k *storageIterationKeeper) ForEachStorage(ctx sdk.Context, addr common.Address, cb func(key, value common.Hash) bool) {
+	k.iterated = true
+	k.EVMKeeper.ForEachStorage(ctx, addr, cb)
+}
+
 func (suite *StateDBTestSuite) TestCode() {
 	code := []byte("hello world")
 	codeHash := crypto.Keccak256Hash(code)
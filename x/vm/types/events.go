@@ -2,10 +2,12 @@ package types
 
 // Evm module events
 const (
-	EventTypeEthereumTx = TypeMsgEthereumTx
-	EventTypeBlockBloom = "block_bloom"
-	EventTypeTxLog      = "tx_log"
-	EventTypeFeeMarket  = "evm_fee_market"
+	EventTypeEthereumTx   = TypeMsgEthereumTx
+	EventTypeBlockBloom   = "block_bloom"
+	EventTypeTxLog        = "tx_log"
+	EventTypeFeeMarket    = "evm_fee_market"
+	EventTypeCosmosCall   = "cosmos_evm_call"
+	EventTypeBaseFeeSplit = "base_fee_split"
 
 	AttributeKeyBaseFee         = "base_fee"
 	AttributeKeyContractAddress = "contract"
@@ -16,6 +18,15 @@ const (
 	AttributeKeyTxGasUsed       = "txGasUsed"
 	AttributeKeyTxType          = "txType"
 	AttributeKeyTxLog           = "txLog"
+	AttributeKeySender          = "sender"
+	AttributeKeyNonce           = "nonce"
+	AttributeKeyGasLimit        = "gasLimit"
+	AttributeKeyInput           = "input"
+
+	// base fee split amounts, denominated in 18 decimals
+	AttributeKeyBurned        = "burned"
+	AttributeKeyCommunityPool = "community_pool"
+	AttributeKeyValidators    = "validators"
 
 	// tx failed in eth vm execution
 	AttributeKeyEthereumTxFailed = "ethereumTxFailed"
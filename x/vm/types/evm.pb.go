@@ -745,6 +745,9 @@ type TraceConfig struct {
 	EnableReturnData bool `protobuf:"varint,12,opt,name=enable_return_data,json=enableReturnData,proto3" json:"enableReturnData"`
 	// tracer_json_config configures the tracer using a JSON string
 	TracerJsonConfig string `protobuf:"bytes,13,opt,name=tracer_json_config,json=tracerJsonConfig,proto3" json:"tracerConfig"`
+	// include_cosmos_calls includes the EVM calls executed by Cosmos messages
+	// in the block traces
+	IncludeCosmosCalls bool `protobuf:"varint,14,opt,name=include_cosmos_calls,json=includeCosmosCalls,proto3" json:"includeCosmosCalls"`
 }
 
 func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
@@ -857,6 +860,13 @@ func (m *TraceConfig) GetTracerJsonConfig() string {
 	return ""
 }
 
+func (m *TraceConfig) GetIncludeCosmosCalls() bool {
+	if m != nil {
+		return m.IncludeCosmosCalls
+	}
+	return false
+}
+
 // Preinstall defines a contract that is preinstalled on-chain with a specific
 // contract address and bytecode
 type Preinstall struct {
@@ -940,132 +950,133 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/evm.proto", fileDescriptor_d1129b8db63d55c7) }
 
 var fileDescriptor_d1129b8db63d55c7 = []byte{
-	// 1988 bytes of a gzipped FileDescriptorProto
+	// 2016 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x4d, 0x6f, 0x1b, 0xc7,
-	0x19, 0x16, 0xc5, 0x95, 0xb4, 0x1c, 0x52, 0xd2, 0x7a, 0x44, 0xcb, 0x34, 0xed, 0x68, 0xd5, 0x6d,
-	0x0f, 0xaa, 0x91, 0x4a, 0x96, 0x1c, 0xb5, 0x86, 0xd3, 0x0f, 0x88, 0x32, 0xd3, 0x4a, 0xb5, 0x1d,
+	0x19, 0x16, 0xc5, 0x95, 0xb4, 0x1c, 0x52, 0xd4, 0x7a, 0x44, 0xcb, 0x34, 0xed, 0x68, 0xd5, 0x6d,
+	0x0f, 0xaa, 0x91, 0x4a, 0x96, 0x1c, 0xb5, 0x86, 0xd3, 0x0f, 0x88, 0x32, 0xd3, 0x48, 0xb5, 0x1d,
 	0x61, 0xa8, 0xc6, 0x48, 0xd1, 0x62, 0x31, 0xdc, 0x1d, 0x2f, 0x37, 0xda, 0xdd, 0x21, 0x66, 0x96,
-	0xb4, 0xd8, 0x5f, 0x10, 0xf8, 0x94, 0xfe, 0x00, 0x03, 0x01, 0x7a, 0xc9, 0x31, 0x87, 0xfe, 0x80,
+	0xb4, 0xd4, 0x5f, 0x10, 0xf8, 0x94, 0xfe, 0x00, 0x03, 0x05, 0x7a, 0xc9, 0x31, 0x87, 0xfe, 0x80,
 	0x1e, 0x83, 0x9e, 0x72, 0x2c, 0x0a, 0x74, 0x51, 0xd0, 0x87, 0x00, 0x3a, 0xea, 0x17, 0x14, 0xf3,
 	0xc1, 0x4f, 0x29, 0xac, 0x02, 0x08, 0xf6, 0x3c, 0xef, 0xc7, 0xf3, 0xcc, 0xc7, 0xbb, 0x3b, 0xef,
-	0x12, 0x54, 0x3d, 0xca, 0x63, 0xca, 0x77, 0x48, 0x37, 0xde, 0x11, 0x7f, 0xbb, 0x62, 0xb4, 0xdd,
-	0x66, 0x34, 0xa5, 0xd0, 0x52, 0xbe, 0x6d, 0x61, 0x11, 0x7f, 0xbb, 0xd5, 0x5b, 0x38, 0x0e, 0x13,
-	0xba, 0x23, 0xff, 0x55, 0x41, 0xd5, 0x72, 0x40, 0x03, 0x2a, 0x87, 0x3b, 0x62, 0xa4, 0xac, 0xce,
-	0xdf, 0xf3, 0x60, 0xf1, 0x04, 0x33, 0x1c, 0x73, 0xb8, 0x0b, 0x0a, 0xa4, 0x1b, 0xbb, 0x3e, 0x49,
-	0x68, 0x5c, 0xc9, 0x6d, 0xe6, 0xb6, 0x0a, 0xb5, 0xf2, 0x65, 0x66, 0x5b, 0x3d, 0x1c, 0x47, 0x4f,
-	0x9c, 0xa1, 0xcb, 0x41, 0x26, 0xe9, 0xc6, 0x4f, 0xc5, 0x10, 0x1e, 0x00, 0x40, 0xce, 0x53, 0x86,
-	0x5d, 0x12, 0xb6, 0x79, 0xc5, 0xd8, 0xcc, 0x6f, 0xe5, 0x6b, 0x4e, 0x3f, 0xb3, 0x0b, 0x75, 0x61,
-	0xad, 0x1f, 0x9d, 0xf0, 0xcb, 0xcc, 0xbe, 0xa5, 0x09, 0x86, 0x81, 0x0e, 0x2a, 0x48, 0x50, 0x0f,
-	0xdb, 0x1c, 0xee, 0x81, 0x92, 0xa0, 0xf6, 0x5a, 0x38, 0x49, 0x48, 0xc4, 0x2b, 0x4b, 0x9b, 0xf9,
-	0xad, 0x42, 0x6d, 0xb5, 0x9f, 0xd9, 0xc5, 0xfa, 0x27, 0xcf, 0x0f, 0xb5, 0x19, 0x15, 0x49, 0x37,
-	0x1e, 0x00, 0xf8, 0x67, 0xb0, 0x82, 0x3d, 0x8f, 0x70, 0xee, 0x7a, 0x34, 0x49, 0x19, 0x8d, 0x2a,
-	0xe6, 0x66, 0x6e, 0xab, 0xb8, 0x67, 0x6f, 0x4f, 0x6f, 0xc4, 0xf6, 0x81, 0x8c, 0x3b, 0x54, 0x61,
-	0xb5, 0xdb, 0xdf, 0x64, 0xf6, 0x5c, 0x3f, 0xb3, 0x97, 0x27, 0xcc, 0x68, 0x19, 0x8f, 0x43, 0xf8,
-	0x04, 0xdc, 0xc5, 0x5e, 0x1a, 0x76, 0x89, 0xcb, 0x53, 0x9c, 0x86, 0x9e, 0xdb, 0x66, 0xc4, 0xa3,
-	0x71, 0x3b, 0x8c, 0x08, 0xaf, 0x14, 0xc4, 0xfc, 0xd0, 0x1d, 0x15, 0xd0, 0x90, 0xfe, 0x93, 0x91,
-	0x1b, 0x3e, 0x04, 0xe5, 0x56, 0xc8, 0x53, 0xca, 0x7a, 0x2e, 0x27, 0xac, 0x4b, 0xdc, 0xd7, 0x61,
-	0xe2, 0xd3, 0xd7, 0x15, 0xb0, 0x99, 0xdb, 0x32, 0x10, 0xd4, 0xbe, 0x86, 0x70, 0xbd, 0x94, 0x9e,
-	0x27, 0xf7, 0xde, 0x7c, 0xf7, 0xf5, 0x83, 0xf5, 0xb1, 0xd3, 0x3d, 0x17, 0xe7, 0xab, 0xce, 0xe4,
-	0xd8, 0x30, 0xe7, 0xad, 0xfc, 0xb1, 0x61, 0xe6, 0x2d, 0xe3, 0xd8, 0x30, 0x17, 0xac, 0xc5, 0x63,
-	0xc3, 0x5c, 0xb4, 0x96, 0x9c, 0xbf, 0xe6, 0xc0, 0xe4, 0x1a, 0xe0, 0x01, 0x58, 0xf4, 0x18, 0xc1,
-	0x29, 0x91, 0x47, 0x57, 0xdc, 0xfb, 0xf1, 0xff, 0xd9, 0x8b, 0xd3, 0x5e, 0x9b, 0xd4, 0x0c, 0xb1,
-	0x1f, 0x48, 0x27, 0xc2, 0x5f, 0x01, 0xc3, 0xc3, 0x51, 0x54, 0x99, 0xff, 0xa1, 0x04, 0x32, 0xcd,
-	0xf9, 0x4f, 0x0e, 0xdc, 0xba, 0x12, 0x01, 0x3d, 0x50, 0xd4, 0x67, 0x95, 0xf6, 0xda, 0x6a, 0x72,
-	0x2b, 0x7b, 0xf7, 0xbf, 0x8f, 0x5b, 0x92, 0xfe, 0xa4, 0x9f, 0xd9, 0x60, 0x84, 0x2f, 0x33, 0x1b,
-	0xaa, 0x12, 0x1a, 0x23, 0x72, 0x10, 0xc0, 0xc3, 0x08, 0xe8, 0x81, 0xb5, 0xc9, 0x82, 0x70, 0xa3,
-	0x90, 0xa7, 0x95, 0x79, 0x59, 0x4b, 0x8f, 0xfa, 0x99, 0x3d, 0x39, 0xb1, 0x67, 0x21, 0x4f, 0x2f,
-	0x33, 0xbb, 0x3a, 0xc1, 0x3a, 0x9e, 0xe9, 0xa0, 0x5b, 0x78, 0x3a, 0xc1, 0xf9, 0xca, 0x02, 0xc5,
-	0xc3, 0x16, 0x0e, 0x93, 0x43, 0x9a, 0xbc, 0x0a, 0x03, 0xf8, 0x27, 0xb0, 0xda, 0xa2, 0x31, 0xe1,
-	0x29, 0xc1, 0xbe, 0xdb, 0x8c, 0xa8, 0x77, 0xa6, 0x9f, 0x9a, 0x47, 0xff, 0xce, 0xec, 0xdb, 0x6a,
-	0x81, 0xdc, 0x3f, 0xdb, 0x0e, 0xe9, 0x4e, 0x8c, 0xd3, 0xd6, 0xf6, 0x51, 0x22, 0x44, 0xd7, 0x95,
-	0xe8, 0x54, 0xa6, 0x83, 0x56, 0x86, 0x96, 0x9a, 0x30, 0xc0, 0x16, 0x58, 0xf1, 0x31, 0x75, 0x5f,
-	0x51, 0x76, 0xa6, 0xc9, 0xe7, 0x25, 0x79, 0xed, 0x7b, 0xc9, 0xfb, 0x99, 0x5d, 0x7a, 0x7a, 0xf0,
-	0xf1, 0x47, 0x94, 0x9d, 0x49, 0x8a, 0xcb, 0xcc, 0xbe, 0xad, 0xc4, 0x26, 0x89, 0x1c, 0x54, 0xf2,
-	0x31, 0x1d, 0x86, 0xc1, 0x97, 0xc0, 0x1a, 0x06, 0xf0, 0x4e, 0xbb, 0x4d, 0x59, 0x5a, 0xc9, 0x6f,
-	0xe6, 0xb6, 0xcc, 0xda, 0xcf, 0xfa, 0x99, 0xbd, 0xa2, 0x29, 0x1b, 0xca, 0x73, 0x99, 0xd9, 0x77,
-	0xa6, 0x48, 0x75, 0x8e, 0x83, 0x56, 0x34, 0xad, 0x0e, 0x85, 0x4d, 0x50, 0x22, 0x61, 0x7b, 0x77,
-	0xff, 0xa1, 0x5e, 0x80, 0x21, 0x17, 0xf0, 0x9b, 0x59, 0x0b, 0x28, 0xd6, 0x8f, 0x4e, 0x76, 0xf7,
-	0x1f, 0x0e, 0xe6, 0xbf, 0xa6, 0x5f, 0x1d, 0x63, 0x2c, 0x0e, 0x2a, 0x2a, 0xa8, 0x26, 0x3f, 0xd0,
-	0xd8, 0xd7, 0x1a, 0x8b, 0x37, 0xd5, 0xd8, 0xbf, 0x4e, 0x63, 0x7f, 0x52, 0x63, 0x7f, 0x52, 0xe3,
-	0xb1, 0xd6, 0x58, 0xba, 0xa9, 0xc6, 0xe3, 0xeb, 0x34, 0x1e, 0x4f, 0x6a, 0xa8, 0x18, 0x51, 0x4c,
-	0xcd, 0xde, 0x5f, 0x70, 0x92, 0x86, 0x9d, 0x58, 0xcb, 0x98, 0x37, 0x2e, 0xa6, 0xa9, 0x4c, 0x07,
-	0xad, 0x0c, 0x2d, 0x8a, 0xfd, 0x0c, 0x94, 0x3d, 0x9a, 0xf0, 0x54, 0xd8, 0x12, 0xda, 0x8e, 0x88,
-	0x96, 0x28, 0x48, 0x89, 0xc7, 0xb3, 0x24, 0xee, 0x29, 0x89, 0xeb, 0xd2, 0x1d, 0xb4, 0x36, 0x69,
-	0x56, 0x62, 0x2e, 0xb0, 0xda, 0x24, 0x25, 0x8c, 0x37, 0x3b, 0x2c, 0xd0, 0x42, 0x40, 0x0a, 0x7d,
-	0x30, 0x4b, 0x48, 0x97, 0xd5, 0x74, 0xaa, 0x83, 0x56, 0x47, 0x26, 0x25, 0xf0, 0x29, 0x58, 0x09,
-	0x85, 0x6a, 0xb3, 0x13, 0x69, 0xfa, 0xa2, 0xa4, 0xdf, 0x9b, 0x45, 0xaf, 0x1f, 0x85, 0xc9, 0x44,
-	0x07, 0x2d, 0x0f, 0x0c, 0x8a, 0xda, 0x07, 0x30, 0xee, 0x84, 0xcc, 0x0d, 0x22, 0xec, 0x85, 0x84,
-	0x69, 0xfa, 0x92, 0xa4, 0xff, 0xf9, 0x2c, 0xfa, 0xbb, 0x8a, 0xfe, 0x6a, 0xb2, 0x83, 0x2c, 0x61,
-	0xfc, 0xad, 0xb2, 0x29, 0x95, 0x06, 0x28, 0x35, 0x09, 0x8b, 0xc2, 0x44, 0xf3, 0x2f, 0x4b, 0xfe,
-	0x87, 0xb3, 0xf8, 0x75, 0x05, 0x8d, 0xa7, 0x39, 0xa8, 0xa8, 0xe0, 0x90, 0x34, 0xa2, 0x89, 0x4f,
-	0x07, 0xa4, 0xb7, 0x6e, 0x4c, 0x3a, 0x9e, 0xe6, 0xa0, 0xa2, 0x82, 0x8a, 0x34, 0x00, 0x6b, 0x98,
-	0x31, 0xfa, 0x7a, 0x6a, 0x43, 0xa0, 0xe4, 0xfe, 0xc5, 0x2c, 0xee, 0xc1, 0xcb, 0xf5, 0x6a, 0xb6,
-	0x78, 0xb9, 0x0a, 0xeb, 0xc4, 0x96, 0xf8, 0x00, 0x06, 0x0c, 0xf7, 0xa6, 0x74, 0xca, 0x37, 0xde,
-	0xf8, 0xab, 0xc9, 0x0e, 0xb2, 0x84, 0x71, 0x42, 0xe5, 0x33, 0x50, 0x8e, 0x09, 0x0b, 0x88, 0x9b,
-	0x90, 0x94, 0xb7, 0xa3, 0x30, 0xd5, 0x3a, 0xb7, 0x6f, 0xfc, 0x1c, 0x5c, 0x97, 0xee, 0x20, 0x28,
-	0xcd, 0x2f, 0xb4, 0x55, 0x69, 0xdd, 0x05, 0xa6, 0x27, 0x6e, 0x0b, 0x37, 0xf4, 0x2b, 0x15, 0x79,
-	0xfb, 0x2f, 0x49, 0x7c, 0xe4, 0xc3, 0x32, 0x58, 0x50, 0x5d, 0xd6, 0x5d, 0xa1, 0x8b, 0x14, 0x80,
-	0x55, 0x60, 0xfa, 0xc4, 0x0b, 0x63, 0x1c, 0xf1, 0x4a, 0x55, 0x26, 0x0c, 0x31, 0xfc, 0x04, 0x2c,
-	0xf3, 0x16, 0x4e, 0x82, 0x16, 0x0e, 0xdd, 0x34, 0x8c, 0x49, 0xe5, 0x9e, 0x9c, 0xf1, 0xee, 0xac,
-	0x19, 0x97, 0xd5, 0x8c, 0x27, 0xf2, 0x1c, 0x54, 0x1a, 0xe0, 0xd3, 0x30, 0x26, 0xf0, 0x04, 0x14,
-	0x3d, 0x9c, 0x78, 0x9d, 0x44, 0xb1, 0xde, 0x97, 0xac, 0x3b, 0xb3, 0x58, 0xf5, 0x55, 0x3c, 0x96,
-	0xe5, 0x20, 0xa0, 0xd0, 0x80, 0xb1, 0xcd, 0x70, 0xd0, 0x21, 0x8a, 0xf1, 0xbd, 0x1b, 0x33, 0x8e,
-	0x65, 0x39, 0x08, 0x28, 0x34, 0x60, 0xec, 0x12, 0x76, 0x16, 0x69, 0xc6, 0x8d, 0x1b, 0x33, 0x8e,
-	0x65, 0x39, 0x08, 0x28, 0x24, 0x19, 0x9f, 0x03, 0x40, 0x39, 0x3e, 0xc3, 0x8a, 0xd0, 0x96, 0x84,
-	0xdb, 0xb3, 0x08, 0x75, 0x0b, 0x3b, 0x4a, 0x72, 0x50, 0x41, 0x02, 0x41, 0x37, 0x6c, 0xcc, 0xd6,
-	0xad, 0x3b, 0xc7, 0x86, 0x79, 0xc7, 0xaa, 0x38, 0x3b, 0x60, 0x41, 0xb4, 0x86, 0x04, 0x5a, 0x20,
-	0x7f, 0x46, 0x7a, 0xaa, 0x2f, 0x40, 0x62, 0x28, 0xce, 0xbe, 0x8b, 0xa3, 0x0e, 0x51, 0xd7, 0x39,
-	0x52, 0xc0, 0x39, 0x01, 0xab, 0xa7, 0x0c, 0x27, 0x5c, 0xb4, 0x95, 0x34, 0x79, 0x46, 0x03, 0x0e,
-	0x21, 0x30, 0x5a, 0x98, 0xb7, 0x74, 0xae, 0x1c, 0xc3, 0x9f, 0x02, 0x23, 0xa2, 0x01, 0x97, 0x8d,
-	0x4d, 0x71, 0xef, 0xf6, 0xd5, 0x2e, 0xea, 0x19, 0x0d, 0x90, 0x0c, 0x71, 0xfe, 0x39, 0x0f, 0xf2,
-	0xcf, 0x68, 0x00, 0x2b, 0x60, 0x09, 0xfb, 0x3e, 0x23, 0x9c, 0x6b, 0xa6, 0x01, 0x84, 0xeb, 0x60,
-	0x31, 0xa5, 0xed, 0xd0, 0x53, 0x74, 0x05, 0xa4, 0x91, 0x10, 0xf6, 0x71, 0x8a, 0x65, 0x0f, 0x50,
-	0x42, 0x72, 0x2c, 0xba, 0x74, 0x59, 0xea, 0x6e, 0xd2, 0x89, 0x9b, 0x84, 0xc9, 0xab, 0xdc, 0xa8,
-	0xad, 0x5e, 0x64, 0x76, 0x51, 0xda, 0x5f, 0x48, 0x33, 0x1a, 0x07, 0xf0, 0x7d, 0xb0, 0x94, 0x9e,
-	0xbb, 0x72, 0x0d, 0x0b, 0x72, 0x8b, 0xd7, 0x2e, 0x32, 0x7b, 0x35, 0x1d, 0x2d, 0xf3, 0x77, 0x98,
-	0xb7, 0xd0, 0x62, 0x7a, 0x2e, 0xfe, 0x87, 0x3b, 0xc0, 0x4c, 0xcf, 0xdd, 0x30, 0xf1, 0xc9, 0xb9,
-	0xbc, 0xc4, 0x8d, 0x5a, 0xf9, 0x22, 0xb3, 0xad, 0xb1, 0xf0, 0x23, 0xe1, 0x43, 0x4b, 0xe9, 0xb9,
-	0x1c, 0xc0, 0xf7, 0x01, 0x50, 0x53, 0x92, 0x0a, 0xea, 0x4e, 0x5e, 0xbe, 0xc8, 0xec, 0x82, 0xb4,
-	0x4a, 0xee, 0xd1, 0x10, 0x3a, 0x60, 0x41, 0x71, 0x9b, 0x92, 0xbb, 0x74, 0x91, 0xd9, 0x66, 0x44,
-	0x03, 0xc5, 0xa9, 0x5c, 0x62, 0xab, 0x18, 0x89, 0x69, 0x97, 0xf8, 0xf2, 0x62, 0x34, 0xd1, 0x00,
-	0x3a, 0x5f, 0xcc, 0x03, 0xf3, 0xf4, 0x1c, 0x11, 0xde, 0x89, 0x52, 0xf8, 0x11, 0xb0, 0x64, 0xaf,
-	0x88, 0xbd, 0xd4, 0x9d, 0xd8, 0xda, 0xda, 0xbd, 0xd1, 0x35, 0x36, 0x1d, 0xe1, 0xa0, 0xd5, 0x81,
-	0xe9, 0x40, 0xef, 0x7f, 0x19, 0x2c, 0x34, 0x23, 0x4a, 0x63, 0x59, 0x09, 0x25, 0xa4, 0x00, 0x7c,
-	0x29, 0x77, 0x4d, 0x9e, 0x72, 0x5e, 0xf6, 0xe1, 0x3f, 0xba, 0x7a, 0xca, 0x53, 0xa5, 0x52, 0xbb,
-	0x27, 0xba, 0xf0, 0xcb, 0xcc, 0x5e, 0x51, 0xda, 0x3a, 0xdf, 0xf9, 0xea, 0xbb, 0xaf, 0x1f, 0xe4,
-	0xc4, 0x06, 0xcb, 0x7a, 0xb2, 0x40, 0x9e, 0x91, 0x54, 0x9e, 0x5c, 0x09, 0x89, 0xa1, 0x78, 0xe1,
-	0x30, 0xd2, 0x25, 0x2c, 0x25, 0xbe, 0x3c, 0x21, 0x13, 0x0d, 0xb1, 0x78, 0x7b, 0x05, 0x98, 0xbb,
-	0x1d, 0x4e, 0x7c, 0x75, 0x1c, 0x68, 0x29, 0xc0, 0xfc, 0x0f, 0x9c, 0xf8, 0x4f, 0x8c, 0xcf, 0xbf,
-	0xb4, 0xe7, 0x1c, 0x0c, 0x8a, 0xba, 0x45, 0xef, 0xb4, 0x23, 0x32, 0xa3, 0xcc, 0xf6, 0x40, 0x49,
-	0x7c, 0xf3, 0xe0, 0x80, 0xb8, 0x67, 0xa4, 0xa7, 0x8b, 0x4d, 0x95, 0x8e, 0xb6, 0xff, 0x9e, 0xf4,
-	0x38, 0x1a, 0x07, 0x5a, 0xe2, 0x4b, 0x03, 0x14, 0x4f, 0x19, 0xf6, 0x88, 0x6e, 0xb8, 0x45, 0xc1,
-	0x0a, 0xc8, 0xb4, 0x84, 0x46, 0x42, 0x5b, 0x3c, 0x93, 0xb4, 0x93, 0xea, 0x87, 0x6a, 0x00, 0x45,
-	0x06, 0x23, 0xe4, 0x9c, 0x78, 0x72, 0x2f, 0x0d, 0xa4, 0x11, 0xdc, 0x07, 0xcb, 0x7e, 0xc8, 0x71,
-	0x33, 0x92, 0x9f, 0x78, 0xde, 0x99, 0x5a, 0x7e, 0xcd, 0xba, 0xc8, 0xec, 0x92, 0x76, 0x34, 0x84,
-	0x1d, 0x4d, 0x20, 0xf8, 0x21, 0x58, 0x1d, 0xa5, 0xc9, 0xd9, 0xca, 0xbd, 0x31, 0x6b, 0xf0, 0x22,
-	0xb3, 0x57, 0x86, 0xa1, 0xd2, 0x83, 0xa6, 0xb0, 0x7a, 0xe9, 0x37, 0x3b, 0x81, 0xac, 0x40, 0x13,
-	0x29, 0x20, 0xac, 0x51, 0x18, 0x87, 0xa9, 0xac, 0xb8, 0x05, 0xa4, 0x00, 0xfc, 0x10, 0x14, 0x68,
-	0x97, 0x30, 0x16, 0xfa, 0x84, 0xcb, 0xde, 0xa9, 0xb8, 0xf7, 0xde, 0xd5, 0x32, 0x18, 0xfb, 0x18,
-	0x41, 0xa3, 0x78, 0xb1, 0x38, 0x92, 0xc8, 0x49, 0xc6, 0x24, 0xa6, 0xac, 0x27, 0xbb, 0x23, 0xbd,
-	0x38, 0xe5, 0x78, 0x2e, 0xed, 0x68, 0x02, 0xc1, 0x1a, 0x80, 0x3a, 0x8d, 0x91, 0xb4, 0xc3, 0x12,
-	0x57, 0xbe, 0x04, 0x4a, 0x32, 0x57, 0x3e, 0x8a, 0xca, 0x8b, 0xa4, 0xf3, 0x29, 0x4e, 0x31, 0xba,
-	0x62, 0x81, 0xbf, 0x06, 0x50, 0x9d, 0x89, 0xfb, 0x19, 0xa7, 0x89, 0xf8, 0xa4, 0x7a, 0x15, 0x06,
-	0xba, 0xbd, 0x91, 0xfa, 0xca, 0xab, 0xe7, 0x6c, 0x29, 0x74, 0xcc, 0xa9, 0x5e, 0xc5, 0xb1, 0x61,
-	0x1a, 0xd6, 0xc2, 0xb1, 0x61, 0x2e, 0x59, 0xe6, 0x70, 0xff, 0xf4, 0x2a, 0xd0, 0xda, 0x00, 0x8f,
-	0x4d, 0xcf, 0x79, 0x01, 0xc0, 0x09, 0x23, 0xa1, 0x68, 0x42, 0xa3, 0x48, 0xbc, 0xb9, 0x12, 0x1c,
-	0x93, 0xc1, 0x2b, 0x53, 0x8c, 0xc7, 0x0b, 0x73, 0x7e, 0xb2, 0x30, 0x21, 0x30, 0x3c, 0xea, 0x13,
-	0x59, 0x1a, 0x05, 0x24, 0xc7, 0x0f, 0xfe, 0x91, 0x03, 0x63, 0x5f, 0x9e, 0xf0, 0x97, 0xa0, 0x7a,
-	0x70, 0x78, 0x58, 0x6f, 0x34, 0xdc, 0xd3, 0x4f, 0x4f, 0xea, 0xee, 0x49, 0x1d, 0x3d, 0x3f, 0x6a,
-	0x34, 0x8e, 0x3e, 0x7e, 0xf1, 0xac, 0xde, 0x68, 0x58, 0x73, 0xd5, 0xfb, 0x6f, 0xde, 0x6e, 0x56,
-	0x46, 0xf1, 0x27, 0x84, 0xc5, 0x21, 0xe7, 0x21, 0x4d, 0x22, 0x21, 0xf0, 0x01, 0x58, 0x1f, 0xcf,
-	0x46, 0xf5, 0xc6, 0x29, 0x3a, 0x3a, 0x3c, 0xad, 0x3f, 0xb5, 0x72, 0xd5, 0xca, 0x9b, 0xb7, 0x9b,
-	0xe5, 0x51, 0x26, 0x22, 0x3c, 0x65, 0xa1, 0x27, 0x9e, 0xbc, 0xc7, 0xa0, 0x72, 0xbd, 0x66, 0xfd,
-	0xa9, 0x35, 0x5f, 0xad, 0xbe, 0x79, 0xbb, 0xb9, 0x7e, 0x9d, 0x22, 0xf1, 0xab, 0xc6, 0xe7, 0x7f,
-	0xdb, 0x98, 0xab, 0x3d, 0xf9, 0xa6, 0xbf, 0x91, 0xfb, 0xb6, 0xbf, 0x91, 0xfb, 0x6f, 0x7f, 0x23,
-	0xf7, 0xc5, 0xbb, 0x8d, 0xb9, 0x6f, 0xdf, 0x6d, 0xcc, 0xfd, 0xeb, 0xdd, 0xc6, 0xdc, 0x1f, 0x37,
-	0x83, 0x30, 0x6d, 0x75, 0x9a, 0xdb, 0x1e, 0x8d, 0x77, 0xa6, 0x7f, 0x6f, 0x10, 0xdf, 0xd4, 0xbc,
-	0xb9, 0x28, 0x7f, 0x14, 0x7a, 0xf4, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe4, 0x51, 0x6f, 0x71,
-	0x6d, 0x12, 0x00, 0x00,
+	0x12, 0xd4, 0x3c, 0xca, 0x63, 0xca, 0xb7, 0x49, 0x2f, 0xde, 0x16, 0x7f, 0x3b, 0x62, 0xb4, 0xd5,
+	0x61, 0x34, 0xa5, 0xd0, 0x52, 0xbe, 0x2d, 0x61, 0x11, 0x7f, 0x3b, 0xb5, 0x5b, 0x38, 0x0e, 0x13,
+	0xba, 0x2d, 0xff, 0x55, 0x41, 0xb5, 0x4a, 0x40, 0x03, 0x2a, 0x87, 0xdb, 0x62, 0xa4, 0xac, 0xce,
+	0xdf, 0xf3, 0x60, 0xf1, 0x18, 0x33, 0x1c, 0x73, 0xb8, 0x03, 0x0a, 0xa4, 0x17, 0xbb, 0x3e, 0x49,
+	0x68, 0x5c, 0xcd, 0x6d, 0xe4, 0x36, 0x0b, 0xf5, 0xca, 0x65, 0x66, 0x5b, 0xe7, 0x38, 0x8e, 0x9e,
+	0x38, 0x43, 0x97, 0x83, 0x4c, 0xd2, 0x8b, 0x9f, 0x8a, 0x21, 0xdc, 0x07, 0x80, 0x9c, 0xa5, 0x0c,
+	0xbb, 0x24, 0xec, 0xf0, 0xaa, 0xb1, 0x91, 0xdf, 0xcc, 0xd7, 0x9d, 0x7e, 0x66, 0x17, 0x1a, 0xc2,
+	0xda, 0x38, 0x3c, 0xe6, 0x97, 0x99, 0x7d, 0x4b, 0x13, 0x0c, 0x03, 0x1d, 0x54, 0x90, 0xa0, 0x11,
+	0x76, 0x38, 0xdc, 0x05, 0x25, 0x41, 0xed, 0xb5, 0x71, 0x92, 0x90, 0x88, 0x57, 0x97, 0x36, 0xf2,
+	0x9b, 0x85, 0xfa, 0x4a, 0x3f, 0xb3, 0x8b, 0x8d, 0x4f, 0x9f, 0x1f, 0x68, 0x33, 0x2a, 0x92, 0x5e,
+	0x3c, 0x00, 0xf0, 0x4f, 0xa0, 0x8c, 0x3d, 0x8f, 0x70, 0xee, 0x7a, 0x34, 0x49, 0x19, 0x8d, 0xaa,
+	0xe6, 0x46, 0x6e, 0xb3, 0xb8, 0x6b, 0x6f, 0x4d, 0x6f, 0xc4, 0xd6, 0xbe, 0x8c, 0x3b, 0x50, 0x61,
+	0xf5, 0xdb, 0xdf, 0x64, 0xf6, 0x5c, 0x3f, 0xb3, 0x97, 0x27, 0xcc, 0x68, 0x19, 0x8f, 0x43, 0xf8,
+	0x04, 0xdc, 0xc5, 0x5e, 0x1a, 0xf6, 0x88, 0xcb, 0x53, 0x9c, 0x86, 0x9e, 0xdb, 0x61, 0xc4, 0xa3,
+	0x71, 0x27, 0x8c, 0x08, 0xaf, 0x16, 0xc4, 0xfc, 0xd0, 0x1d, 0x15, 0xd0, 0x94, 0xfe, 0xe3, 0x91,
+	0x1b, 0x3e, 0x04, 0x95, 0x76, 0xc8, 0x53, 0xca, 0xce, 0x5d, 0x4e, 0x58, 0x8f, 0xb8, 0xaf, 0xc3,
+	0xc4, 0xa7, 0xaf, 0xab, 0x60, 0x23, 0xb7, 0x69, 0x20, 0xa8, 0x7d, 0x4d, 0xe1, 0x7a, 0x29, 0x3d,
+	0x4f, 0xee, 0xbd, 0xf9, 0xee, 0xeb, 0x07, 0x6b, 0x63, 0xa7, 0x7b, 0x26, 0xce, 0x57, 0x9d, 0xc9,
+	0x91, 0x61, 0xce, 0x5b, 0xf9, 0x23, 0xc3, 0xcc, 0x5b, 0xc6, 0x91, 0x61, 0x2e, 0x58, 0x8b, 0x47,
+	0x86, 0xb9, 0x68, 0x2d, 0x39, 0x7f, 0xc9, 0x81, 0xc9, 0x35, 0xc0, 0x7d, 0xb0, 0xe8, 0x31, 0x82,
+	0x53, 0x22, 0x8f, 0xae, 0xb8, 0xfb, 0xe3, 0xff, 0xb3, 0x17, 0x27, 0xe7, 0x1d, 0x52, 0x37, 0xc4,
+	0x7e, 0x20, 0x9d, 0x08, 0x7f, 0x05, 0x0c, 0x0f, 0x47, 0x51, 0x75, 0xfe, 0x87, 0x12, 0xc8, 0x34,
+	0xe7, 0x3f, 0x39, 0x70, 0xeb, 0x4a, 0x04, 0xf4, 0x40, 0x51, 0x9f, 0x55, 0x7a, 0xde, 0x51, 0x93,
+	0x2b, 0xef, 0xde, 0xff, 0x3e, 0x6e, 0x49, 0xfa, 0x93, 0x7e, 0x66, 0x83, 0x11, 0xbe, 0xcc, 0x6c,
+	0xa8, 0x4a, 0x68, 0x8c, 0xc8, 0x41, 0x00, 0x0f, 0x23, 0xa0, 0x07, 0x56, 0x27, 0x0b, 0xc2, 0x8d,
+	0x42, 0x9e, 0x56, 0xe7, 0x65, 0x2d, 0x3d, 0xea, 0x67, 0xf6, 0xe4, 0xc4, 0x9e, 0x85, 0x3c, 0xbd,
+	0xcc, 0xec, 0xda, 0x04, 0xeb, 0x78, 0xa6, 0x83, 0x6e, 0xe1, 0xe9, 0x04, 0xe7, 0x2b, 0x0b, 0x14,
+	0x0f, 0xda, 0x38, 0x4c, 0x0e, 0x68, 0xf2, 0x2a, 0x0c, 0xe0, 0x1f, 0xc1, 0x4a, 0x9b, 0xc6, 0x84,
+	0xa7, 0x04, 0xfb, 0x6e, 0x2b, 0xa2, 0xde, 0xa9, 0x7e, 0x6a, 0x1e, 0xfd, 0x3b, 0xb3, 0x6f, 0xab,
+	0x05, 0x72, 0xff, 0x74, 0x2b, 0xa4, 0xdb, 0x31, 0x4e, 0xdb, 0x5b, 0x87, 0x89, 0x10, 0x5d, 0x53,
+	0xa2, 0x53, 0x99, 0x0e, 0x2a, 0x0f, 0x2d, 0x75, 0x61, 0x80, 0x6d, 0x50, 0xf6, 0x31, 0x75, 0x5f,
+	0x51, 0x76, 0xaa, 0xc9, 0xe7, 0x25, 0x79, 0xfd, 0x7b, 0xc9, 0xfb, 0x99, 0x5d, 0x7a, 0xba, 0xff,
+	0xc9, 0x47, 0x94, 0x9d, 0x4a, 0x8a, 0xcb, 0xcc, 0xbe, 0xad, 0xc4, 0x26, 0x89, 0x1c, 0x54, 0xf2,
+	0x31, 0x1d, 0x86, 0xc1, 0x97, 0xc0, 0x1a, 0x06, 0xf0, 0x6e, 0xa7, 0x43, 0x59, 0x5a, 0xcd, 0x6f,
+	0xe4, 0x36, 0xcd, 0xfa, 0xcf, 0xfa, 0x99, 0x5d, 0xd6, 0x94, 0x4d, 0xe5, 0xb9, 0xcc, 0xec, 0x3b,
+	0x53, 0xa4, 0x3a, 0xc7, 0x41, 0x65, 0x4d, 0xab, 0x43, 0x61, 0x0b, 0x94, 0x48, 0xd8, 0xd9, 0xd9,
+	0x7b, 0xa8, 0x17, 0x60, 0xc8, 0x05, 0xfc, 0x66, 0xd6, 0x02, 0x8a, 0x8d, 0xc3, 0xe3, 0x9d, 0xbd,
+	0x87, 0x83, 0xf9, 0xaf, 0x2a, 0xa9, 0x71, 0x16, 0x07, 0x15, 0x15, 0x54, 0x93, 0x1f, 0x68, 0xec,
+	0x69, 0x8d, 0xc5, 0x9b, 0x6a, 0xec, 0x5d, 0xa7, 0xb1, 0x37, 0xa9, 0xb1, 0x37, 0xa9, 0xf1, 0x58,
+	0x6b, 0x2c, 0xdd, 0x54, 0xe3, 0xf1, 0x75, 0x1a, 0x8f, 0x27, 0x35, 0x54, 0x8c, 0x28, 0xa6, 0xd6,
+	0xf9, 0x9f, 0x71, 0x92, 0x86, 0xdd, 0x58, 0xcb, 0x98, 0x37, 0x2e, 0xa6, 0xa9, 0x4c, 0x07, 0x95,
+	0x87, 0x16, 0xc5, 0x7e, 0x0a, 0x2a, 0x1e, 0x4d, 0x78, 0x2a, 0x6c, 0x09, 0xed, 0x44, 0x44, 0x4b,
+	0x14, 0xa4, 0xc4, 0xe3, 0x59, 0x12, 0xf7, 0x94, 0xc4, 0x75, 0xe9, 0x0e, 0x5a, 0x9d, 0x34, 0x2b,
+	0x31, 0x17, 0x58, 0x1d, 0x92, 0x12, 0xc6, 0x5b, 0x5d, 0x16, 0x68, 0x21, 0x20, 0x85, 0x3e, 0x98,
+	0x25, 0xa4, 0xcb, 0x6a, 0x3a, 0xd5, 0x41, 0x2b, 0x23, 0x93, 0x12, 0xf8, 0x0c, 0x94, 0x43, 0xa1,
+	0xda, 0xea, 0x46, 0x9a, 0xbe, 0x28, 0xe9, 0x77, 0x67, 0xd1, 0xeb, 0x47, 0x61, 0x32, 0xd1, 0x41,
+	0xcb, 0x03, 0x83, 0xa2, 0xf6, 0x01, 0x8c, 0xbb, 0x21, 0x73, 0x83, 0x08, 0x7b, 0x21, 0x61, 0x9a,
+	0xbe, 0x24, 0xe9, 0x7f, 0x3e, 0x8b, 0xfe, 0xae, 0xa2, 0xbf, 0x9a, 0xec, 0x20, 0x4b, 0x18, 0x7f,
+	0xab, 0x6c, 0x4a, 0xa5, 0x09, 0x4a, 0x2d, 0xc2, 0xa2, 0x30, 0xd1, 0xfc, 0xcb, 0x92, 0xff, 0xe1,
+	0x2c, 0x7e, 0x5d, 0x41, 0xe3, 0x69, 0x0e, 0x2a, 0x2a, 0x38, 0x24, 0x8d, 0x68, 0xe2, 0xd3, 0x01,
+	0xe9, 0xad, 0x1b, 0x93, 0x8e, 0xa7, 0x39, 0xa8, 0xa8, 0xa0, 0x22, 0x0d, 0xc0, 0x2a, 0x66, 0x8c,
+	0xbe, 0x9e, 0xda, 0x10, 0x28, 0xb9, 0x7f, 0x31, 0x8b, 0x7b, 0xf0, 0x72, 0xbd, 0x9a, 0x2d, 0x5e,
+	0xae, 0xc2, 0x3a, 0xb1, 0x25, 0x3e, 0x80, 0x01, 0xc3, 0xe7, 0x53, 0x3a, 0x95, 0x1b, 0x6f, 0xfc,
+	0xd5, 0x64, 0x07, 0x59, 0xc2, 0x38, 0xa1, 0xf2, 0x39, 0xa8, 0xc4, 0x84, 0x05, 0xc4, 0x4d, 0x48,
+	0xca, 0x3b, 0x51, 0x98, 0x6a, 0x9d, 0xdb, 0x37, 0x7e, 0x0e, 0xae, 0x4b, 0x77, 0x10, 0x94, 0xe6,
+	0x17, 0xda, 0xaa, 0xb4, 0xee, 0x02, 0xd3, 0x13, 0xb7, 0x85, 0x1b, 0xfa, 0xd5, 0xaa, 0xbc, 0xfd,
+	0x97, 0x24, 0x3e, 0xf4, 0x61, 0x05, 0x2c, 0xa8, 0x2e, 0xeb, 0xae, 0xd0, 0x45, 0x0a, 0xc0, 0x1a,
+	0x30, 0x7d, 0xe2, 0x85, 0x31, 0x8e, 0x78, 0xb5, 0x26, 0x13, 0x86, 0x18, 0x7e, 0x0a, 0x96, 0x79,
+	0x1b, 0x27, 0x41, 0x1b, 0x87, 0x6e, 0x1a, 0xc6, 0xa4, 0x7a, 0x4f, 0xce, 0x78, 0x67, 0xd6, 0x8c,
+	0x2b, 0x6a, 0xc6, 0x13, 0x79, 0x0e, 0x2a, 0x0d, 0xf0, 0x49, 0x18, 0x13, 0x78, 0x0c, 0x8a, 0x1e,
+	0x4e, 0xbc, 0x6e, 0xa2, 0x58, 0xef, 0x4b, 0xd6, 0xed, 0x59, 0xac, 0xfa, 0x2a, 0x1e, 0xcb, 0x72,
+	0x10, 0x50, 0x68, 0xc0, 0xd8, 0x61, 0x38, 0xe8, 0x12, 0xc5, 0xf8, 0xde, 0x8d, 0x19, 0xc7, 0xb2,
+	0x1c, 0x04, 0x14, 0x1a, 0x30, 0xf6, 0x08, 0x3b, 0x8d, 0x34, 0xe3, 0xfa, 0x8d, 0x19, 0xc7, 0xb2,
+	0x1c, 0x04, 0x14, 0x92, 0x8c, 0xcf, 0x01, 0xa0, 0x1c, 0x9f, 0x62, 0x45, 0x68, 0x4b, 0xc2, 0xad,
+	0x59, 0x84, 0xba, 0x85, 0x1d, 0x25, 0x39, 0xa8, 0x20, 0x81, 0xa0, 0x1b, 0x36, 0x66, 0x6b, 0xd6,
+	0x9d, 0x23, 0xc3, 0xbc, 0x63, 0x55, 0x9d, 0x6d, 0xb0, 0x20, 0x5a, 0x43, 0x02, 0x2d, 0x90, 0x3f,
+	0x25, 0xe7, 0xaa, 0x2f, 0x40, 0x62, 0x28, 0xce, 0xbe, 0x87, 0xa3, 0x2e, 0x51, 0xd7, 0x39, 0x52,
+	0xc0, 0x39, 0x06, 0x2b, 0x27, 0x0c, 0x27, 0x5c, 0xb4, 0x95, 0x34, 0x79, 0x46, 0x03, 0x0e, 0x21,
+	0x30, 0xda, 0x98, 0xb7, 0x75, 0xae, 0x1c, 0xc3, 0x9f, 0x02, 0x23, 0xa2, 0x01, 0x97, 0x8d, 0x4d,
+	0x71, 0xf7, 0xf6, 0xd5, 0x2e, 0xea, 0x19, 0x0d, 0x90, 0x0c, 0x71, 0xfe, 0x39, 0x0f, 0xf2, 0xcf,
+	0x68, 0x00, 0xab, 0x60, 0x09, 0xfb, 0x3e, 0x23, 0x9c, 0x6b, 0xa6, 0x01, 0x84, 0x6b, 0x60, 0x31,
+	0xa5, 0x9d, 0xd0, 0x53, 0x74, 0x05, 0xa4, 0x91, 0x10, 0xf6, 0x71, 0x8a, 0x65, 0x0f, 0x50, 0x42,
+	0x72, 0x2c, 0xba, 0x74, 0x59, 0xea, 0x6e, 0xd2, 0x8d, 0x5b, 0x84, 0xc9, 0xab, 0xdc, 0xa8, 0xaf,
+	0x5c, 0x64, 0x76, 0x51, 0xda, 0x5f, 0x48, 0x33, 0x1a, 0x07, 0xf0, 0x7d, 0xb0, 0x94, 0x9e, 0xb9,
+	0x72, 0x0d, 0x0b, 0x72, 0x8b, 0x57, 0x2f, 0x32, 0x7b, 0x25, 0x1d, 0x2d, 0xf3, 0x63, 0xcc, 0xdb,
+	0x68, 0x31, 0x3d, 0x13, 0xff, 0xc3, 0x6d, 0x60, 0xa6, 0x67, 0x6e, 0x98, 0xf8, 0xe4, 0x4c, 0x5e,
+	0xe2, 0x46, 0xbd, 0x72, 0x91, 0xd9, 0xd6, 0x58, 0xf8, 0xa1, 0xf0, 0xa1, 0xa5, 0xf4, 0x4c, 0x0e,
+	0xe0, 0xfb, 0x00, 0xa8, 0x29, 0x49, 0x05, 0x75, 0x27, 0x2f, 0x5f, 0x64, 0x76, 0x41, 0x5a, 0x25,
+	0xf7, 0x68, 0x08, 0x1d, 0xb0, 0xa0, 0xb8, 0x4d, 0xc9, 0x5d, 0xba, 0xc8, 0x6c, 0x33, 0xa2, 0x81,
+	0xe2, 0x54, 0x2e, 0xb1, 0x55, 0x8c, 0xc4, 0xb4, 0x47, 0x7c, 0x79, 0x31, 0x9a, 0x68, 0x00, 0x9d,
+	0x2f, 0xe7, 0x81, 0x79, 0x72, 0x86, 0x08, 0xef, 0x46, 0x29, 0xfc, 0x08, 0x58, 0xb2, 0x57, 0xc4,
+	0x5e, 0xea, 0x4e, 0x6c, 0x6d, 0xfd, 0xde, 0xe8, 0x1a, 0x9b, 0x8e, 0x70, 0xd0, 0xca, 0xc0, 0xb4,
+	0xaf, 0xf7, 0xbf, 0x02, 0x16, 0x5a, 0x11, 0xa5, 0xb1, 0xac, 0x84, 0x12, 0x52, 0x00, 0xbe, 0x94,
+	0xbb, 0x26, 0x4f, 0x39, 0x2f, 0xfb, 0xf0, 0x1f, 0x5d, 0x3d, 0xe5, 0xa9, 0x52, 0xa9, 0xdf, 0x13,
+	0x5d, 0xf8, 0x65, 0x66, 0x97, 0x95, 0xb6, 0xce, 0x77, 0xbe, 0xfa, 0xee, 0xeb, 0x07, 0x39, 0xb1,
+	0xc1, 0xb2, 0x9e, 0x2c, 0x90, 0x67, 0x24, 0x95, 0x27, 0x57, 0x42, 0x62, 0x28, 0x5e, 0x38, 0x8c,
+	0xf4, 0x08, 0x4b, 0x89, 0x2f, 0x4f, 0xc8, 0x44, 0x43, 0x2c, 0xde, 0x5e, 0x01, 0xe6, 0x6e, 0x97,
+	0x13, 0x5f, 0x1d, 0x07, 0x5a, 0x0a, 0x30, 0xff, 0x3d, 0x27, 0xfe, 0x13, 0xe3, 0x8b, 0xbf, 0xda,
+	0x73, 0x0e, 0x06, 0x45, 0xdd, 0xa2, 0x77, 0x3b, 0x11, 0x99, 0x51, 0x66, 0xbb, 0xa0, 0x24, 0xbe,
+	0x79, 0x70, 0x40, 0xdc, 0x53, 0x72, 0xae, 0x8b, 0x4d, 0x95, 0x8e, 0xb6, 0xff, 0x8e, 0x9c, 0x73,
+	0x34, 0x0e, 0xb4, 0xc4, 0x85, 0x01, 0x8a, 0x27, 0x0c, 0x7b, 0x44, 0x37, 0xdc, 0xa2, 0x60, 0x05,
+	0x64, 0x5a, 0x42, 0x23, 0xa1, 0x2d, 0x9e, 0x49, 0xda, 0x4d, 0xf5, 0x43, 0x35, 0x80, 0x22, 0x83,
+	0x11, 0x72, 0x46, 0x3c, 0xb9, 0x97, 0x06, 0xd2, 0x08, 0xee, 0x81, 0x65, 0x3f, 0xe4, 0xb8, 0x15,
+	0xc9, 0x4f, 0x3c, 0xef, 0x54, 0x2d, 0xbf, 0x6e, 0x5d, 0x64, 0x76, 0x49, 0x3b, 0x9a, 0xc2, 0x8e,
+	0x26, 0x10, 0xfc, 0x10, 0xac, 0x8c, 0xd2, 0xe4, 0x6c, 0xe5, 0xde, 0x98, 0x75, 0x78, 0x91, 0xd9,
+	0xe5, 0x61, 0xa8, 0xf4, 0xa0, 0x29, 0xac, 0x5e, 0xfa, 0xad, 0x6e, 0x20, 0x2b, 0xd0, 0x44, 0x0a,
+	0x08, 0x6b, 0x14, 0xc6, 0x61, 0x2a, 0x2b, 0x6e, 0x01, 0x29, 0x00, 0x3f, 0x04, 0x05, 0xda, 0x23,
+	0x8c, 0x85, 0x3e, 0xe1, 0xb2, 0x77, 0x2a, 0xee, 0xbe, 0x77, 0xb5, 0x0c, 0xc6, 0x3e, 0x46, 0xd0,
+	0x28, 0x5e, 0x2c, 0x8e, 0x24, 0x72, 0x92, 0x31, 0x89, 0x29, 0x3b, 0xaf, 0x16, 0x47, 0x8b, 0x53,
+	0x8e, 0xe7, 0xd2, 0x8e, 0x26, 0x10, 0xac, 0x03, 0xa8, 0xd3, 0x18, 0x49, 0xbb, 0x2c, 0x71, 0xe5,
+	0x4b, 0xa0, 0x24, 0x73, 0xe5, 0xa3, 0xa8, 0xbc, 0x48, 0x3a, 0x9f, 0xe2, 0x14, 0xa3, 0x2b, 0x16,
+	0xf8, 0x6b, 0x00, 0xd5, 0x99, 0xb8, 0x9f, 0x73, 0x9a, 0x88, 0x4f, 0xaa, 0x57, 0x61, 0xa0, 0xdb,
+	0x1b, 0xa9, 0xaf, 0xbc, 0x7a, 0xce, 0x96, 0x42, 0x47, 0x9c, 0x0e, 0x3e, 0xa9, 0x3e, 0x06, 0x95,
+	0x30, 0xf1, 0xa2, 0xae, 0x4f, 0x5c, 0xb5, 0x5a, 0x57, 0x7c, 0x59, 0xf2, 0x6a, 0x59, 0xce, 0x62,
+	0xed, 0x22, 0xb3, 0xa1, 0xf6, 0x1f, 0x48, 0xf7, 0x81, 0xf0, 0xa2, 0x6b, 0x6c, 0x47, 0x86, 0x69,
+	0x58, 0x0b, 0x47, 0x86, 0xb9, 0x64, 0x99, 0xc3, 0x93, 0xd0, 0xfb, 0x81, 0x56, 0x07, 0x78, 0x6c,
+	0xa1, 0xce, 0x0b, 0x00, 0x8e, 0x19, 0x09, 0x45, 0x3b, 0x1b, 0x45, 0xe2, 0x1d, 0x98, 0xe0, 0x98,
+	0x0c, 0x5e, 0xbe, 0x62, 0x3c, 0x5e, 0xe2, 0xf3, 0x93, 0x25, 0x0e, 0x81, 0xe1, 0x51, 0x9f, 0xc8,
+	0x22, 0x2b, 0x20, 0x39, 0x7e, 0xf0, 0x8f, 0x1c, 0x18, 0xfb, 0x86, 0x85, 0xbf, 0x04, 0xb5, 0xfd,
+	0x83, 0x83, 0x46, 0xb3, 0xe9, 0x9e, 0x7c, 0x76, 0xdc, 0x70, 0x8f, 0x1b, 0xe8, 0xf9, 0x61, 0xb3,
+	0x79, 0xf8, 0xc9, 0x8b, 0x67, 0x8d, 0x66, 0xd3, 0x9a, 0xab, 0xdd, 0x7f, 0xf3, 0x76, 0xa3, 0x3a,
+	0x8a, 0x3f, 0x26, 0x2c, 0x0e, 0x39, 0x0f, 0x69, 0x12, 0x09, 0x81, 0x0f, 0xc0, 0xda, 0x78, 0x36,
+	0x6a, 0x34, 0x4f, 0xd0, 0xe1, 0xc1, 0x49, 0xe3, 0xa9, 0x95, 0xab, 0x55, 0xdf, 0xbc, 0xdd, 0xa8,
+	0x8c, 0x32, 0x11, 0xe1, 0x29, 0x0b, 0x3d, 0xf1, 0x0c, 0x3f, 0x06, 0xd5, 0xeb, 0x35, 0x1b, 0x4f,
+	0xad, 0xf9, 0x5a, 0xed, 0xcd, 0xdb, 0x8d, 0xb5, 0xeb, 0x14, 0x89, 0x5f, 0x33, 0xbe, 0xf8, 0xdb,
+	0xfa, 0x5c, 0xfd, 0xc9, 0x37, 0xfd, 0xf5, 0xdc, 0xb7, 0xfd, 0xf5, 0xdc, 0x7f, 0xfb, 0xeb, 0xb9,
+	0x2f, 0xdf, 0xad, 0xcf, 0x7d, 0xfb, 0x6e, 0x7d, 0xee, 0x5f, 0xef, 0xd6, 0xe7, 0xfe, 0xb0, 0x11,
+	0x84, 0x69, 0xbb, 0xdb, 0xda, 0xf2, 0x68, 0xbc, 0x3d, 0xfd, 0xcb, 0x85, 0xf8, 0x3a, 0xe7, 0xad,
+	0x45, 0xf9, 0xf3, 0xd2, 0xa3, 0xff, 0x0d, 0x00, 0x69, 0xf4, 0x80, 0x4b, 0xb7, 0x12, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1838,6 +1849,16 @@ func (m *TraceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IncludeCosmosCalls {
+		i--
+		if m.IncludeCosmosCalls {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
 	if len(m.TracerJsonConfig) > 0 {
 		i -= len(m.TracerJsonConfig)
 		copy(dAtA[i:], m.TracerJsonConfig)
@@ -2329,6 +2350,9 @@ func (m *TraceConfig) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvm(uint64(l))
 	}
+	if m.IncludeCosmosCalls {
+		n += 2
+	}
 	return n
 }
 
@@ -4864,6 +4888,26 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.TracerJsonConfig = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeCosmosCalls", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeCosmosCalls = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -65,6 +65,13 @@ type FeeMarketKeeper interface {
 	GetBaseFee(ctx sdk.Context) math.LegacyDec
 	GetParams(ctx sdk.Context) feemarkettypes.Params
 	CalculateBaseFee(ctx sdk.Context) math.LegacyDec
+	AddBurnedBaseFee(ctx sdk.Context, amount math.LegacyDec)
+}
+
+// CommunityPoolKeeper defines the expected interface needed to fund the
+// community pool with the base fee share of the base fee split.
+type CommunityPoolKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
 
 // Erc20Keeper defines the expected interface needed to instantiate ERC20 precompiles.
@@ -92,3 +99,10 @@ type BankWrapper interface {
 type ConsensusParamsKeeper interface {
 	Params(context.Context, *types.QueryParamsRequest) (*types.QueryParamsResponse, error)
 }
+
+// HeaderHashStore defines the expected interface of the node local archival
+// store of the block header hashes.
+type HeaderHashStore interface {
+	// GetHeaderHash returns the empty hash if the height is not found.
+	GetHeaderHash(height int64) (common.Hash, error)
+}
@@ -16,6 +16,11 @@ type FeeMarketKeeper struct {
 	mock.Mock
 }
 
+// AddBurnedBaseFee provides a mock function with given fields: ctx, amount
+func (_m *FeeMarketKeeper) AddBurnedBaseFee(ctx types.Context, amount math.LegacyDec) {
+	_m.Called(ctx, amount)
+}
+
 // CalculateBaseFee provides a mock function with given fields: ctx
 func (_m *FeeMarketKeeper) CalculateBaseFee(ctx types.Context) math.LegacyDec {
 	ret := _m.Called(ctx)
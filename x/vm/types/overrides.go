@@ -0,0 +1,120 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/holiman/uint256"
+)
+
+// OverrideStateDB defines the StateDB methods required to apply a StateOverride.
+type OverrideStateDB interface {
+	vm.StateDB
+	// SetBalance sets the balance of the account to the given amount.
+	SetBalance(addr common.Address, amount *uint256.Int)
+	// SetStorage replaces the entire storage of the account with the given one.
+	SetStorage(addr common.Address, storage map[common.Hash]common.Hash)
+}
+
+// StateOverride is the collection of overridden accounts.
+type StateOverride map[common.Address]OverrideAccount
+
+// OverrideAccount indicates the overriding fields of account during the execution of
+// a message call.
+// Note, state and stateDiff can't be specified at the same time. If state is
+// set, message execution will only use the data in the given state. Otherwise
+// if statDiff is set, all diff will be applied first and then execute the call
+// message.
+type OverrideAccount struct {
+	Nonce     *hexutil.Uint64              `json:"nonce"`
+	Code      *hexutil.Bytes               `json:"code"`
+	Balance   **hexutil.Big                `json:"balance"`
+	State     *map[common.Hash]common.Hash `json:"state"`
+	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+}
+
+// Validate performs a stateless validation of the state overrides.
+func (diff StateOverride) Validate() error {
+	for addr, account := range diff {
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+		if account.Balance != nil && *account.Balance != nil {
+			balance := (*account.Balance).ToInt()
+			if balance.Sign() < 0 {
+				return fmt.Errorf("account %s has a negative balance override", addr.Hex())
+			}
+			if _, overflow := uint256.FromBig(balance); overflow {
+				return fmt.Errorf("account %s balance override overflows uint256", addr.Hex())
+			}
+		}
+	}
+	return nil
+}
+
+// Apply overrides the fields of specified accounts into the given state.
+func (diff StateOverride) Apply(db OverrideStateDB) error {
+	if err := diff.Validate(); err != nil {
+		return err
+	}
+
+	for addr, account := range diff {
+		// Override account nonce.
+		if account.Nonce != nil {
+			db.SetNonce(addr, uint64(*account.Nonce), tracing.NonceChangeUnspecified)
+		}
+		// Override account(contract) code.
+		if account.Code != nil {
+			db.SetCode(addr, *account.Code)
+		}
+		// Override account balance.
+		if account.Balance != nil && *account.Balance != nil {
+			balance, _ := uint256.FromBig((*account.Balance).ToInt())
+			db.SetBalance(addr, balance)
+		}
+		// Replace entire state if caller requires.
+		if account.State != nil {
+			db.SetStorage(addr, *account.State)
+		}
+		// Apply state diff into specified accounts.
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				db.SetState(addr, key, value)
+			}
+		}
+	}
+	return nil
+}
+
+// BlockOverrides is a set of header fields to override during the execution
+// of a message call.
+type BlockOverrides struct {
+	Number       *hexutil.Big    `json:"number"`
+	Time         *hexutil.Uint64 `json:"time"`
+	GasLimit     *hexutil.Uint64 `json:"gasLimit"`
+	FeeRecipient *common.Address `json:"feeRecipient"`
+	BaseFee      *hexutil.Big    `json:"baseFeePerGas"`
+}
+
+// Validate performs a stateless validation of the block overrides.
+func (o *BlockOverrides) Validate() error {
+	if o == nil {
+		return nil
+	}
+	if o.Number != nil && (o.Number.ToInt().Sign() < 0 || !o.Number.ToInt().IsInt64()) {
+		return fmt.Errorf("invalid block number override %s", o.Number)
+	}
+	if o.Time != nil && uint64(*o.Time) > uint64(1<<63-1) {
+		return fmt.Errorf("invalid block time override %d", uint64(*o.Time))
+	}
+	if o.GasLimit != nil && uint64(*o.GasLimit) > uint64(1<<63-1) {
+		return fmt.Errorf("invalid block gas limit override %d", uint64(*o.GasLimit))
+	}
+	if o.BaseFee != nil && o.BaseFee.ToInt().Sign() < 0 {
+		return fmt.Errorf("invalid base fee override %s", o.BaseFee)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateOverrideValidate(t *testing.T) {
+	addr := common.HexToAddress("0x1")
+	state := map[common.Hash]common.Hash{common.HexToHash("0x1"): common.HexToHash("0x2")}
+	negative := (*hexutil.Big)(big.NewInt(-1))
+	overflow := (*hexutil.Big)(new(big.Int).Lsh(big.NewInt(1), 256))
+	balance := (*hexutil.Big)(big.NewInt(100))
+
+	testCases := []struct {
+		name      string
+		overrides StateOverride
+		expPass   bool
+	}{
+		{
+			"empty overrides",
+			StateOverride{},
+			true,
+		},
+		{
+			"valid balance and state",
+			StateOverride{addr: {Balance: &balance, State: &state}},
+			true,
+		},
+		{
+			"both state and stateDiff",
+			StateOverride{addr: {State: &state, StateDiff: &state}},
+			false,
+		},
+		{
+			"negative balance",
+			StateOverride{addr: {Balance: &negative}},
+			false,
+		},
+		{
+			"balance overflows uint256",
+			StateOverride{addr: {Balance: &overflow}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.overrides.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestEthCallRequestUnmarshalOverrides(t *testing.T) {
+	testCases := []struct {
+		name           string
+		req            EthCallRequest
+		expPass        bool
+		expState       bool
+		expBlockNumber *big.Int
+	}{
+		{
+			"no overrides",
+			EthCallRequest{},
+			true,
+			false,
+			nil,
+		},
+		{
+			"state and block overrides",
+			EthCallRequest{
+				Overrides:      []byte(`{"0x0000000000000000000000000000000000000001":{"balance":"0x1","nonce":"0x2"}}`),
+				BlockOverrides: []byte(`{"number":"0x10","baseFeePerGas":"0x0"}`),
+			},
+			true,
+			true,
+			big.NewInt(16),
+		},
+		{
+			"invalid state overrides json",
+			EthCallRequest{Overrides: []byte(`[]`)},
+			false,
+			false,
+			nil,
+		},
+		{
+			"negative block number",
+			EthCallRequest{BlockOverrides: []byte(`{"number":"-0x1"}`)},
+			false,
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		stateOverrides, blockOverrides, err := tc.req.UnmarshalOverrides()
+		if !tc.expPass {
+			require.Error(t, err, tc.name)
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expState, len(stateOverrides) > 0, tc.name)
+		if tc.expBlockNumber == nil {
+			require.Nil(t, blockOverrides, tc.name)
+		} else {
+			require.Equal(t, tc.expBlockNumber, blockOverrides.Number.ToInt(), tc.name)
+		}
+	}
+}
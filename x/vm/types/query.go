@@ -1,6 +1,60 @@
 package types
 
+import (
+	"encoding/json"
+)
+
+const (
+	// MaxRangeQueryLimit is the maximum page size of the StorageRange and
+	// AccountRange queries.
+	MaxRangeQueryLimit = 256
+	// MaxDumpStorageEntries is the maximum number of storage entries returned
+	// for each account by the AccountRange query. The rest of the storage of an
+	// account can be paginated with the StorageRange query.
+	MaxDumpStorageEntries = 256
+)
+
 // Failed returns if the contract execution failed in vm errors
 func (egr EstimateGasResponse) Failed() bool {
 	return len(egr.VmError) > 0
 }
+
+// UnmarshalOverrides decodes the JSON encoded state and block overrides of the
+// request. It returns nil values for the overrides that are not set.
+func (m *EthCallRequest) UnmarshalOverrides() (StateOverride, *BlockOverrides, error) {
+	return unmarshalOverrides(m.Overrides, m.BlockOverrides)
+}
+
+// UnmarshalOverrides decodes the JSON encoded state and block overrides of the
+// request. It returns nil values for the overrides that are not set.
+func (m *QueryTraceCallRequest) UnmarshalOverrides() (StateOverride, *BlockOverrides, error) {
+	return unmarshalOverrides(m.Overrides, m.BlockOverrides)
+}
+
+func unmarshalOverrides(overridesBz, blockOverridesBz []byte) (StateOverride, *BlockOverrides, error) {
+	var (
+		stateOverrides StateOverride
+		blockOverrides *BlockOverrides
+	)
+
+	if len(overridesBz) > 0 {
+		if err := json.Unmarshal(overridesBz, &stateOverrides); err != nil {
+			return nil, nil, err
+		}
+		if err := stateOverrides.Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if len(blockOverridesBz) > 0 {
+		blockOverrides = new(BlockOverrides)
+		if err := json.Unmarshal(blockOverridesBz, blockOverrides); err != nil {
+			return nil, nil, err
+		}
+		if err := blockOverrides.Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return stateOverrides, blockOverrides, nil
+}
@@ -0,0 +1,142 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// MaxSimulateBlocks is the maximum number of blocks that can be simulated
+	// in a single eth_simulateV1 request.
+	MaxSimulateBlocks = 256
+	// SimulateTimestampIncrement is the default time increment (in seconds)
+	// between two consecutive simulated blocks.
+	SimulateTimestampIncrement = 12
+)
+
+// JSON-RPC error codes of the failed simulated calls, compatible with go-ethereum.
+const (
+	SimErrCodeReverted = -32000
+	SimErrCodeVMError  = -32015
+)
+
+// SimOpts are the inputs to eth_simulateV1.
+type SimOpts struct {
+	BlockStateCalls        []SimBlock `json:"blockStateCalls"`
+	TraceTransfers         bool       `json:"traceTransfers"`
+	Validation             bool       `json:"validation"`
+	ReturnFullTransactions bool       `json:"returnFullTransactions"`
+}
+
+// SimBlock is a batch of calls to be simulated sequentially on top of the
+// given block and state overrides.
+type SimBlock struct {
+	BlockOverrides *BlockOverrides   `json:"blockOverrides"`
+	StateOverrides StateOverride     `json:"stateOverrides"`
+	Calls          []TransactionArgs `json:"calls"`
+}
+
+// SimCallResult is the result of a simulated call.
+type SimCallResult struct {
+	ReturnValue hexutil.Bytes   `json:"returnData"`
+	Logs        []*ethtypes.Log `json:"logs"`
+	GasUsed     hexutil.Uint64  `json:"gasUsed"`
+	Status      hexutil.Uint64  `json:"status"`
+	Error       *SimCallError   `json:"error,omitempty"`
+}
+
+// SimCallError is the error of a failed simulated call.
+type SimCallError struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Data    string `json:"data,omitempty"`
+}
+
+// SimBlockResult is the result of a simulated block. The header, the
+// transactions and their senders are formatted into an RPC block by the
+// JSON-RPC server.
+type SimBlockResult struct {
+	Header       *ethtypes.Header        `json:"header"`
+	Transactions []*ethtypes.Transaction `json:"transactions"`
+	Senders      []common.Address        `json:"senders"`
+	Calls        []SimCallResult         `json:"calls"`
+}
+
+// Validate performs a stateless validation of the simulation options.
+func (opts SimOpts) Validate() error {
+	if len(opts.BlockStateCalls) == 0 {
+		return errors.New("empty input")
+	}
+	if len(opts.BlockStateCalls) > MaxSimulateBlocks {
+		return fmt.Errorf("too many blocks: %d > %d", len(opts.BlockStateCalls), MaxSimulateBlocks)
+	}
+	for i, block := range opts.BlockStateCalls {
+		if err := block.StateOverrides.Validate(); err != nil {
+			return fmt.Errorf("block %d: %w", i, err)
+		}
+		if err := block.BlockOverrides.Validate(); err != nil {
+			return fmt.Errorf("block %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// SanitizeChain checks that the simulated blocks are in order and fills the
+// gaps between them with empty blocks. Blocks without a number or timestamp
+// override are assigned the next one, starting from the given base block.
+func (opts SimOpts) SanitizeChain(baseNumber, baseTime uint64) ([]SimBlock, error) {
+	var (
+		res        = make([]SimBlock, 0, len(opts.BlockStateCalls))
+		prevNumber = baseNumber
+		prevTime   = baseTime
+	)
+
+	for _, block := range opts.BlockStateCalls {
+		overrides := new(BlockOverrides)
+		if block.BlockOverrides != nil {
+			*overrides = *block.BlockOverrides
+		}
+		block.BlockOverrides = overrides
+
+		if overrides.Number == nil {
+			overrides.Number = (*hexutil.Big)(new(big.Int).SetUint64(prevNumber + 1))
+		}
+		number := overrides.Number.ToInt().Uint64()
+		if number <= prevNumber {
+			return nil, fmt.Errorf("block numbers must be in order: %d <= %d", number, prevNumber)
+		}
+		if number-baseNumber > MaxSimulateBlocks {
+			return nil, fmt.Errorf("too many blocks: %d > %d", number-baseNumber, MaxSimulateBlocks)
+		}
+
+		// fill the gap with empty blocks
+		for n := prevNumber + 1; n < number; n++ {
+			prevTime += SimulateTimestampIncrement
+			timestamp := hexutil.Uint64(prevTime)
+			res = append(res, SimBlock{
+				BlockOverrides: &BlockOverrides{
+					Number: (*hexutil.Big)(new(big.Int).SetUint64(n)),
+					Time:   &timestamp,
+				},
+			})
+		}
+		prevNumber = number
+
+		if overrides.Time == nil {
+			timestamp := hexutil.Uint64(prevTime + SimulateTimestampIncrement)
+			overrides.Time = &timestamp
+		} else if uint64(*overrides.Time) <= prevTime {
+			return nil, fmt.Errorf("block timestamps must be in order: %d <= %d", uint64(*overrides.Time), prevTime)
+		}
+		prevTime = uint64(*overrides.Time)
+
+		res = append(res, block)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimOptsSanitizeChain(t *testing.T) {
+	number := func(n int64) *hexutil.Big { return (*hexutil.Big)(big.NewInt(n)) }
+	timestamp := func(t uint64) *hexutil.Uint64 { return (*hexutil.Uint64)(&t) }
+
+	testCases := []struct {
+		name       string
+		blocks     []SimBlock
+		expPass    bool
+		expNumbers []int64
+		expTimes   []uint64
+	}{
+		{
+			"default number and time",
+			[]SimBlock{{}, {}},
+			true,
+			[]int64{11, 12},
+			[]uint64{1012, 1024},
+		},
+		{
+			"fill the gap between blocks",
+			[]SimBlock{{}, {BlockOverrides: &BlockOverrides{Number: number(14)}}},
+			true,
+			[]int64{11, 12, 13, 14},
+			[]uint64{1012, 1024, 1036, 1048},
+		},
+		{
+			"time override",
+			[]SimBlock{{BlockOverrides: &BlockOverrides{Time: timestamp(2000)}}, {}},
+			true,
+			[]int64{11, 12},
+			[]uint64{2000, 2012},
+		},
+		{
+			"block numbers out of order",
+			[]SimBlock{{BlockOverrides: &BlockOverrides{Number: number(12)}}, {BlockOverrides: &BlockOverrides{Number: number(12)}}},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"block number lower than the base",
+			[]SimBlock{{BlockOverrides: &BlockOverrides{Number: number(10)}}},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"timestamps out of order",
+			[]SimBlock{{}, {BlockOverrides: &BlockOverrides{Time: timestamp(1012)}}},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"too many blocks",
+			[]SimBlock{{BlockOverrides: &BlockOverrides{Number: number(10 + MaxSimulateBlocks + 1)}}},
+			false,
+			nil,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		opts := SimOpts{BlockStateCalls: tc.blocks}
+		blocks, err := opts.SanitizeChain(10, 1000)
+		if !tc.expPass {
+			require.Error(t, err, tc.name)
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+		require.Len(t, blocks, len(tc.expNumbers), tc.name)
+		for i, block := range blocks {
+			require.Equal(t, tc.expNumbers[i], block.BlockOverrides.Number.ToInt().Int64(), tc.name)
+			require.Equal(t, tc.expTimes[i], uint64(*block.BlockOverrides.Time), tc.name)
+		}
+	}
+}
+
+func TestSimOptsValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		opts    SimOpts
+		expPass bool
+	}{
+		{
+			"valid",
+			SimOpts{BlockStateCalls: []SimBlock{{}}},
+			true,
+		},
+		{
+			"empty input",
+			SimOpts{},
+			false,
+		},
+		{
+			"too many blocks",
+			SimOpts{BlockStateCalls: make([]SimBlock, MaxSimulateBlocks+1)},
+			false,
+		},
+		{
+			"invalid block overrides",
+			SimOpts{BlockStateCalls: []SimBlock{{BlockOverrides: &BlockOverrides{BaseFee: (*hexutil.Big)(big.NewInt(-1))}}}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.opts.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -51,8 +51,20 @@ func NewTracer(tracer string, msg core.Message, cfg *params.ChainConfig, height
 
 // TxTraceResult is the result of a single transaction trace during a block trace.
 type TxTraceResult struct {
-	Result interface{} `json:"result,omitempty"` // Trace results produced by the tracer
-	Error  string      `json:"error,omitempty"`  // Trace failure produced by the tracer
+	Result    interface{}   `json:"result,omitempty"`    // Trace results produced by the tracer
+	Error     string        `json:"error,omitempty"`     // Trace failure produced by the tracer
+	CosmosMsg *CosmosMsgRef `json:"cosmosMsg,omitempty"` // Cosmos message that executed the call, nil for ethereum txs
+}
+
+// CosmosMsgRef identifies the Cosmos message that executed an EVM call traced
+// along the ethereum txs of a block, or the block phase for the calls executed
+// outside of the txs.
+type CosmosMsgRef struct {
+	TxHash   string `json:"txHash,omitempty"`
+	TxIndex  uint64 `json:"txIndex"`
+	MsgIndex uint64 `json:"msgIndex"`
+	MsgType  string `json:"msgType,omitempty"`
+	Phase    string `json:"phase,omitempty"`
 }
 
 // NoOpTracer is an empty implementation of vm.Tracer interface
@@ -47,6 +47,12 @@ func (w FeeMarketWrapper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 	return types.ConvertAmountTo18DecimalsLegacy(baseFee).TruncateInt().BigInt()
 }
 
+// AddBurnedBaseFee adds the given amount, in 18 decimals, to the cumulative
+// amount of base fees burned, converted to the bank module decimals.
+func (w FeeMarketWrapper) AddBurnedBaseFee(ctx sdk.Context, amount *big.Int) {
+	w.FeeMarketKeeper.AddBurnedBaseFee(ctx, types.ConvertBigIntFrom18DecimalsToLegacyDec(amount))
+}
+
 // GetParams returns the params with associated fees values converted to 18 decimals.
 func (w FeeMarketWrapper) GetParams(ctx sdk.Context) feemarkettypes.Params {
 	params := w.FeeMarketKeeper.GetParams(ctx)
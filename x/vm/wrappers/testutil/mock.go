@@ -479,6 +479,18 @@ func (m *MockFeeMarketKeeper) EXPECT() *MockFeeMarketKeeperMockRecorder {
 	return m.recorder
 }
 
+// AddBurnedBaseFee mocks base method.
+func (m *MockFeeMarketKeeper) AddBurnedBaseFee(ctx types.Context, amount math.LegacyDec) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddBurnedBaseFee", ctx, amount)
+}
+
+// AddBurnedBaseFee indicates an expected call of AddBurnedBaseFee.
+func (mr *MockFeeMarketKeeperMockRecorder) AddBurnedBaseFee(ctx, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBurnedBaseFee", reflect.TypeOf((*MockFeeMarketKeeper)(nil).AddBurnedBaseFee), ctx, amount)
+}
+
 // CalculateBaseFee mocks base method.
 func (m *MockFeeMarketKeeper) CalculateBaseFee(ctx types.Context) math.LegacyDec {
 	m.ctrl.T.Helper()